@@ -0,0 +1,119 @@
+package gbasm
+
+import "fmt"
+
+// nops are the recommended multi-byte NOP sequences, indexed by length.
+// Each is a single instruction; longer padding repeats the 9-byte form.
+var nops = [...][]byte{
+	1: {0x90},
+	2: {0x66, 0x90},
+	3: {0x0F, 0x1F, 0x00},
+	4: {0x0F, 0x1F, 0x40, 0x00},
+	5: {0x0F, 0x1F, 0x44, 0x00, 0x00},
+	6: {0x66, 0x0F, 0x1F, 0x44, 0x00, 0x00},
+	7: {0x0F, 0x1F, 0x80, 0x00, 0x00, 0x00, 0x00},
+	8: {0x0F, 0x1F, 0x84, 0x00, 0x00, 0x00, 0x00, 0x00},
+	9: {0x66, 0x0F, 0x1F, 0x84, 0x00, 0x00, 0x00, 0x00, 0x00},
+}
+
+// appendNops appends n bytes of NOP instructions to bs.
+func appendNops(bs []byte, n int) []byte {
+	for n > 0 {
+		k := n
+		if k >= len(nops) {
+			k = len(nops) - 1
+		}
+		bs = append(bs, nops[k]...)
+		n -= k
+	}
+	return bs
+}
+
+// padLen returns the number of bytes needed to bring off up to a multiple
+// of align.
+func padLen(off, align int) int {
+	if align <= 1 {
+		return 0
+	}
+	return (align - off%align) % align
+}
+
+// MaxAlign is the largest alignment a function, label or var may ask for.
+const MaxAlign = 4096
+
+// checkAlign reports whether n is a valid alignment: a power of two no
+// larger than MaxAlign.
+func checkAlign(n int) error {
+	if n < 1 || n > MaxAlign || n&(n-1) != 0 {
+		return fmt.Errorf("alignment %d must be a power of two between 1 and %d", n, MaxAlign)
+	}
+	return nil
+}
+
+// An alignPad is NOP padding in a function body that brings the code after
+// it to an aligned offset. Relaxing jumps moves the code before it, so
+// relax recomputes its length.
+type alignPad struct {
+	start int // offset of the padding in f.bs
+	len   int // current length of the padding
+	align int
+	entry int // index of the padding's ListEntry, or -1
+}
+
+// AlignTo pads f with NOPs so that the next instruction starts at a
+// multiple of n bytes, e.g. for a loop head. The function itself is
+// aligned to at least n, so the offset is aligned in the linked program
+// too.
+//
+// Like Label, AlignTo first saves every local to memory, so that a label
+// declared right after it is not pushed off the boundary by spills. A
+// label declared right before it is moved after the padding.
+func (f *Function) AlignTo(n int) error {
+	if err := checkAlign(n); err != nil {
+		return err
+	}
+	if n > f.Align {
+		f.Align = n
+	}
+	f.EvictAll()
+	f.flush()
+	p := alignPad{start: f.bs.Len(), len: padLen(f.bs.Len(), n), align: n, entry: -1}
+	if f.listing != nil {
+		p.entry = len(f.listing)
+		f.listing = append(f.listing, ListEntry{
+			Offset: p.start,
+			Len:    p.len,
+			Form:   "NOP",
+			Source: f.listSource,
+			Note:   fmt.Sprintf("align %d", n),
+		})
+	}
+	f.bs.Write(appendNops(nil, p.len))
+	f.pads = append(f.pads, p)
+	for l, off := range f.labels {
+		if off == p.start {
+			f.labels[l] = f.bs.Len()
+		}
+	}
+	return nil
+}
+
+// SetAlign makes the linker place f at a multiple of n bytes.
+func (f *Function) SetAlign(n int) error {
+	if err := checkAlign(n); err != nil {
+		return err
+	}
+	if n > f.Align {
+		f.Align = n
+	}
+	return nil
+}
+
+// SetAlign makes the linker place v at a multiple of n bytes.
+func (v *Var) SetAlign(n int) error {
+	if err := checkAlign(n); err != nil {
+		return err
+	}
+	v.Align = n
+	return nil
+}
@@ -0,0 +1,111 @@
+package gbasm
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestNopPadding(t *testing.T) {
+	for n := 1; n <= 20; n++ {
+		bs := appendNops(nil, n)
+		if len(bs) != n {
+			t.Fatalf("%d bytes of padding came out as %d", n, len(bs))
+		}
+		ds, err := Disassemble(bs)
+		if err != nil {
+			t.Fatalf("%d bytes of padding: %v", n, err)
+		}
+		for _, d := range ds {
+			// The 2-byte NOP is 66 90, which decodes as XCHG AX, AX.
+			if d.Mnemonic != "NOP" && d.String() != "XCHG AX, AX" {
+				t.Errorf("%d bytes of padding decoded to %s", n, d)
+			}
+		}
+		if n <= 9 && len(ds) != 1 {
+			t.Errorf("%d bytes of padding took %d instructions", n, len(ds))
+		}
+	}
+}
+
+func TestAlignRelaxed(t *testing.T) {
+	o, err := NewOFile("align", "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := o.NewFunction("align.bs", 1, "f")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// MOV RAX, RBX is 3 bytes.
+	f.Instr("MOV", R_RAX, R_RBX)
+	f.Jump("JMP", "loop") // 5 bytes until relaxed to 2
+	f.Label("loop")       // declared before the padding, moved after it
+	if err := f.AlignTo(16); err != nil {
+		t.Fatal(err)
+	}
+	f.Instr("ADD", R_RAX, int8(1))
+	f.Jump("JNE", "loop")
+	if err := f.AlignTo(32); err != nil {
+		t.Fatal(err)
+	}
+	f.Label("end")
+	f.Instr("RET")
+	if err := f.AlignTo(3); err == nil {
+		t.Errorf("Expected an alignment of 3 to be rejected")
+	}
+
+	bs, err := f.Body()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.Align != 32 {
+		t.Errorf("Expected the function to be aligned to 32, got %d", f.Align)
+	}
+	if f.labels["loop"] != 16 || f.labels["end"] != 32 {
+		t.Errorf("Expected loop at 16 and end at 32, got %v", f.labels)
+	}
+	ds, err := Disassemble(bs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range ds {
+		if target, ok := d.Target(); ok && d.Mnemonic != "CALL" {
+			if d.Len() != 2 || target != f.labels["loop"] {
+				t.Errorf("Expected a short jump to loop, got %s", d)
+			}
+		}
+	}
+}
+
+func TestAlignRoundTrip(t *testing.T) {
+	o, err := NewOFile("align", "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := o.NewFunction("align.bs", 1, "f")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Instr("RET")
+	if err := f.SetAlign(64); err != nil {
+		t.Fatal(err)
+	}
+	if err := o.AddVar("v", "byte[16]", make([]byte, 16), false); err != nil {
+		t.Fatal(err)
+	}
+	if err := o.Vars["v"].SetAlign(16); err != nil {
+		t.Fatal(err)
+	}
+
+	var b bytes.Buffer
+	if err := writeOFile(&b, o); err != nil {
+		t.Fatal(err)
+	}
+	got, err := readOFile(&b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Funcs["f"].Align != 64 || got.Vars["v"].Align != 16 {
+		t.Errorf("Expected alignments 64 and 16, got %d and %d", got.Funcs["f"].Align, got.Vars["v"].Align)
+	}
+}
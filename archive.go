@@ -0,0 +1,285 @@
+package gbasm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// A .ba archive bundles .bo files. It starts with the magic "\x7fGBA" and
+// the archive version, then an index with an entry for each member: its
+// name, its package, the qualified names of the symbols it defines and the
+// size of its .bo. The members' .bo files follow in index order, each with
+// its own header, so a member is read exactly as the file it was made from.
+var baMagic = [4]byte{0x7f, 'G', 'B', 'A'}
+
+// BAVersion is the .ba format version this toolchain reads and writes.
+const BAVersion = 1
+
+// An ArchiveMember is a .bo file in an archive.
+type ArchiveMember struct {
+	Name    string   // the base name of the file it was made from
+	Pkgname string   // the package it defines
+	Symbols []string // the qualified names of its functions, vars and data
+	bo      []byte
+}
+
+// An Archive is a set of .bo files, at most one per package.
+type Archive struct {
+	Filename string
+	Members  []*ArchiveMember
+	syms     map[string]*ArchiveMember
+}
+
+// NewArchive returns an archive of the .bo files named by filenames.
+func NewArchive(filenames []string) (*Archive, error) {
+	a := &Archive{}
+	for _, fn := range filenames {
+		bo, err := os.ReadFile(fn)
+		if err != nil {
+			return nil, err
+		}
+		if err := a.Add(filepath.Base(fn), bo); err != nil {
+			return nil, fmt.Errorf("%s: %w", fn, err)
+		}
+	}
+	return a, nil
+}
+
+// Add adds the .bo file bo to a as name.
+func (a *Archive) Add(name string, bo []byte) error {
+	if err := checkMemberName(name); err != nil {
+		return err
+	}
+	o, err := readOFile(bytes.NewReader(bo))
+	if err != nil {
+		return err
+	}
+	for _, m := range a.Members {
+		if m.Name == name {
+			return fmt.Errorf("Archive already has a member %s", name)
+		}
+		if m.Pkgname == o.Pkgname {
+			return fmt.Errorf("Package %s is already in the archive as %s", o.Pkgname, m.Name)
+		}
+	}
+	m := &ArchiveMember{Name: name, Pkgname: o.Pkgname, bo: bo}
+	for _, names := range [][]string{sortedKeys(o.Funcs), sortedKeys(o.Vars), sortedKeys(o.Data)} {
+		for _, n := range names {
+			m.Symbols = append(m.Symbols, qualify(o.Pkgname, n))
+		}
+	}
+	a.Members = append(a.Members, m)
+	a.syms = nil
+	return nil
+}
+
+// checkMemberName reports an error unless name is a plain file name,
+// which bar -x can write into its directory without escaping it.
+func checkMemberName(name string) error {
+	if name == "" || name == "." || name == ".." || name != filepath.Base(name) {
+		return fmt.Errorf("Member name %q is not a plain file name", name)
+	}
+	return nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	var ks []string
+	for k := range m {
+		ks = append(ks, k)
+	}
+	sort.Strings(ks)
+	return ks
+}
+
+// Object reads the member's .bo.
+func (m *ArchiveMember) Object() (*OFile, error) {
+	o, err := readOFile(bytes.NewReader(m.bo))
+	if err != nil {
+		return nil, fmt.Errorf("Member %s: %w", m.Name, err)
+	}
+	o.Filename = m.Name
+	return o, nil
+}
+
+// Bytes returns the member's .bo file.
+func (m *ArchiveMember) Bytes() []byte {
+	return m.bo
+}
+
+// Package returns the member defining package pkg, or nil.
+func (a *Archive) Package(pkg string) *ArchiveMember {
+	for _, m := range a.Members {
+		if m.Pkgname == pkg {
+			return m
+		}
+	}
+	return nil
+}
+
+// Lookup returns the member defining the qualified symbol sym, or nil.
+func (a *Archive) Lookup(sym string) *ArchiveMember {
+	if a.syms == nil {
+		a.syms = make(map[string]*ArchiveMember)
+		for _, m := range a.Members {
+			for _, s := range m.Symbols {
+				a.syms[s] = m
+			}
+		}
+	}
+	return a.syms[sym]
+}
+
+// Write writes a to w.
+func (a *Archive) Write(w io.Writer) error {
+	if _, err := w.Write(baMagic[:]); err != nil {
+		return err
+	}
+	if err := binary.Write(w, binary.LittleEndian, uint32(BAVersion)); err != nil {
+		return err
+	}
+	if err := writeSize(w, len(a.Members)); err != nil {
+		return err
+	}
+	for _, m := range a.Members {
+		if err := writeString(w, m.Name); err != nil {
+			return err
+		}
+		if err := writeString(w, m.Pkgname); err != nil {
+			return err
+		}
+		if err := writeSize(w, len(m.Symbols)); err != nil {
+			return err
+		}
+		for _, s := range m.Symbols {
+			if err := writeString(w, s); err != nil {
+				return err
+			}
+		}
+		if err := writeSize(w, len(m.bo)); err != nil {
+			return err
+		}
+	}
+	for _, m := range a.Members {
+		if _, err := w.Write(m.bo); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Output writes a to filename.
+func (a *Archive) Output(filename string) error {
+	var b bytes.Buffer
+	if err := a.Write(&b); err != nil {
+		return err
+	}
+	return os.WriteFile(filename, b.Bytes(), 0644)
+}
+
+func readArchive(r io.Reader) (*Archive, error) {
+	var version uint32
+	if err := binary.Read(r, binary.LittleEndian, &version); err != nil {
+		return nil, err
+	}
+	if version != BAVersion {
+		return nil, fmt.Errorf("%w: it is .ba version %d, and this toolchain reads version %d; rebuild it",
+			ErrIncompatibleObject, version, BAVersion)
+	}
+	n, err := readSize(r)
+	if err != nil {
+		return nil, err
+	}
+	a := &Archive{}
+	sizes := make([]int, n)
+	for i := 0; i < n; i++ {
+		m := &ArchiveMember{}
+		if m.Name, err = readString(r); err != nil {
+			return nil, err
+		}
+		if err := checkMemberName(m.Name); err != nil {
+			return nil, err
+		}
+		if m.Pkgname, err = readString(r); err != nil {
+			return nil, err
+		}
+		nsyms, err := readSize(r)
+		if err != nil {
+			return nil, err
+		}
+		for j := 0; j < nsyms; j++ {
+			s, err := readString(r)
+			if err != nil {
+				return nil, err
+			}
+			m.Symbols = append(m.Symbols, s)
+		}
+		if sizes[i], err = readSize(r); err != nil {
+			return nil, err
+		}
+		a.Members = append(a.Members, m)
+	}
+	for i, m := range a.Members {
+		m.bo = make([]byte, sizes[i])
+		if _, err := io.ReadFull(r, m.bo); err != nil {
+			return nil, fmt.Errorf("Member %s: %w", m.Name, err)
+		}
+	}
+	return a, nil
+}
+
+// ReadArchive reads the archive filename.
+func ReadArchive(filename string) (*Archive, error) {
+	bs, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	if !isArchive(bs) {
+		return nil, fmt.Errorf("%s is not a .ba archive", filename)
+	}
+	a, err := readArchive(bytes.NewReader(bs[len(baMagic):]))
+	if err != nil {
+		return nil, err
+	}
+	a.Filename = filename
+	return a, nil
+}
+
+func isArchive(bs []byte) bool {
+	return len(bs) >= len(baMagic) && [4]byte(bs[:4]) == baMagic
+}
+
+// IsArchive reports whether filename is a .ba archive.
+func IsArchive(filename string) bool {
+	f, err := os.Open(filename)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+	var magic [4]byte
+	if _, err := io.ReadFull(f, magic[:]); err != nil {
+		return false
+	}
+	return magic == baMagic
+}
+
+// ReadPackage reads package pkg from filename, which is either a .bo file
+// or an archive with a member for pkg.
+func ReadPackage(filename, pkg string) (*OFile, error) {
+	if !IsArchive(filename) {
+		return ReadOFile(filename)
+	}
+	a, err := ReadArchive(filename)
+	if err != nil {
+		return nil, err
+	}
+	m := a.Package(pkg)
+	if m == nil {
+		return nil, fmt.Errorf("Archive %s has no package %s", filename, pkg)
+	}
+	return m.Object()
+}
@@ -0,0 +1,108 @@
+package gbasm
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+)
+
+// archiveTestObject returns the .bo of package pkg with a function named
+// fn that calls each of calls.
+func archiveTestObject(t *testing.T, pkg, fn string, calls ...string) []byte {
+	t.Helper()
+	_, bs := testObject(t, pkg, func(o *OFile) {
+		f, err := o.NewFunction(pkg+".bs", 1, fn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, c := range calls {
+			f.Jump("CALL", c)
+		}
+		f.Instr("RET")
+	})
+	return bs
+}
+
+func TestArchive(t *testing.T) {
+	a := &Archive{}
+	for _, m := range []struct {
+		name, pkg, fn string
+		calls         []string
+	}{
+		{"init.bo", "_init", "start", []string{"main.main"}},
+		{"used.bo", "used", "f", nil},
+		{"unused.bo", "unused", "g", nil},
+	} {
+		if err := a.Add(m.name, archiveTestObject(t, m.pkg, m.fn, m.calls...)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := a.Add("again.bo", archiveTestObject(t, "used", "h")); err == nil {
+		t.Error("Expected a second member for package used to be rejected")
+	}
+
+	path := filepath.Join(t.TempDir(), "rt.ba")
+	if err := a.Output(path); err != nil {
+		t.Fatal(err)
+	}
+	if !IsArchive(path) {
+		t.Fatal("Expected the file to be an archive")
+	}
+	a, err := ReadArchive(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m := a.Lookup("used.f"); m == nil || m.Name != "used.bo" {
+		t.Errorf("Expected used.f in used.bo, got %v", m)
+	}
+	o, err := ReadPackage(path, "unused")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o.Funcs["g"] == nil {
+		t.Errorf("Expected package unused to define g, got %v", o.Funcs)
+	}
+
+	o, err = readOFile(bytes.NewReader(archiveTestObject(t, "main", "main", "used.f")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	bin, err := link([]*OFile{o}, []*Archive{a}, nil, ENTRY_ADDR, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	linked := make(map[string]bool)
+	for _, s := range bin.Sections[0].symbols {
+		linked[s.Name] = true
+	}
+	for _, sym := range []string{"_init.start", "main.main", "used.f"} {
+		if !linked[sym] {
+			t.Errorf("Expected %s to be linked, got %v", sym, linked)
+		}
+	}
+	if linked["unused.g"] {
+		t.Error("Expected the unreachable member not to be linked")
+	}
+}
+
+func TestArchiveMemberNames(t *testing.T) {
+	bo := archiveTestObject(t, "evil", "f")
+	a := &Archive{}
+	for _, name := range []string{"../../x.bo", "dir/x.bo", "..", ""} {
+		if err := a.Add(name, bo); err == nil {
+			t.Errorf("Expected a member named %q to be rejected", name)
+		}
+	}
+	// A crafted archive could still name a member anything.
+	if err := a.Add("evil.bo", bo); err != nil {
+		t.Fatal(err)
+	}
+	a.Members[0].Name = "../../x.bo"
+	path := filepath.Join(t.TempDir(), "evil.ba")
+	if err := a.Output(path); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReadArchive(path); err == nil {
+		t.Error("Expected an archive with a member named ../../x.bo to be rejected")
+	}
+}
@@ -0,0 +1,167 @@
+package gbasm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	rdebug "runtime/debug"
+)
+
+// A .bo file starts with a header:
+//
+//	magic    [4]byte  "\x7fGBO"
+//	version  uint32   BOVersion of the writer
+//	producer string   the toolchain commit that wrote it (Producer)
+//	crc      uint32   CRC-32 (IEEE) of everything after the header
+//
+// followed by tagged sections, each a tag string, a size and that many
+// bytes. A reader skips sections with tags it does not know and treats
+// sections it does not find as empty, so a new optional section can be
+// added without changing BOVersion. A change to the layout of an existing
+// section must bump it.
+var boMagic = [4]byte{0x7f, 'G', 'B', 'O'}
+
+// BOVersion is the .bo format version this toolchain reads and writes.
+const BOVersion = 3
+
+// ErrIncompatibleObject is returned, wrapped, by ReadOFile for an object
+// file that is not in the format this toolchain reads.
+var ErrIncompatibleObject = errors.New("object was built by an incompatible toolchain")
+
+// Producer names the toolchain written into each .bo: the commit it was
+// built from, if the build recorded one.
+var Producer = producer()
+
+func producer() string {
+	info, ok := rdebug.ReadBuildInfo()
+	if !ok {
+		return "devel"
+	}
+	rev, dirty := "", false
+	for _, s := range info.Settings {
+		switch s.Key {
+		case "vcs.revision":
+			rev = s.Value
+		case "vcs.modified":
+			dirty = s.Value == "true"
+		}
+	}
+	if rev == "" {
+		return "devel"
+	}
+	if dirty {
+		rev += "-dirty"
+	}
+	return rev
+}
+
+// The tags of the sections writeOFile writes, in the order it writes them.
+const (
+	secPackage     = "package"
+	secTypes       = "types"
+	secData        = "data"
+	secVars        = "vars"
+	secFuncs       = "funcs"
+	secStructs     = "structs"
+	secTypeAliases = "typealiases"
+	secInterfaces  = "interfaces"
+	secValues      = "values"
+	secExportHash  = "exporthash"
+	secImports     = "importhashes"
+	secDebug       = "debug"
+	secVarPos      = "varpos"
+)
+
+// writeSection writes a section tagged tag with the bytes body writes.
+func writeSection(w io.Writer, tag string, body func(w io.Writer) error) error {
+	var b bytes.Buffer
+	if err := body(&b); err != nil {
+		return err
+	}
+	if err := writeString(w, tag); err != nil {
+		return err
+	}
+	if err := writeSize(w, b.Len()); err != nil {
+		return err
+	}
+	_, err := w.Write(b.Bytes())
+	return err
+}
+
+// readSections reads tagged sections up to the end of r. A tag that
+// appears twice is an error.
+func readSections(r io.Reader) (map[string][]byte, error) {
+	secs := make(map[string][]byte)
+	for {
+		tag, err := readString(r)
+		if err == io.EOF {
+			return secs, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		size, err := readSize(r)
+		if err != nil {
+			return nil, err
+		}
+		bs := make([]byte, size)
+		if _, err := io.ReadFull(r, bs); err != nil {
+			return nil, fmt.Errorf("Section %s: %w", tag, err)
+		}
+		if _, ok := secs[tag]; ok {
+			return nil, fmt.Errorf("Duplicate section %s", tag)
+		}
+		secs[tag] = bs
+	}
+}
+
+// writeHeader writes the header for the sections in body, naming producer
+// as the toolchain that wrote them.
+func writeHeader(w io.Writer, producer string, body []byte) error {
+	if _, err := w.Write(boMagic[:]); err != nil {
+		return err
+	}
+	if err := binary.Write(w, binary.LittleEndian, uint32(BOVersion)); err != nil {
+		return err
+	}
+	if err := writeString(w, producer); err != nil {
+		return err
+	}
+	return binary.Write(w, binary.LittleEndian, crc32.ChecksumIEEE(body))
+}
+
+// readHeader checks the header of a .bo and returns the producer that
+// wrote it and the sections after it.
+func readHeader(r io.Reader) (producer string, body []byte, err error) {
+	var magic [4]byte
+	if _, err := io.ReadFull(r, magic[:]); err != nil || magic != boMagic {
+		return "", nil, fmt.Errorf("%w: no .bo header; rebuild it", ErrIncompatibleObject)
+	}
+	var version uint32
+	if err := binary.Read(r, binary.LittleEndian, &version); err != nil {
+		return "", nil, err
+	}
+	producer, err = readString(r)
+	if err != nil {
+		return "", nil, err
+	}
+	if version != BOVersion {
+		return "", nil, fmt.Errorf("%w: it is .bo version %d, written by %s, and this toolchain (%s) reads version %d; rebuild it",
+			ErrIncompatibleObject, version, producer, Producer, BOVersion)
+	}
+	var crc uint32
+	if err := binary.Read(r, binary.LittleEndian, &crc); err != nil {
+		return "", nil, err
+	}
+	body, err = io.ReadAll(r)
+	if err != nil {
+		return "", nil, err
+	}
+	if got := crc32.ChecksumIEEE(body); got != crc {
+		return "", nil, fmt.Errorf("Object file is corrupt: checksum is %08x, header says %08x", got, crc)
+	}
+	return producer, body, nil
+}
@@ -0,0 +1,78 @@
+package gbasm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+)
+
+// headerLen is the length of the header writeOFile wrote for Producer.
+var headerLen = 4 + 4 + 8 + len(Producer) + 4
+
+func TestOFileHeader(t *testing.T) {
+	_, bs := testObject(t, "hdr", func(o *OFile) {
+		if err := o.AddVar("v", "i64", uint64(7), true); err != nil {
+			t.Fatal(err)
+		}
+	})
+	o, err := readOFile(bytes.NewReader(bs))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o.Producer != Producer || o.Pkgname != "hdr" || o.Vars["v"] == nil {
+		t.Errorf("Unexpected round trip: producer %q, package %q, vars %v", o.Producer, o.Pkgname, o.Vars)
+	}
+
+	// A file from before the header starts with the package name.
+	_, err = readOFile(bytes.NewReader(bs[headerLen:]))
+	if !errors.Is(err, ErrIncompatibleObject) {
+		t.Errorf("Expected an incompatible toolchain error for a headerless file, got %v", err)
+	}
+
+	newer := append([]byte(nil), bs...)
+	binary.LittleEndian.PutUint32(newer[4:], BOVersion+1)
+	_, err = readOFile(bytes.NewReader(newer))
+	want := fmt.Sprintf("version %d", BOVersion+1)
+	if !errors.Is(err, ErrIncompatibleObject) || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected an incompatible toolchain error naming %s, got %v", want, err)
+	}
+
+	corrupt := append([]byte(nil), bs...)
+	corrupt[len(corrupt)-1] ^= 0xff
+	_, err = readOFile(bytes.NewReader(corrupt))
+	if err == nil || !strings.Contains(err.Error(), "corrupt") {
+		t.Errorf("Expected a checksum error, got %v", err)
+	}
+}
+
+func TestOFileUnknownSection(t *testing.T) {
+	_, bs := testObject(t, "hdr", func(o *OFile) {
+		if err := o.AddVar("v", "i64", uint64(7), true); err != nil {
+			t.Fatal(err)
+		}
+	})
+	var body bytes.Buffer
+	body.Write(bs[headerLen:])
+	if err := writeSection(&body, "from-the-future", func(w io.Writer) error {
+		_, err := w.Write([]byte("ignored"))
+		return err
+	}); err != nil {
+		t.Fatal(err)
+	}
+	var b bytes.Buffer
+	if err := writeHeader(&b, Producer, body.Bytes()); err != nil {
+		t.Fatal(err)
+	}
+	b.Write(body.Bytes())
+	o, err := readOFile(&b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o.Vars["v"] == nil {
+		t.Errorf("Expected var v, got %v", o.Vars)
+	}
+}
@@ -1,6 +1,7 @@
 package gbasm
 
 import (
+	"bytes"
 	"encoding/binary"
 	"fmt"
 	"io"
@@ -69,8 +70,8 @@ func writeTypeDescrs(w io.Writer, types map[string]*TypeDescr) error {
 	if err != nil {
 		return err
 	}
-	for _, t := range types {
-		err := writeTypeDescr(w, t)
+	for _, name := range sortedKeys(types) {
+		err := writeTypeDescr(w, types[name])
 		if err != nil {
 			return err
 		}
@@ -153,8 +154,8 @@ func writeVars(w io.Writer, vs map[string]*Var) error {
 	if err != nil {
 		return err
 	}
-	for _, t := range vs {
-		err := writeVar(w, t)
+	for _, name := range sortedKeys(vs) {
+		err := writeVar(w, vs[name])
 		if err != nil {
 			return err
 		}
@@ -185,6 +186,9 @@ func writeVar(w io.Writer, v *Var) error {
 	if err := binary.Write(w, binary.LittleEndian, v.IsPub); err != nil {
 		return err
 	}
+	if err := binary.Write(w, binary.LittleEndian, v.IsConst); err != nil {
+		return err
+	}
 	if err := writeString(w, v.VType); err != nil {
 		return err
 	}
@@ -205,6 +209,12 @@ func writeVar(w io.Writer, v *Var) error {
 	if err := writeString(w, v.Kind); err != nil {
 		return err
 	}
+	if err := writeSize(w, v.Align); err != nil {
+		return err
+	}
+	if err := writeSize(w, v.ZeroFill); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -217,6 +227,10 @@ func readVar(r io.Reader) (*Var, error) {
 	if err := binary.Read(r, binary.LittleEndian, &isPub); err != nil {
 		return nil, err
 	}
+	var isConst bool
+	if err := binary.Read(r, binary.LittleEndian, &isConst); err != nil {
+		return nil, err
+	}
 	vtype, err := readString(r)
 	if err != nil {
 		return nil, err
@@ -245,7 +259,69 @@ func readVar(r io.Reader) (*Var, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Var{Name: name, IsPub: isPub, VType: vtype, Val: bs, Relocs: relocs, Kind: kind}, nil
+	align, err := readSize(r)
+	if err != nil {
+		return nil, err
+	}
+	zeroFill, err := readSize(r)
+	if err != nil {
+		return nil, err
+	}
+	if zeroFill > 0 && (len(bs) > 0 || len(relocs) > 0) {
+		return nil, fmt.Errorf("Var %s is zero-filled but has a value", name)
+	}
+	return &Var{Name: name, IsPub: isPub, IsConst: isConst, VType: vtype, Val: bs, Relocs: relocs, Kind: kind, Align: align, ZeroFill: zeroFill}, nil
+}
+
+// writeVarPositions writes the name, file and line of each var in vs whose
+// position is known.
+func writeVarPositions(w io.Writer, vs map[string]*Var) error {
+	var names []string
+	for _, name := range sortedKeys(vs) {
+		if vs[name].SrcFile != "" {
+			names = append(names, name)
+		}
+	}
+	if err := writeSize(w, len(names)); err != nil {
+		return err
+	}
+	for _, name := range names {
+		if err := writeString(w, name); err != nil {
+			return err
+		}
+		if err := writeString(w, vs[name].SrcFile); err != nil {
+			return err
+		}
+		if err := writeSize(w, vs[name].SrcLine); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readVarPositions reads what writeVarPositions wrote into the vars of vs.
+func readVarPositions(r io.Reader, vs map[string]*Var) error {
+	n, err := readSize(r)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < n; i++ {
+		name, err := readString(r)
+		if err != nil {
+			return err
+		}
+		v := vs[name]
+		if v == nil {
+			return fmt.Errorf("Position for undeclared var %s", name)
+		}
+		if v.SrcFile, err = readString(r); err != nil {
+			return err
+		}
+		if v.SrcLine, err = readSize(r); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func writeDataReloc(w io.Writer, r *DataReloc) error {
@@ -334,7 +410,8 @@ func writeFunctions(w io.Writer, fs map[string]*Function) error {
 	if err != nil {
 		return err
 	}
-	for _, f := range fs {
+	for _, name := range sortedKeys(fs) {
+		f := fs[name]
 		err := writeFunction(w, f)
 		if err != nil {
 			return fmt.Errorf("Writing function %s: %w", f.Name, err)
@@ -446,6 +523,9 @@ func writeFunction(w io.Writer, f *Function) error {
 			}
 		}
 	}
+	if err := writeSize(w, f.Align); err != nil {
+		return fmt.Errorf("Writing alignment: %w", err)
+	}
 	return nil
 }
 
@@ -541,6 +621,10 @@ func readFunction(r io.Reader) (*Function, error) {
 			returnAliases[s] = params
 		}
 	}
+	align, err := readSize(r)
+	if err != nil {
+		return nil, err
+	}
 	return &Function{
 		Name:          name,
 		IsPub:         isPub,
@@ -551,107 +635,118 @@ func readFunction(r io.Reader) (*Function, error) {
 		Symbols:       symbols,
 		Relocations:   relocations,
 		ReturnAliases: returnAliases,
+		Align:         align,
 		bodyBs:        bodyBs,
 	}, nil
 }
 
 func writeOFile(w io.Writer, o *OFile) error {
-	err := writeString(w, o.Pkgname)
-	if err != nil {
-		return err
-	}
-	err = writeString(w, o.ExeFormat)
-	if err != nil {
-		return err
-	}
-	err = writeTypeDescrs(w, o.Types)
-	if err != nil {
-		return err
-	}
-	err = writeVars(w, o.Data)
-	if err != nil {
-		return err
-	}
-	err = writeVars(w, o.Vars)
-	if err != nil {
-		return err
-	}
-	err = writeFunctions(w, o.Funcs)
-	if err != nil {
-		return fmt.Errorf("WriteFunctions: %w", err)
-	}
-	err = writeStructs(w, o.Structs)
-	if err != nil {
-		return err
-	}
-	err = writeTypeAliases(w, o.TypeAliases)
-	if err != nil {
-		return err
+	var body bytes.Buffer
+	sections := []struct {
+		tag   string
+		write func(w io.Writer) error
+	}{
+		{secPackage, func(w io.Writer) error {
+			if err := writeString(w, o.Pkgname); err != nil {
+				return err
+			}
+			return writeString(w, o.ExeFormat)
+		}},
+		{secTypes, func(w io.Writer) error { return writeTypeDescrs(w, o.Types) }},
+		{secData, func(w io.Writer) error { return writeVars(w, o.Data) }},
+		{secVars, func(w io.Writer) error { return writeVars(w, o.Vars) }},
+		{secFuncs, func(w io.Writer) error {
+			if err := writeFunctions(w, o.Funcs); err != nil {
+				return fmt.Errorf("WriteFunctions: %w", err)
+			}
+			return nil
+		}},
+		{secStructs, func(w io.Writer) error { return writeStructs(w, o.Structs) }},
+		{secTypeAliases, func(w io.Writer) error { return writeTypeAliases(w, o.TypeAliases) }},
+		{secInterfaces, func(w io.Writer) error { return writeInterfaces(w, o.Interfaces) }},
+		{secValues, func(w io.Writer) error { return writeValues(w, o.Values) }},
+		{secExportHash, func(w io.Writer) error { return writeString(w, o.ComputeExportHash()) }},
+		{secImports, func(w io.Writer) error { return writeImportHashes(w, o.ImportHashes) }},
+		{secDebug, func(w io.Writer) error { return writeDebugInfo(w, o.Funcs) }},
+		{secVarPos, func(w io.Writer) error { return writeVarPositions(w, o.Vars) }},
+	}
+	for _, sec := range sections {
+		if err := writeSection(&body, sec.tag, sec.write); err != nil {
+			return err
+		}
 	}
-	err = writeInterfaces(w, o.Interfaces)
-	if err != nil {
-		return err
+	producer := o.Producer
+	if producer == "" {
+		producer = Producer
 	}
-	err = writeValues(w, o.Values)
-	if err != nil {
+	if err := writeHeader(w, producer, body.Bytes()); err != nil {
 		return err
 	}
-	return nil
+	_, err := w.Write(body.Bytes())
+	return err
 }
 
 func readOFile(r io.Reader) (*OFile, error) {
-	pkgname, err := readString(r)
-	if err != nil {
-		return nil, err
-	}
-	exeformat, err := readString(r)
+	producer, body, err := readHeader(r)
 	if err != nil {
 		return nil, err
 	}
-	types, err := readTypeDescrs(r)
+	secs, err := readSections(bytes.NewReader(body))
 	if err != nil {
 		return nil, err
 	}
-	data, err := readVars(r)
-	if err != nil {
-		return nil, err
-	}
-	vars, err := readVars(r)
-	if err != nil {
-		return nil, err
-	}
-	funcs, err := readFunctions(r)
-	if err != nil {
-		return nil, err
-	}
-	structs, err := readStructs(r)
-	if err != nil {
-		return nil, err
-	}
-	typeAliases, err := readTypeAliases(r)
+	o := &OFile{
+		Producer:    producer,
+		Types:       make(map[string]*TypeDescr),
+		Data:        make(map[string]*Var),
+		Vars:        make(map[string]*Var),
+		Funcs:       make(map[string]*Function),
+		Structs:     make(map[string]*StructShape),
+		TypeAliases: make(map[string]*TypeAliasShape),
+		Interfaces:  make(map[string]*InterfaceShape),
+		Values:      make(map[string]*ValuesShape),
+	}
+	// Each known section replaces its zero value; a missing one leaves
+	// it empty.
+	read := func(tag string, read func(r io.Reader) error) {
+		bs, ok := secs[tag]
+		if !ok || err != nil {
+			return
+		}
+		if err = read(bytes.NewReader(bs)); err != nil {
+			err = fmt.Errorf("Reading section %s: %w", tag, err)
+		}
+	}
+	read(secPackage, func(r io.Reader) (err error) {
+		if o.Pkgname, err = readString(r); err != nil {
+			return err
+		}
+		o.ExeFormat, err = readString(r)
+		return err
+	})
+	read(secTypes, func(r io.Reader) (err error) { o.Types, err = readTypeDescrs(r); return err })
+	read(secData, func(r io.Reader) (err error) { o.Data, err = readVars(r); return err })
+	read(secVars, func(r io.Reader) (err error) { o.Vars, err = readVars(r); return err })
+	read(secFuncs, func(r io.Reader) (err error) { o.Funcs, err = readFunctions(r); return err })
+	read(secStructs, func(r io.Reader) (err error) { o.Structs, err = readStructs(r); return err })
+	read(secTypeAliases, func(r io.Reader) (err error) { o.TypeAliases, err = readTypeAliases(r); return err })
+	read(secInterfaces, func(r io.Reader) (err error) { o.Interfaces, err = readInterfaces(r); return err })
+	read(secValues, func(r io.Reader) (err error) { o.Values, err = readValues(r); return err })
+	read(secExportHash, func(r io.Reader) (err error) { o.ExportHash, err = readString(r); return err })
+	read(secImports, func(r io.Reader) (err error) { o.ImportHashes, err = readImportHashes(r); return err })
+	read(secDebug, func(r io.Reader) error { return readDebugInfo(r, o.Funcs) })
+	read(secVarPos, func(r io.Reader) error { return readVarPositions(r, o.Vars) })
 	if err != nil {
 		return nil, err
 	}
-	interfaces, err := readInterfaces(r)
-	if err != nil {
-		return nil, err
+	if o.ExportHash == "" {
+		o.ExportHash = o.ComputeExportHash()
 	}
-	values, err := readValues(r)
-	if err != nil {
-		return nil, err
+	// Stamp every function with its owning package so the linker can namespace.
+	for _, fn := range o.Funcs {
+		fn.Pkgname = o.Pkgname
 	}
-	return &OFile{
-		Pkgname:     pkgname,
-		ExeFormat:   exeformat,
-		Types:       types,
-		Data:        data,
-		Vars:        vars,
-		Funcs:       funcs,
-		Structs:     structs,
-		TypeAliases: typeAliases,
-		Interfaces:  interfaces,
-		Values:      values,
-	}, nil
+	return o, nil
 }
 
 func writeStructs(w io.Writer, ss map[string]*StructShape) error {
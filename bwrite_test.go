@@ -5,6 +5,22 @@ import (
 	"testing"
 )
 
+// testObject returns an object file of package pkg, named pkg.bo, once
+// build has added its declarations, and the .bo writeOFile writes for it.
+func testObject(t *testing.T, pkg string, build func(o *OFile)) (*OFile, []byte) {
+	t.Helper()
+	o, err := NewOFile(pkg+".bo", pkg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	build(o)
+	var b bytes.Buffer
+	if err := writeOFile(&b, o); err != nil {
+		t.Fatal(err)
+	}
+	return o, b.Bytes()
+}
+
 // TestStructShapeMethodNamesRoundTrip verifies that the MethodNames field
 // added to StructShape (so cross-package importers can reconstruct a struct's
 // method table) survives serialization through writeOFile/readOFile intact,
@@ -65,3 +81,85 @@ func TestStructShapeMethodNamesRoundTrip(t *testing.T) {
 		})
 	}
 }
+
+// TestVarIsConstRoundTrip verifies that a var's immutability and position
+// survive serialization, so an importer can reject writes to it and say
+// where it was declared, and that data is always read back immutable.
+func TestVarIsConstRoundTrip(t *testing.T) {
+	o, err := NewOFile("test.bo", "mypkg")
+	if err != nil {
+		t.Fatalf("NewOFile: %v", err)
+	}
+	if err := o.AddVar("limit", "i64", uint64(10), true); err != nil {
+		t.Fatalf("AddVar: %v", err)
+	}
+	o.Vars["limit"].IsConst = true
+	o.Vars["limit"].SrcFile, o.Vars["limit"].SrcLine = "limit.bos", 3
+	if err := o.AddVar("count", "i64", uint64(0), true); err != nil {
+		t.Fatalf("AddVar: %v", err)
+	}
+	if err := o.AddData("msg", "byte[2]", "hi", false); err != nil {
+		t.Fatalf("AddData: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := writeOFile(&buf, o); err != nil {
+		t.Fatalf("writeOFile: %v", err)
+	}
+	got, err := readOFile(&buf)
+	if err != nil {
+		t.Fatalf("readOFile: %v", err)
+	}
+	for _, tt := range []struct {
+		v       *Var
+		isConst bool
+	}{
+		{got.Vars["limit"], true},
+		{got.Vars["count"], false},
+		{got.Data["msg"], true},
+	} {
+		if tt.v == nil {
+			t.Fatalf("var missing after round-trip: %v %v", got.Vars, got.Data)
+		}
+		if tt.v.IsConst != tt.isConst {
+			t.Errorf("%s: IsConst: got %v want %v", tt.v.Name, tt.v.IsConst, tt.isConst)
+		}
+	}
+	if v := got.Vars["limit"]; v.SrcFile != "limit.bos" || v.SrcLine != 3 {
+		t.Errorf("limit: position: got %s:%d want limit.bos:3", v.SrcFile, v.SrcLine)
+	}
+	if v := got.Vars["count"]; v.SrcFile != "" || v.SrcLine != 0 {
+		t.Errorf("count: position: got %s:%d want none", v.SrcFile, v.SrcLine)
+	}
+}
+
+// TestVarZeroFillRoundTrip verifies that a zero-filled var is written as
+// its size rather than its bytes, and read back the same way.
+func TestVarZeroFillRoundTrip(t *testing.T) {
+	o, err := NewOFile("test.bo", "mypkg")
+	if err != nil {
+		t.Fatalf("NewOFile: %v", err)
+	}
+	if err := o.AddZeroVar("buf", "byte[65536]", 1<<16, false); err != nil {
+		t.Fatalf("AddZeroVar: %v", err)
+	}
+	if err := o.AddZeroVar("buf", "byte[8]", 8, false); err == nil {
+		t.Errorf("Expected a second declaration of buf to be rejected")
+	}
+
+	var buf bytes.Buffer
+	if err := writeOFile(&buf, o); err != nil {
+		t.Fatalf("writeOFile: %v", err)
+	}
+	if buf.Len() >= 1<<16 {
+		t.Errorf("Expected the zero-filled var to take no space, got a %d-byte object", buf.Len())
+	}
+	got, err := readOFile(&buf)
+	if err != nil {
+		t.Fatalf("readOFile: %v", err)
+	}
+	v := got.Vars["buf"]
+	if v == nil || v.ZeroFill != 1<<16 || len(v.Val) != 0 || v.Size() != 1<<16 {
+		t.Errorf("Expected buf to be zero-filled to %d bytes, got %+v", 1<<16, v)
+	}
+}
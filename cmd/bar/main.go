@@ -0,0 +1,92 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/knusbaum/gbasm"
+)
+
+var create = flag.Bool("c", false, "Create the archive named by the first argument from the .bo files after it.")
+var list = flag.Bool("t", false, "List the members of the archive, with their packages.")
+var extract = flag.Bool("x", false, "Extract the members of the archive named after it, or all of them, into the -C directory.")
+var dir = flag.String("C", ".", "Extract into this directory.")
+var verbose = flag.Bool("v", false, "With -t, also list the symbols each member defines.")
+var help = flag.Bool("h", false, "Print this help message.")
+
+func main() {
+	flag.Parse()
+
+	if *help {
+		fmt.Printf("usage: bar -c archive.ba file.bo...\n       bar -t [-v] archive.ba\n       bar -x [-C dir] archive.ba [member...]\n")
+		flag.PrintDefaults()
+		return
+	}
+
+	n := 0
+	for _, b := range []bool{*create, *list, *extract} {
+		if b {
+			n++
+		}
+	}
+	if n != 1 {
+		fmt.Printf("Fatal: Expected exactly one of -c, -t and -x.\n")
+		os.Exit(1)
+	}
+	if flag.NArg() <= 0 {
+		fmt.Printf("Fatal: Expected archive name.\n")
+		os.Exit(1)
+	}
+	arname := flag.Arg(0)
+
+	if *create {
+		a, err := gbasm.NewArchive(flag.Args()[1:])
+		if err != nil {
+			fmt.Printf("Failed to create archive %s: %s\n", arname, err)
+			os.Exit(1)
+		}
+		if err := a.Output(arname); err != nil {
+			fmt.Printf("Failed to write archive %s: %s\n", arname, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	a, err := gbasm.ReadArchive(arname)
+	if err != nil {
+		fmt.Printf("Failed to read archive %s: %s\n", arname, err)
+		os.Exit(1)
+	}
+	if *list {
+		for _, m := range a.Members {
+			fmt.Printf("%s\t%s\n", m.Name, m.Pkgname)
+			if *verbose {
+				for _, s := range m.Symbols {
+					fmt.Printf("\t%s\n", s)
+				}
+			}
+		}
+		return
+	}
+
+	want := make(map[string]bool)
+	for _, name := range flag.Args()[1:] {
+		want[name] = true
+	}
+	for _, m := range a.Members {
+		if len(want) > 0 && !want[m.Name] {
+			continue
+		}
+		delete(want, m.Name)
+		if err := os.WriteFile(filepath.Join(*dir, m.Name), m.Bytes(), 0644); err != nil {
+			fmt.Printf("Failed to extract %s: %s\n", m.Name, err)
+			os.Exit(1)
+		}
+	}
+	for name := range want {
+		fmt.Printf("Archive %s has no member %s\n", arname, name)
+		os.Exit(1)
+	}
+}
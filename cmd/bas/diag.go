@@ -0,0 +1,172 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// at is the position of the line currently being assembled, and atText
+// its text. Errors are reported against them.
+var at srcPos
+var atText string
+
+// A basError is an error reported against a position in the input.
+type basError struct {
+	pos srcPos
+	col int // 1-based column in the line, 0 if unknown
+	msg string
+}
+
+func (e basError) String() string {
+	if e.pos.file == "" {
+		return e.msg
+	}
+	s := fmt.Sprintf("%s:%d", e.pos.file, e.pos.line)
+	if e.col > 0 {
+		s += fmt.Sprintf(":%d", e.col)
+	}
+	if e.pos.via != "" {
+		s += " (" + e.pos.via + ")"
+	}
+	return s + ": " + e.msg
+}
+
+// errs collects the errors reported so far. Assembly carries on past them
+// so that one run reports every independent mistake in a file.
+var errs []basError
+
+// lineAbort is panicked by fatalf to abandon the line being assembled. The
+// line loop recovers it and moves on to the next line.
+type lineAbort struct{}
+
+// errorAt records an error at column col of the current line.
+func errorAt(col int, msg string) {
+	errs = append(errs, basError{pos: at, col: col, msg: msg})
+}
+
+// lineCol returns the column of the first non-blank character of the
+// current line.
+func lineCol() int {
+	return len(atText) - len(strings.TrimLeft(atText, " \t")) + 1
+}
+
+// fatalf reports an error at the current line and abandons it.
+func fatalf(format string, args ...interface{}) {
+	errorAt(lineCol(), fmt.Sprintf(format, args...))
+	panic(lineAbort{})
+}
+
+// fatalfField is fatalf for an error about the n'th space-separated field
+// of the current line (counting from 0, as SplitSpace does); the error
+// points at that field.
+func fatalfField(n int, format string, args ...interface{}) {
+	errorAt(fieldCol(n), fmt.Sprintf(format, args...))
+	panic(lineAbort{})
+}
+
+// fieldCol returns the column of the n'th space-separated field of the
+// current line, or of its first non-blank character if it has fewer.
+func fieldCol(n int) int {
+	col := 0
+	inField := false
+	for i := 0; i < len(atText); i++ {
+		blank := atText[i] == ' ' || atText[i] == '\t'
+		if !blank && !inField {
+			if n == 0 {
+				col = i + 1
+				break
+			}
+			n--
+		}
+		inField = !blank
+	}
+	if col == 0 {
+		col = lineCol()
+	}
+	return col
+}
+
+// relocSites holds where each data relocation was written, by the name of
+// its var or data block and its index in the Relocs, so that errors found
+// once the whole file is read can point at it.
+var relocSites = make(map[string][]basError)
+
+// assembleLines calls scan, which assembles lines until its input runs
+// out, and reports whether it got to the end. A line abandoned by fatalf
+// stops scan early; calling it again resumes at the following line.
+//
+// Panics from the assembler library are recorded against the current line
+// too. An instruction that cannot be encoded (an error value) emitted
+// nothing, so the function it belongs to can carry on. Anything else is a
+// broken allocator invariant, and the rest of the function is not worth
+// assembling: broken is set to skip it.
+func assembleLines(scan func(), broken *bool) (done bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			switch r := r.(type) {
+			case lineAbort:
+			case error:
+				errorAt(lineCol(), r.Error())
+			default:
+				errorAt(lineCol(), fmt.Sprint(r))
+				*broken = true
+			}
+			done = false
+		}
+	}()
+	scan()
+	return true
+}
+
+// isDeclaration reports whether line starts a new top-level declaration,
+// where assembly of a broken function can resume.
+func isDeclaration(line string) bool {
+	line = strings.TrimPrefix(line, "pub ")
+	word := strings.Fields(line)
+	if len(word) > 2 && word[0] == "align" {
+		word = word[2:]
+	}
+	if len(word) > 1 && word[0] == "immutable" {
+		word = word[1:]
+	}
+	if len(word) == 0 {
+		return false
+	}
+	switch word[0] {
+	case "package", "function", "data", "var", "struct", "interface", "typealias",
+		"typedesc", "typedesc_cache", "iface_desc", "values", "importhash":
+		return true
+	}
+	return false
+}
+
+// exitOnErrors prints the errors collected so far, sorted by position, and
+// exits if there are any.
+func exitOnErrors() {
+	if len(errs) == 0 {
+		return
+	}
+	sort.SliceStable(errs, func(i, j int) bool {
+		a, b := errs[i], errs[j]
+		if a.pos.file != b.pos.file {
+			// Errors without a position go last.
+			if a.pos.file == "" || b.pos.file == "" {
+				return b.pos.file == ""
+			}
+			return a.pos.file < b.pos.file
+		}
+		if a.pos.file == "" {
+			return a.msg < b.msg
+		}
+		if a.pos.line != b.pos.line {
+			return a.pos.line < b.pos.line
+		}
+		return a.col < b.col
+	})
+	for _, e := range errs {
+		fmt.Printf("Fatal: %s\n", e)
+	}
+	os.Exit(1)
+}
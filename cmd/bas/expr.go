@@ -0,0 +1,394 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/knusbaum/gbasm"
+)
+
+// An exprEnv resolves the names used in a constant expression: constants
+// defined with const/equ, and struct layouts declared with the struct
+// directive for sizeof and offsetof.
+type exprEnv struct {
+	consts map[string]int64
+	o      *gbasm.OFile
+}
+
+// isExpr reports whether tok must be an expression rather than a
+// register, symbol or plain literal.
+func isExpr(tok string) bool {
+	return strings.ContainsAny(tok, "+-*/<>&|()") && !strings.Contains(tok, "[")
+}
+
+// eval evaluates an integer expression. Expressions use the C operators and
+// precedence for multiplication, division, addition, subtraction, shifts,
+// and bitwise and and or (* / + - << >> & |), along with unary minus,
+// parentheses, decimal and hex literals, constants, sizeof(type) and
+// offsetof(struct,field). Every step is checked for int64
+// overflow; narrower operands are checked by the caller.
+func (e exprEnv) eval(s string) (int64, error) {
+	p := &exprParser{env: e, src: s}
+	p.next()
+	v, err := p.binary(0)
+	if err != nil {
+		return 0, fmt.Errorf("expression %q: %s", s, err)
+	}
+	if p.tok != "" {
+		return 0, fmt.Errorf("expression %q: unexpected %q", s, p.tok)
+	}
+	return v, nil
+}
+
+// fitsWidth reports whether v can be encoded in a bits-wide operand,
+// as either a signed or an unsigned value.
+func fitsWidth(v int64, bits int) bool {
+	if bits <= 0 || bits >= 64 {
+		return true
+	}
+	return v >= -(1<<(bits-1)) && v < 1<<bits
+}
+
+type exprParser struct {
+	env exprEnv
+	src string
+	pos int
+	tok string
+}
+
+// binops lists the binary operators from lowest to highest precedence.
+var binops = [][]string{
+	{"|"},
+	{"&"},
+	{"<<", ">>"},
+	{"+", "-"},
+	{"*", "/"},
+}
+
+// next advances p.tok to the next token, or "" at the end of input.
+func (p *exprParser) next() {
+	for p.pos < len(p.src) && (p.src[p.pos] == ' ' || p.src[p.pos] == '\t') {
+		p.pos++
+	}
+	if p.pos >= len(p.src) {
+		p.tok = ""
+		return
+	}
+	start := p.pos
+	c := p.src[p.pos]
+	switch {
+	case c == '<' || c == '>':
+		p.pos++
+		if p.pos < len(p.src) && p.src[p.pos] == c {
+			p.pos++
+		}
+	case isWordByte(c):
+		for p.pos < len(p.src) && isWordByte(p.src[p.pos]) {
+			p.pos++
+		}
+	default:
+		p.pos++
+	}
+	p.tok = p.src[start:p.pos]
+}
+
+func isWordByte(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+func (p *exprParser) binary(level int) (int64, error) {
+	if level == len(binops) {
+		return p.unary()
+	}
+	l, err := p.binary(level + 1)
+	if err != nil {
+		return 0, err
+	}
+	for {
+		op := ""
+		for _, o := range binops[level] {
+			if p.tok == o {
+				op = o
+			}
+		}
+		if op == "" {
+			return l, nil
+		}
+		p.next()
+		r, err := p.binary(level + 1)
+		if err != nil {
+			return 0, err
+		}
+		if l, err = apply(op, l, r); err != nil {
+			return 0, err
+		}
+	}
+}
+
+func apply(op string, l, r int64) (int64, error) {
+	overflow := fmt.Errorf("%d %s %d overflows 64 bits", l, op, r)
+	switch op {
+	case "+":
+		v := l + r
+		if (l > 0 && r > 0 && v < 0) || (l < 0 && r < 0 && v >= 0) {
+			return 0, overflow
+		}
+		return v, nil
+	case "-":
+		v := l - r
+		if (l >= 0 && r < 0 && v < 0) || (l < 0 && r > 0 && v >= 0) {
+			return 0, overflow
+		}
+		return v, nil
+	case "*":
+		if l == 0 || r == 0 {
+			return 0, nil
+		}
+		v := l * r
+		if v/r != l || (l == -1 && r == math.MinInt64) || (r == -1 && l == math.MinInt64) {
+			return 0, overflow
+		}
+		return v, nil
+	case "/":
+		if r == 0 {
+			return 0, fmt.Errorf("division by zero")
+		}
+		if l == math.MinInt64 && r == -1 {
+			return 0, overflow
+		}
+		return l / r, nil
+	case "<<":
+		if r < 0 || r > 63 {
+			return 0, fmt.Errorf("shift count %d out of range", r)
+		}
+		v := l << r
+		if v>>r != l {
+			return 0, overflow
+		}
+		return v, nil
+	case ">>":
+		if r < 0 || r > 63 {
+			return 0, fmt.Errorf("shift count %d out of range", r)
+		}
+		return l >> r, nil
+	case "&":
+		return l & r, nil
+	case "|":
+		return l | r, nil
+	}
+	return 0, fmt.Errorf("unknown operator %q", op)
+}
+
+func (p *exprParser) unary() (int64, error) {
+	switch p.tok {
+	case "-":
+		p.next()
+		// Negate decimal literals as they are parsed, so the most
+		// negative int64 can be written.
+		if p.tok != "" && p.tok[0] >= '1' && p.tok[0] <= '9' {
+			if v, err := strconv.ParseInt("-"+p.tok, 10, 64); err == nil {
+				p.next()
+				return v, nil
+			}
+		}
+		v, err := p.unary()
+		if err != nil {
+			return 0, err
+		}
+		if v == math.MinInt64 {
+			return 0, fmt.Errorf("-(%d) overflows 64 bits", v)
+		}
+		return -v, nil
+	case "+":
+		p.next()
+		return p.unary()
+	case "(":
+		p.next()
+		v, err := p.binary(0)
+		if err != nil {
+			return 0, err
+		}
+		if p.tok != ")" {
+			return 0, fmt.Errorf("missing ')'")
+		}
+		p.next()
+		return v, nil
+	case "":
+		return 0, fmt.Errorf("unexpected end")
+	}
+
+	tok := p.tok
+	if !isWordByte(tok[0]) {
+		return 0, fmt.Errorf("unexpected %q", tok)
+	}
+	if tok[0] >= '0' && tok[0] <= '9' {
+		p.next()
+		if strings.HasPrefix(tok, "0x") {
+			// Hex literals may use all 64 bits, for masks.
+			u, err := strconv.ParseUint(tok[2:], 16, 64)
+			if err != nil {
+				return 0, fmt.Errorf("bad hex literal %s", tok)
+			}
+			return int64(u), nil
+		}
+		v, err := strconv.ParseInt(tok, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("bad integer literal %s", tok)
+		}
+		return v, nil
+	}
+	if tok == "sizeof" || tok == "offsetof" {
+		args, err := p.call()
+		if err != nil {
+			return 0, fmt.Errorf("%s: %s", tok, err)
+		}
+		if tok == "sizeof" {
+			if len(args) != 1 {
+				return 0, fmt.Errorf("sizeof takes one type, but have %v", args)
+			}
+			return p.env.sizeof(args[0], 0)
+		}
+		if len(args) != 2 {
+			return 0, fmt.Errorf("offsetof takes a struct and a field, but have %v", args)
+		}
+		return p.env.offsetof(args[0], args[1])
+	}
+	v, ok := p.env.consts[tok]
+	if !ok {
+		return 0, fmt.Errorf("undefined constant %s", tok)
+	}
+	p.next()
+	return v, nil
+}
+
+// call reads the parenthesized, comma-separated arguments of sizeof or
+// offsetof. They are type and field names, so they are taken verbatim.
+func (p *exprParser) call() ([]string, error) {
+	if p.pos >= len(p.src) || p.src[p.pos] != '(' {
+		return nil, fmt.Errorf("missing '('")
+	}
+	depth := 0
+	start := p.pos + 1
+	for i := p.pos; i < len(p.src); i++ {
+		switch p.src[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				args := strings.Split(p.src[start:i], ",")
+				for j := range args {
+					args[j] = strings.TrimSpace(args[j])
+				}
+				p.pos = i + 1
+				p.next()
+				return args, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("missing ')'")
+}
+
+// maxTypeDepth bounds the nesting sizeof follows, catching structs that
+// contain themselves.
+const maxTypeDepth = 32
+
+// sizeof returns the size in bytes of a type as written in a struct field,
+// laid out the way bosc lays it out: fields are packed in order, pointers
+// and function pointers take 8 bytes, slices and interfaces 16.
+func (e exprEnv) sizeof(t string, depth int) (int64, error) {
+	if depth > maxTypeDepth {
+		return 0, fmt.Errorf("type %s nests too deeply", t)
+	}
+	t = strings.TrimSpace(t)
+	for _, q := range []string{"owned ", "mut "} {
+		t = strings.TrimSpace(strings.TrimPrefix(t, q))
+	}
+	switch {
+	case t == "":
+		return 0, fmt.Errorf("empty type")
+	case strings.HasPrefix(t, "*"), strings.HasPrefix(t, "fn("):
+		return 8, nil
+	case strings.HasPrefix(t, "(") && strings.HasSuffix(t, ")"):
+		return e.sizeof(t[1:len(t)-1], depth+1)
+	case strings.HasSuffix(t, "]"):
+		open := strings.LastIndexByte(t, '[')
+		if open < 0 {
+			return 0, fmt.Errorf("bad type %s", t)
+		}
+		if open == len(t)-2 {
+			return 16, nil
+		}
+		n, err := strconv.ParseInt(t[open+1:len(t)-1], 10, 64)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("bad array length in %s", t)
+		}
+		elem, err := e.sizeof(t[:open], depth+1)
+		if err != nil {
+			return 0, err
+		}
+		return apply("*", elem, n)
+	}
+	switch t {
+	case "i64", "u64":
+		return 8, nil
+	case "i32", "u32":
+		return 4, nil
+	case "i16", "u16":
+		return 2, nil
+	case "i8", "u8", "byte", "bool":
+		return 1, nil
+	}
+	if e.o != nil {
+		if s := e.o.Structs[t]; s != nil {
+			var size int64
+			for _, f := range s.Fields {
+				fs, err := e.sizeof(f.Type, depth+1)
+				if err != nil {
+					return 0, err
+				}
+				if size, err = apply("+", size, fs); err != nil {
+					return 0, err
+				}
+			}
+			return size, nil
+		}
+		if a := e.o.TypeAliases[t]; a != nil {
+			return e.sizeof(a.Underlying, depth+1)
+		}
+		if e.o.Interfaces[t] != nil {
+			return 16, nil
+		}
+		if v := e.o.Values[t]; v != nil {
+			return e.sizeof(v.TagType, depth+1)
+		}
+	}
+	return 0, fmt.Errorf("unknown type %s", t)
+}
+
+// offsetof returns the byte offset of field in the struct named st.
+func (e exprEnv) offsetof(st, field string) (int64, error) {
+	var s *gbasm.StructShape
+	if e.o != nil {
+		s = e.o.Structs[st]
+	}
+	if s == nil {
+		return 0, fmt.Errorf("unknown struct %s", st)
+	}
+	var off int64
+	for _, f := range s.Fields {
+		if f.Name == field {
+			return off, nil
+		}
+		fs, err := e.sizeof(f.Type, 1)
+		if err != nil {
+			return 0, err
+		}
+		if off, err = apply("+", off, fs); err != nil {
+			return 0, err
+		}
+	}
+	return 0, fmt.Errorf("struct %s has no field %s", st, field)
+}
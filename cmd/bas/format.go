@@ -0,0 +1,41 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/knusbaum/gbasm"
+)
+
+var format = flag.String("format", "bo", "Write the object file in this format: bo, or elf for an ELF64 relocatable object")
+
+// objectExt is the extension of the file the package is written to when
+// -o is not given.
+func objectExt() string {
+	if *format == "elf" {
+		return ".o"
+	}
+	return ".bo"
+}
+
+// writeObject writes o to o.Filename in the format named by -format.
+func writeObject(o *gbasm.OFile) error {
+	if *format != "elf" {
+		return o.Output()
+	}
+	f, err := os.Create(o.Filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return o.WriteELF(f)
+}
+
+// checkFormat exits if -format names no format bas can write.
+func checkFormat() {
+	if *format != "bo" && *format != "elf" {
+		fmt.Printf("Fatal: Unknown object format %q; expected bo or elf.\n", *format)
+		os.Exit(1)
+	}
+}
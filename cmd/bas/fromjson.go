@@ -0,0 +1,33 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/knusbaum/gbasm"
+)
+
+var fromJSON = flag.Bool("from-json", false, "Write the object file dumped by bdump -json to the file named by the argument, instead of assembling")
+
+// assembleJSON writes the .bo dumped to filename by bdump -json.
+func assembleJSON(filename string) {
+	f, err := os.Open(filename)
+	if err != nil {
+		fmt.Printf("Fatal: %s\n", err)
+		os.Exit(1)
+	}
+	defer f.Close()
+	o, err := gbasm.ReadJSON(f)
+	if err != nil {
+		fmt.Printf("Fatal: Failed to read %s: %s\n", filename, err)
+		os.Exit(1)
+	}
+	if *out != "" {
+		o.Filename = *out
+	}
+	if err := writeObject(o); err != nil {
+		fmt.Printf("Fatal: Failed to write object file: %s\n", err)
+		os.Exit(1)
+	}
+}
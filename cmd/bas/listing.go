@@ -0,0 +1,65 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/knusbaum/gbasm"
+)
+
+var listing = flag.String("l", "", "Write an assembly listing to this file")
+
+// writeListing writes the listing of every function in o to path. Each
+// source line is followed by the instructions it produced: their offsets,
+// bytes and encoder forms, the locations its variables resolved to, and
+// the spills and reloads the register allocator inserted for it.
+func writeListing(path string, o *gbasm.OFile) error {
+	var fs []*gbasm.Function
+	for _, f := range o.Funcs {
+		fs = append(fs, f)
+	}
+	sort.Slice(fs, func(i, j int) bool {
+		if fs[i].SrcFile != fs[j].SrcFile {
+			return fs[i].SrcFile < fs[j].SrcFile
+		}
+		return fs[i].SrcLine < fs[j].SrcLine
+	})
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	w := bufio.NewWriter(file)
+	for _, f := range fs {
+		body, err := f.Body()
+		if err != nil {
+			return fmt.Errorf("function %s: %s", f.Name, err)
+		}
+		fmt.Fprintf(w, "function %s.%s (%s:%d)\n", o.Pkgname, f.Name, f.SrcFile, f.SrcLine)
+		src := ""
+		for _, e := range f.Listing() {
+			if e.Source != src {
+				src = e.Source
+				fmt.Fprintf(w, "%s\n", src)
+			}
+			var note string
+			if e.Note != "" {
+				note = "; " + e.Note
+			} else {
+				note = strings.Join(e.Allocs, " ")
+			}
+			line := fmt.Sprintf("    %04x  %-30s %-24s %s", e.Offset, fmt.Sprintf("% x", body[e.Offset:e.Offset+e.Len]), e.Form, note)
+			fmt.Fprintf(w, "%s\n", strings.TrimRight(line, " "))
+		}
+		fmt.Fprintf(w, "\n")
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	return file.Close()
+}
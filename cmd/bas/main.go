@@ -1,7 +1,6 @@
 package main
 
 import (
-	"bufio"
 	"bytes"
 	"errors"
 	"flag"
@@ -167,8 +166,7 @@ func basUnquoteSigTok(tok string) string {
 func mustParseU64(directive, name, field, val string) uint64 {
 	n, err := strconv.ParseUint(val, 10, 64)
 	if err != nil {
-		fmt.Printf("Fatal: %s %s: bad %s %q: %v\n", directive, name, field, val, err)
-		os.Exit(1)
+		fatalf("%s %s: bad %s %q: %v", directive, name, field, val, err)
 	}
 	return n
 }
@@ -184,8 +182,7 @@ func parseSlotMasks(directive, name string, toks []string) []uint64 {
 	for i, t := range toks {
 		n, err := strconv.ParseUint(t, 0, 64)
 		if err != nil {
-			fmt.Printf("Fatal: %s %s: bad slot mask %q: %v\n", directive, name, t, err)
-			os.Exit(1)
+			fatalf("%s %s: bad slot mask %q: %v", directive, name, t, err)
 		}
 		masks[i] = n
 	}
@@ -217,13 +214,32 @@ func parseSizePrefix(s string) (bits int, rest string, ok bool) {
 	return 0, "", false
 }
 
-func ParseIndirect(o *gbasm.OFile, f *gbasm.Function, s string) (indirect any, err error) {
-	//(base, index string, scale int, err error) {
-	r := regexp.MustCompile(`\[([_a-zA-Z0-9]+)\s*(([+-])\s*([_x0-9a-zA-Z]+))?\s*(\*\s*([x0-9]+))?\]`)
+// operandWidth returns the width in bits of an instruction operand, or 0 if
+// it has none of its own (an immediate or an unsized memory operand).
+func operandWidth(arg interface{}) int {
+	switch a := arg.(type) {
+	case gbasm.Register:
+		return a.Width()
+	case *gbasm.Ralloc:
+		return a.RegSize()
+	case *gbasm.RallocPartial:
+		return a.Bits
+	case gbasm.Indirect:
+		return a.Size
+	}
+	return 0
+}
+
+// ParseIndirect parses a memory operand, [base], [base+disp] or
+// [base+index*scale]. The displacement and scale are expressions.
+func ParseIndirect(o *gbasm.OFile, f *gbasm.Function, env exprEnv, s string) (indirect any, err error) {
+	r := regexp.MustCompile(`^\[\s*([_a-zA-Z0-9]+)\s*(.*?)\s*\]$`)
 	parts := r.FindStringSubmatch(s)
+	if parts == nil {
+		return nil, fmt.Errorf("Malformed indirection %q", s)
+	}
 	base := parts[1]
-	index := parts[4]
-	scale := parts[6]
+	rest := parts[2]
 
 	var baser gbasm.Register
 	var baseSym string // when set, base is a global symbol; baser is ignored
@@ -233,15 +249,14 @@ func ParseIndirect(o *gbasm.OFile, f *gbasm.Function, s string) (indirect any, e
 		baser = a.Register()
 	} else if o != nil && (o.Vars[base] != nil || o.Data[base] != nil) {
 		// Global symbol: addressing becomes RIP-relative to this name,
-		// with any index expression below baked into the relocation.
+		// with any displacement below baked into the relocation.
 		baseSym = base
 	} else {
 		return nil, fmt.Errorf("Base %q was neither a register, a local variable, nor a known global symbol.", base)
 	}
 
 	// indirectFor builds the right Indirect flavor (register-relative
-	// or RIP-relative-to-symbol) given the parsed offset. Used by the
-	// three integer-index and no-index branches below.
+	// or RIP-relative-to-symbol) given the parsed offset.
 	indirectFor := func(off int32) gbasm.Indirect {
 		if baseSym != "" {
 			return gbasm.Indirect{Symbol: baseSym, Off: off}
@@ -249,70 +264,104 @@ func ParseIndirect(o *gbasm.OFile, f *gbasm.Function, s string) (indirect any, e
 		return gbasm.Indirect{Reg: baser, Off: off}
 	}
 
-	// figure out if index is an int or register
-	if strings.HasPrefix(index, "0x") {
-		i, err := strconv.ParseInt(strings.TrimPrefix(index, "0x"), 16, 32)
-		if err != nil {
-			return nil, err
-		}
-		if scale != "" {
-			return nil, fmt.Errorf("Cannot index with scale with scale literal")
-		}
-		if parts[3] != "+" {
-			return nil, fmt.Errorf("Cannot subtract hex literal in scale")
-		}
-		return indirectFor(int32(i)), nil
+	if rest == "" {
+		return indirectFor(0), nil
 	}
-	i, err := strconv.ParseInt(index, 10, 32)
-	if err == nil {
-		if scale != "" {
-			return nil, fmt.Errorf("Cannot index with scale with scale literal")
-		}
-		if parts[3] == "-" {
-			i = -i
-		}
-		return indirectFor(int32(i)), nil
+	if rest[0] != '+' && rest[0] != '-' {
+		return nil, fmt.Errorf("Expected '+' or '-' after base %s, but have %q", base, rest)
 	}
 
-	if index == "" {
-		if scale != "" {
-			return nil, fmt.Errorf("Cannot have scale without index.")
+	// A register or register-allocated variable after the sign is an index,
+	// optionally scaled and followed by a displacement.
+	ri := regexp.MustCompile(`^([+-])\s*([_a-zA-Z0-9]+)\s*(.*)$`)
+	if m := ri.FindStringSubmatch(rest); m != nil {
+		var indexr gbasm.Register
+		isIndex := true
+		if reg, err := gbasm.ParseReg(m[2]); err == nil {
+			indexr = reg
+		} else if a := f.AllocFor(m[2]); a != nil {
+			indexr = a.Register()
+		} else {
+			isIndex = false
+		}
+		if isIndex {
+			// Can't combine with a RIP-relative base: x86-64 RIP
+			// addressing is "RIP + disp32" only, with no base or index
+			// register.
+			if baseSym != "" {
+				return nil, fmt.Errorf("global symbol %q cannot be combined with a register index — x86-64 RIP-relative addressing takes a disp32 only.", baseSym)
+			}
+			if m[1] == "-" {
+				return nil, fmt.Errorf("Cannot subtract index %s", m[2])
+			}
+			tail := m[3]
+			scale := int64(1)
+			if strings.HasPrefix(tail, "*") {
+				// The scale is a single term, so [rbx+rcx*8+16] scales
+				// by 8 and adds 16.
+				var term string
+				term, tail = splitTerm(strings.TrimSpace(tail[1:]))
+				scale, err = env.eval(term)
+				if err != nil {
+					return nil, err
+				}
+				if scale != 1 && scale != 2 && scale != 4 && scale != 8 {
+					return nil, fmt.Errorf("Scale must be an integer in [1, 2, 4, 8], but got %v", scale)
+				}
+			}
+			var disp int64
+			if tail = strings.TrimSpace(tail); tail != "" {
+				if tail[0] != '+' && tail[0] != '-' {
+					return nil, fmt.Errorf("Expected '+' or '-' after index %s, but have %q", m[2], tail)
+				}
+				disp, err = env.eval(tail)
+				if err != nil {
+					return nil, err
+				}
+				if disp < math.MinInt32 || disp > math.MaxInt32 {
+					return nil, fmt.Errorf("Displacement %s = %d does not fit in 32 bits", tail, disp)
+				}
+			}
+			return gbasm.IndirectBaseIndexScale{
+				Base:  baser,
+				Index: indexr,
+				Scale: int(scale),
+				Off:   int32(disp),
+			}, nil
 		}
-		return indirectFor(0), nil
 	}
 
-	// Not an integer index — a register-scaled index. Can't combine with
-	// a RIP-relative base: x86-64 RIP addressing is "RIP + disp32" only,
-	// with no base or index register.
-	if baseSym != "" {
-		return nil, fmt.Errorf("global symbol %q cannot be combined with a register index — x86-64 RIP-relative addressing takes a disp32 only.", baseSym)
+	// Otherwise the sign starts the displacement expression.
+	disp, err := env.eval(rest)
+	if err != nil {
+		return nil, err
 	}
-	var indexr gbasm.Register
-	if reg, err := gbasm.ParseReg(index); err == nil {
-		indexr = reg
-	} else if a := f.AllocFor(index); a != nil {
-		indexr = a.Register()
-	} else {
-		return nil, fmt.Errorf("Index was neither an integer, nor a register, nor a variable.")
+	if disp < math.MinInt32 || disp > math.MaxInt32 {
+		return nil, fmt.Errorf("Displacement %s = %d does not fit in 32 bits", rest, disp)
 	}
+	return indirectFor(int32(disp)), nil
+}
 
-	scalei := 1
-	if scale != "" {
-		i, err := strconv.ParseInt(scale, 10, 32)
-		if err != nil {
-			return nil, fmt.Errorf("Scale must be an integer in [1, 2, 4, 8], but got %v", scale)
-		}
-		if i != 1 && i != 2 && i != 4 && i != 8 {
-			return nil, fmt.Errorf("Scale must be an integer in [1, 2, 4, 8], but got %v", i)
+// splitTerm splits s after its first term: a name or number, a
+// parenthesized expression, or a call such as sizeof(T).
+func splitTerm(s string) (term, rest string) {
+	i := 0
+	for i < len(s) && (s[i] == '_' || s[i] >= 'a' && s[i] <= 'z' || s[i] >= 'A' && s[i] <= 'Z' || s[i] >= '0' && s[i] <= '9') {
+		i++
+	}
+	if i < len(s) && s[i] == '(' {
+		for depth := 0; i < len(s); i++ {
+			if s[i] == '(' {
+				depth++
+			} else if s[i] == ')' {
+				if depth--; depth == 0 {
+					i++
+					break
+				}
+			}
 		}
-		scalei = int(i)
 	}
-
-	return gbasm.IndirectBaseIndexScale{
-		Base:  baser,
-		Index: indexr,
-		Scale: scalei,
-	}, nil
+	return s[:i], s[i:]
 }
 
 var out = flag.String("o", "", "Write the linked executable to this file")
@@ -324,7 +373,11 @@ func main() {
 	// unreadable Go tracebacks.
 	defer func() {
 		if r := recover(); r != nil {
-			fmt.Fprintf(os.Stderr, "Fatal: %v\n", r)
+			if at.file != "" {
+				fmt.Fprintf(os.Stderr, "Fatal: %s: %v\n", at, r)
+			} else {
+				fmt.Fprintf(os.Stderr, "Fatal: %v\n", r)
+			}
 			os.Exit(1)
 		}
 	}()
@@ -341,1110 +394,1274 @@ func main() {
 		fmt.Printf("Fatal: Expected file name to open.\n")
 		os.Exit(1)
 	}
+	checkFormat()
+	if *fromJSON {
+		if flag.NArg() != 1 {
+			fmt.Printf("Fatal: -from-json expects one file.\n")
+			os.Exit(1)
+		}
+		assembleJSON(flag.Arg(0))
+		return
+	}
+	if *regalloc != "lru" && *regalloc != "scan" {
+		fmt.Printf("Fatal: Unknown register allocation %q; expected lru or scan.\n", *regalloc)
+		os.Exit(1)
+	}
 
 	var o *gbasm.OFile
 	for fi := 0; fi < flag.NArg(); fi++ {
 		fmt.Printf("Assembling %s\n", flag.Arg(fi))
-		file, err := os.Open(flag.Arg(fi))
+		src, err := newSource(flag.Arg(fi))
 		if err != nil {
-			fmt.Printf("Fatal: %s", err)
+			fmt.Printf("Fatal: %s\n", err)
+			os.Exit(1)
 		}
-		defer file.Close()
+		defer src.Close()
+		src.o = o
 
 		var f *gbasm.Function
+		// plan holds the registers planned for f's locals with -ra=scan.
+		var plan *regPlan
+		// sourceLines is set once f has a line directive. Until then its
+		// code is attributed to the lines of the .bs itself.
+		sourceLines := false
+		// lineDirective is the position given by a line directive on the
+		// previous line, if it had one, and declared on the line before
+		// that. A var declared right after a line directive was declared
+		// at the position it gives.
+		var lineDirective, declared *srcPos
 		//var locals map[string]*gbasm.Ralloc
-		scanner := bufio.NewScanner(file)
-		ln := 0
-	lines:
-		for scanner.Scan() {
-			ln++
-			line := strings.TrimSpace(scanner.Text())
-			if line == "" {
-				continue
-			}
-			if strings.HasPrefix(line, "//") {
-				continue
-			}
-			//fmt.Printf("INPUT %v\n", line)
-			if strings.HasPrefix(line, "package") {
-				pkgname := strings.TrimSpace(strings.TrimPrefix(line, "package"))
-				if *out == "" {
-					*out = pkgname + ".bo"
+		// broken is set when the assembler library panics part way through
+		// a function, leaving it in no state to assemble the rest of.
+		broken := false
+		for !assembleLines(func() {
+		lines:
+			for src.Scan() {
+				line := strings.TrimSpace(src.Text())
+				if line == "" {
+					continue
 				}
-				if o == nil {
-					o, err = gbasm.NewOFile(*out, pkgname)
-					if err != nil {
-						fmt.Printf("Failed to create ofile: %s\n", err)
-						os.Exit(1)
+				if strings.HasPrefix(line, "//") {
+					continue
+				}
+				if broken {
+					if !isDeclaration(line) {
+						continue
 					}
-				} else {
-					if o.Pkgname != pkgname {
-						fmt.Printf("Creating package %s: file %s has package name %s.\n", o.Pkgname, flag.Arg(fi), pkgname)
-						os.Exit(1)
+					broken = false
+				}
+				//fmt.Printf("INPUT %v\n", line)
+				declared, lineDirective = lineDirective, nil
+				if f != nil {
+					f.ListSource(fmt.Sprintf("%s: %s", at, line))
+					if !sourceLines {
+						f.SourceLine(at.file, at.line)
 					}
 				}
-				continue
-			} else if o == nil {
-				fmt.Printf("Fatal: Need package declaration before anything else.")
-				os.Exit(1)
-			}
-			isPub := false
-			if strings.HasPrefix(line, "pub ") {
-				isPub = true
-				line = strings.TrimSpace(strings.TrimPrefix(line, "pub "))
-			}
-			if strings.HasPrefix(line, "typealias ") {
-				// Single-line directive:
-				//   typealias Name underlying [method1 method2 ...]
-				parts := strings.Fields(strings.TrimPrefix(line, "typealias "))
-				if len(parts) < 2 {
-					fmt.Printf("Fatal: typealias directive: expected name and underlying type\n")
-					os.Exit(1)
-				}
-				aname := parts[0]
-				underlying := parts[1]
-				methods := parts[2:]
-				if err := o.AddTypeAlias(aname, underlying, methods, isPub); err != nil {
-					fmt.Printf("Fatal: typealias %s: %s\n", aname, err)
-					os.Exit(1)
-				}
-				continue
-			}
-			if strings.HasPrefix(line, "interface") {
-				// Multi-line directive:
-				//   interface Name {
-				//     method methodName {
-				//       param paramName paramType
-				//       ...
-				//       return returnType
-				//     }
-				//     ...
-				//   }
-				// Inside a method block the lines are:
-				//   "param <name> <type-with-spaces>"
-				//   "return <type-with-spaces>"
-				// `param` lines record the receiver and other parameters
-				// in declaration order; the receiver type is conventionally
-				// "*self" or some variant. Comments and blank lines are
-				// permitted in the body.
-				rest := strings.TrimSpace(strings.TrimPrefix(line, "interface"))
-				openIdx := strings.IndexByte(rest, '{')
-				if openIdx < 0 {
-					fmt.Printf("Fatal: interface directive: missing '{' on the same line, got: %q\n", line)
-					os.Exit(1)
-				}
-				iname := strings.TrimSpace(rest[:openIdx])
-				if iname == "" {
-					fmt.Printf("Fatal: interface directive: missing name before '{'\n")
-					os.Exit(1)
-				}
-				var methods []gbasm.InterfaceMethodShape
-				for {
-					if !scanner.Scan() {
-						fmt.Printf("Fatal: interface %s: unexpected EOF before '}'\n", iname)
-						os.Exit(1)
-					}
-					ln++
-					body := strings.TrimSpace(scanner.Text())
-					if body == "" || strings.HasPrefix(body, "//") {
+				if strings.HasPrefix(line, "package") {
+					pkgname := strings.TrimSpace(strings.TrimPrefix(line, "package"))
+					if *out == "" {
+						*out = pkgname + objectExt()
+					}
+					if o == nil {
+						o, err = gbasm.NewOFile(*out, pkgname)
+						if err != nil {
+							fatalf("Failed to create ofile: %s", err)
+						}
+						src.o = o
+					} else {
+						if o.Pkgname != pkgname {
+							fatalf("Creating package %s: file %s has package name %s.", o.Pkgname, flag.Arg(fi), pkgname)
+						}
+					}
+					continue
+				} else if o == nil {
+					// Nothing can be assembled without a package; report
+					// this once rather than for every line.
+					errorAt(lineCol(), "Need package declaration before anything else.")
+					exitOnErrors()
+				}
+				isPub := false
+				if strings.HasPrefix(line, "pub ") {
+					isPub = true
+					line = strings.TrimSpace(strings.TrimPrefix(line, "pub "))
+				}
+				// "align N" before a function, var or data declaration sets
+				// the boundary the linker places it on. On a line of its
+				// own inside a function it pads the code with NOPs up to
+				// the next multiple of N.
+				declAlign := 0
+				if strings.HasPrefix(line, "align ") {
+					alignField := 1 // the field holding N, for errors
+					if isPub {
+						alignField++
+					}
+					parts := SplitNSpace(strings.TrimSpace(strings.TrimPrefix(line, "align ")), 2)
+					v, err := src.env().eval(parts[0])
+					if err != nil {
+						fatalfField(alignField, "align: %s", err)
+					}
+					if len(parts) == 1 {
+						if f == nil {
+							fatalf("align outside a function must come before a function, var or data declaration")
+						}
+						if err := f.AlignTo(int(v)); err != nil {
+							fatalfField(alignField, "%s", err)
+						}
 						continue
 					}
-					if body == "}" {
-						break
+					line = parts[1]
+					if !strings.HasPrefix(line, "function") && !strings.HasPrefix(line, "var") && !strings.HasPrefix(line, "data") && !strings.HasPrefix(line, "immutable ") {
+						fatalf("align can only come before a function, var or data declaration, but have %q", line)
+					}
+					declAlign = int(v)
+				}
+				// "immutable var" declares a var its package never writes
+				// after initialization. Importers reject writes to it.
+				isConst := false
+				if strings.HasPrefix(line, "immutable ") {
+					isConst = true
+					line = strings.TrimSpace(strings.TrimPrefix(line, "immutable "))
+					if !strings.HasPrefix(line, "var") {
+						fatalf("immutable can only come before a var declaration, but have %q", line)
+					}
+				}
+				if strings.HasPrefix(line, "typealias ") {
+					// Single-line directive:
+					//   typealias Name underlying [method1 method2 ...]
+					parts := strings.Fields(strings.TrimPrefix(line, "typealias "))
+					if len(parts) < 2 {
+						fatalf("typealias directive: expected name and underlying type")
 					}
-					if !strings.HasPrefix(body, "method ") {
-						fmt.Printf("Fatal: interface %s: expected 'method <name> {' or '}', got %q\n", iname, body)
-						os.Exit(1)
+					aname := parts[0]
+					underlying := parts[1]
+					methods := parts[2:]
+					if err := o.AddTypeAlias(aname, underlying, methods, isPub); err != nil {
+						fatalf("typealias %s: %s", aname, err)
 					}
-					headRest := strings.TrimSpace(strings.TrimPrefix(body, "method"))
-					mOpen := strings.IndexByte(headRest, '{')
-					if mOpen < 0 {
-						fmt.Printf("Fatal: interface %s: method directive missing '{' on the same line, got: %q\n", iname, body)
-						os.Exit(1)
+					continue
+				}
+				if strings.HasPrefix(line, "importhash ") {
+					// Single-line directive, from bosc:
+					//   importhash pkg hash
+					// records the export hash of an import the
+					// package was compiled against.
+					parts := strings.Fields(strings.TrimPrefix(line, "importhash "))
+					if len(parts) != 2 {
+						fatalf("importhash directive: expected package and hash")
 					}
-					mname := strings.TrimSpace(headRest[:mOpen])
-					if mname == "" {
-						fmt.Printf("Fatal: interface %s: method directive missing name before '{'\n", iname)
-						os.Exit(1)
+					if err := o.AddImportHash(parts[0], parts[1]); err != nil {
+						fatalf("importhash: %s", err)
 					}
-					var params []gbasm.FieldShape
-					var retType string
-					var retAliases [][]int
-					sawReturn := false
+					continue
+				}
+				if strings.HasPrefix(line, "interface") {
+					// Multi-line directive:
+					//   interface Name {
+					//     method methodName {
+					//       param paramName paramType
+					//       ...
+					//       return returnType
+					//     }
+					//     ...
+					//   }
+					// Inside a method block the lines are:
+					//   "param <name> <type-with-spaces>"
+					//   "return <type-with-spaces>"
+					// `param` lines record the receiver and other parameters
+					// in declaration order; the receiver type is conventionally
+					// "*self" or some variant. Comments and blank lines are
+					// permitted in the body.
+					rest := strings.TrimSpace(strings.TrimPrefix(line, "interface"))
+					openIdx := strings.IndexByte(rest, '{')
+					if openIdx < 0 {
+						fatalf("interface directive: missing '{' on the same line, got: %q", line)
+					}
+					iname := strings.TrimSpace(rest[:openIdx])
+					if iname == "" {
+						fatalf("interface directive: missing name before '{'")
+					}
+					var methods []gbasm.InterfaceMethodShape
 					for {
-						if !scanner.Scan() {
-							fmt.Printf("Fatal: interface %s: method %s: unexpected EOF before '}'\n", iname, mname)
-							os.Exit(1)
+						if !src.ScanRaw() {
+							fatalf("interface %s: unexpected EOF before '}'", iname)
 						}
-						ln++
-						mbody := strings.TrimSpace(scanner.Text())
-						if mbody == "" || strings.HasPrefix(mbody, "//") {
+						body := strings.TrimSpace(src.Text())
+						if body == "" || strings.HasPrefix(body, "//") {
 							continue
 						}
-						if mbody == "}" {
+						if body == "}" {
 							break
 						}
-						if strings.HasPrefix(mbody, "param ") {
-							rest := strings.TrimSpace(strings.TrimPrefix(mbody, "param"))
-							sp := strings.IndexAny(rest, " \t")
-							if sp < 0 {
-								fmt.Printf("Fatal: interface %s: method %s: param line %q lacks a type\n", iname, mname, mbody)
-								os.Exit(1)
-							}
-							pname := rest[:sp]
-							ptype := strings.TrimSpace(rest[sp+1:])
-							if ptype == "" {
-								fmt.Printf("Fatal: interface %s: method %s: param %s has empty type\n", iname, mname, pname)
-								os.Exit(1)
-							}
-							params = append(params, gbasm.FieldShape{Name: pname, Type: ptype})
-							continue
+						if !strings.HasPrefix(body, "method ") {
+							fatalf("interface %s: expected 'method <name> {' or '}', got %q", iname, body)
 						}
-						if strings.HasPrefix(mbody, "return ") {
-							retType = strings.TrimSpace(strings.TrimPrefix(mbody, "return"))
-							sawReturn = true
-							continue
+						headRest := strings.TrimSpace(strings.TrimPrefix(body, "method"))
+						mOpen := strings.IndexByte(headRest, '{')
+						if mOpen < 0 {
+							fatalf("interface %s: method directive missing '{' on the same line, got: %q", iname, body)
+						}
+						mname := strings.TrimSpace(headRest[:mOpen])
+						if mname == "" {
+							fatalf("interface %s: method directive missing name before '{'", iname)
 						}
-						if strings.HasPrefix(mbody, "retaliases ") {
-							// retaliases <slot>: <idx> <idx>...  (declared borrow contract)
-							rest := strings.TrimSpace(strings.TrimPrefix(mbody, "retaliases"))
-							colon := strings.IndexByte(rest, ':')
-							if colon < 0 {
-								fmt.Printf("Fatal: interface %s: method %s: retaliases line %q lacks ':'\n", iname, mname, mbody)
-								os.Exit(1)
+						var params []gbasm.FieldShape
+						var retType string
+						var retAliases [][]int
+						sawReturn := false
+						for {
+							if !src.ScanRaw() {
+								fatalf("interface %s: method %s: unexpected EOF before '}'", iname, mname)
 							}
-							slot, err := strconv.Atoi(strings.TrimSpace(rest[:colon]))
-							if err != nil || slot < 0 {
-								fmt.Printf("Fatal: interface %s: method %s: bad retaliases slot in %q\n", iname, mname, mbody)
-								os.Exit(1)
+							mbody := strings.TrimSpace(src.Text())
+							if mbody == "" || strings.HasPrefix(mbody, "//") {
+								continue
 							}
-							var idxs []int
-							for _, tok := range strings.Fields(rest[colon+1:]) {
-								v, verr := strconv.Atoi(tok)
-								if verr != nil || v < 0 {
-									fmt.Printf("Fatal: interface %s: method %s: bad retaliases index %q\n", iname, mname, tok)
-									os.Exit(1)
+							if mbody == "}" {
+								break
+							}
+							if strings.HasPrefix(mbody, "param ") {
+								rest := strings.TrimSpace(strings.TrimPrefix(mbody, "param"))
+								sp := strings.IndexAny(rest, " \t")
+								if sp < 0 {
+									fatalf("interface %s: method %s: param line %q lacks a type", iname, mname, mbody)
+								}
+								pname := rest[:sp]
+								ptype := strings.TrimSpace(rest[sp+1:])
+								if ptype == "" {
+									fatalf("interface %s: method %s: param %s has empty type", iname, mname, pname)
 								}
-								idxs = append(idxs, v)
+								params = append(params, gbasm.FieldShape{Name: pname, Type: ptype})
+								continue
 							}
-							for len(retAliases) <= slot {
-								retAliases = append(retAliases, nil)
+							if strings.HasPrefix(mbody, "return ") {
+								retType = strings.TrimSpace(strings.TrimPrefix(mbody, "return"))
+								sawReturn = true
+								continue
 							}
-							retAliases[slot] = idxs
-							continue
+							if strings.HasPrefix(mbody, "retaliases ") {
+								// retaliases <slot>: <idx> <idx>...  (declared borrow contract)
+								rest := strings.TrimSpace(strings.TrimPrefix(mbody, "retaliases"))
+								colon := strings.IndexByte(rest, ':')
+								if colon < 0 {
+									fatalf("interface %s: method %s: retaliases line %q lacks ':'", iname, mname, mbody)
+								}
+								slot, err := strconv.Atoi(strings.TrimSpace(rest[:colon]))
+								if err != nil || slot < 0 {
+									fatalf("interface %s: method %s: bad retaliases slot in %q", iname, mname, mbody)
+								}
+								var idxs []int
+								for _, tok := range strings.Fields(rest[colon+1:]) {
+									v, verr := strconv.Atoi(tok)
+									if verr != nil || v < 0 {
+										fatalf("interface %s: method %s: bad retaliases index %q", iname, mname, tok)
+									}
+									idxs = append(idxs, v)
+								}
+								for len(retAliases) <= slot {
+									retAliases = append(retAliases, nil)
+								}
+								retAliases[slot] = idxs
+								continue
+							}
+							fatalf("interface %s: method %s: unknown line %q", iname, mname, mbody)
 						}
-						fmt.Printf("Fatal: interface %s: method %s: unknown line %q\n", iname, mname, mbody)
-						os.Exit(1)
-					}
-					if !sawReturn {
-						fmt.Printf("Fatal: interface %s: method %s: missing 'return <type>' line\n", iname, mname)
-						os.Exit(1)
-					}
-					methods = append(methods, gbasm.InterfaceMethodShape{
-						Name:          mname,
-						Params:        params,
-						Return:        retType,
-						ReturnAliases: retAliases,
-					})
-				}
-				if err := o.AddInterface(iname, methods, isPub); err != nil {
-					fmt.Printf("Fatal: interface %s: %s\n", iname, err)
-					os.Exit(1)
-				}
-				continue
-			}
-			if strings.HasPrefix(line, "typedesc_cache") {
-				// Single-line directive: typedesc_cache <name>
-				// A bare 8-byte writable slot, zero-initialized. Lives in the
-				// Vars map (F_WRITE). Tagged so bdump recognizes it and the
-				// pairing check can find it.
-				name := strings.TrimSpace(strings.TrimPrefix(line, "typedesc_cache"))
-				if name == "" {
-					fmt.Printf("Fatal: typedesc_cache directive: missing name\n")
-					os.Exit(1)
-				}
-				if err := o.AddVar(name, "byte[8]", make([]byte, 8), isPub); err != nil {
-					fmt.Printf("Fatal: typedesc_cache %s: %s\n", name, err)
-					os.Exit(1)
-				}
-				o.Vars[name].Kind = gbasm.KindTypedescCache
-				continue
-			}
-			if strings.HasPrefix(line, "typedesc") {
-				// Multi-line directive:
-				//   typedesc <name> {
-				//     name "<type-name>"
-				//     size <i64>
-				//     cache_ref <cache-symbol>
-				//     method <name> <sig> <name_hash> <sig_hash> <recv_shape> <fn_reloc>
-				//     ...
-				//   }
-				rest := strings.TrimSpace(strings.TrimPrefix(line, "typedesc"))
-				openIdx := strings.IndexByte(rest, '{')
-				if openIdx < 0 {
-					fmt.Printf("Fatal: typedesc directive: missing '{' on the same line, got: %q\n", line)
-					os.Exit(1)
-				}
-				tname := strings.TrimSpace(rest[:openIdx])
-				if tname == "" {
-					fmt.Printf("Fatal: typedesc directive: missing name before '{'\n")
-					os.Exit(1)
-				}
-				rec := &gbasm.TypedescRecord{}
-				for {
-					if !scanner.Scan() {
-						fmt.Printf("Fatal: typedesc %s: unexpected EOF before '}'\n", tname)
-						os.Exit(1)
-					}
-					ln++
-					body := strings.TrimSpace(scanner.Text())
-					if body == "" || strings.HasPrefix(body, "//") {
-						continue
-					}
-					if body == "}" {
-						break
-					}
-					switch {
-					case strings.HasPrefix(body, "name "):
-						s := strings.TrimSpace(strings.TrimPrefix(body, "name"))
-						rec.TypeName = strings.Trim(s, `"`)
-					case strings.HasPrefix(body, "size "):
-						s := strings.TrimSpace(strings.TrimPrefix(body, "size"))
-						n, err := strconv.ParseUint(s, 10, 64)
-						if err != nil {
-							fmt.Printf("Fatal: typedesc %s: bad size %q: %v\n", tname, s, err)
-							os.Exit(1)
-						}
-						rec.SizeBytes = n
-					case strings.HasPrefix(body, "cache_ref "):
-						rec.CacheSym = strings.TrimSpace(strings.TrimPrefix(body, "cache_ref"))
-					case strings.HasPrefix(body, "method "):
-						f := strings.Fields(strings.TrimPrefix(body, "method"))
-						if len(f) < 6 {
-							fmt.Printf("Fatal: typedesc %s: method requires >=6 fields (name sig name_hash sig_hash recv_shape fn_reloc [slot_mask...]), got %v\n", tname, f)
-							os.Exit(1)
-						}
-						nh := mustParseU64("typedesc", tname, "name_hash", f[2])
-						sh := mustParseU64("typedesc", tname, "sig_hash", f[3])
-						rs := mustParseU64("typedesc", tname, "recv_shape", f[4])
-						rec.Methods = append(rec.Methods, gbasm.TypedescMethod{
-							Name:      f[0],
-							Sig:       basUnquoteSigTok(f[1]),
-							NameHash:  nh,
-							SigHash:   sh,
-							RecvShape: rs,
-							FnSym:     f[5],
-							SlotMasks: parseSlotMasks("typedesc", tname, f[6:]),
+						if !sawReturn {
+							fatalf("interface %s: method %s: missing 'return <type>' line", iname, mname)
+						}
+						methods = append(methods, gbasm.InterfaceMethodShape{
+							Name:          mname,
+							Params:        params,
+							Return:        retType,
+							ReturnAliases: retAliases,
 						})
-					default:
-						fmt.Printf("Fatal: typedesc %s: unknown line %q\n", tname, body)
-						os.Exit(1)
 					}
+					if err := o.AddInterface(iname, methods, isPub); err != nil {
+						fatalf("interface %s: %s", iname, err)
+					}
+					continue
 				}
-				data, relocs := gbasm.EncodeTypedesc(rec)
-				if err := o.AddData(tname, fmt.Sprintf("byte[%d]", len(data)), data, isPub); err != nil {
-					fmt.Printf("Fatal: typedesc %s: %s\n", tname, err)
-					os.Exit(1)
-				}
-				o.Data[tname].Relocs = relocs
-				o.Data[tname].Kind = gbasm.KindTypedesc
-				continue
-			}
-			if strings.HasPrefix(line, "iface_desc") {
-				// Multi-line directive:
-				//   iface_desc <name> {
-				//     name "<iface-name>"
-				//     method <name> <sig> <name_hash> <sig_hash> <decl_idx>
-				//     ...
-				//   }
-				rest := strings.TrimSpace(strings.TrimPrefix(line, "iface_desc"))
-				openIdx := strings.IndexByte(rest, '{')
-				if openIdx < 0 {
-					fmt.Printf("Fatal: iface_desc directive: missing '{' on the same line, got: %q\n", line)
-					os.Exit(1)
-				}
-				iname := strings.TrimSpace(rest[:openIdx])
-				if iname == "" {
-					fmt.Printf("Fatal: iface_desc directive: missing name before '{'\n")
-					os.Exit(1)
-				}
-				rec := &gbasm.IfaceDescRecord{}
-				for {
-					if !scanner.Scan() {
-						fmt.Printf("Fatal: iface_desc %s: unexpected EOF before '}'\n", iname)
-						os.Exit(1)
-					}
-					ln++
-					body := strings.TrimSpace(scanner.Text())
-					if body == "" || strings.HasPrefix(body, "//") {
-						continue
+				if strings.HasPrefix(line, "typedesc_cache") {
+					// Single-line directive: typedesc_cache <name>
+					// A bare 8-byte writable slot, zero-initialized. Lives in the
+					// Vars map (F_WRITE), in .bss. Tagged so bdump recognizes it and the
+					// pairing check can find it.
+					name := strings.TrimSpace(strings.TrimPrefix(line, "typedesc_cache"))
+					if name == "" {
+						fatalf("typedesc_cache directive: missing name")
 					}
-					if body == "}" {
-						break
+					if err := o.AddZeroVar(name, "byte[8]", 8, isPub); err != nil {
+						fatalf("typedesc_cache %s: %s", name, err)
 					}
-					switch {
-					case strings.HasPrefix(body, "name "):
-						s := strings.TrimSpace(strings.TrimPrefix(body, "name"))
-						rec.IfaceName = strings.Trim(s, `"`)
-					case strings.HasPrefix(body, "method "):
-						f := strings.Fields(strings.TrimPrefix(body, "method"))
-						if len(f) < 5 {
-							fmt.Printf("Fatal: iface_desc %s: method requires >=5 fields (name sig name_hash sig_hash decl_idx [slot_mask...]), got %v\n", iname, f)
-							os.Exit(1)
-						}
-						nh := mustParseU64("iface_desc", iname, "name_hash", f[2])
-						sh := mustParseU64("iface_desc", iname, "sig_hash", f[3])
-						di := mustParseU64("iface_desc", iname, "decl_idx", f[4])
-						rec.Methods = append(rec.Methods, gbasm.IfaceDescMethod{
-							Name:     f[0],
-							Sig:      basUnquoteSigTok(f[1]),
-							NameHash: nh,
-							SigHash:  sh,
-							DeclIdx:  di,
-							SlotMasks: parseSlotMasks("iface_desc", iname, f[5:]),
-						})
-					default:
-						fmt.Printf("Fatal: iface_desc %s: unknown line %q\n", iname, body)
-						os.Exit(1)
-					}
-				}
-				data, _ := gbasm.EncodeIfaceDesc(rec)
-				if err := o.AddData(iname, fmt.Sprintf("byte[%d]", len(data)), data, isPub); err != nil {
-					fmt.Printf("Fatal: iface_desc %s: %s\n", iname, err)
-					os.Exit(1)
-				}
-				o.Data[iname].Kind = gbasm.KindIfaceDesc
-				continue
-			}
-			if strings.HasPrefix(line, "values ") {
-				// Multi-line directive:
-				//   values <Name> {
-				//     tag <tag-type>
-				//     case <case-name> <tag-int>
-				//     projection <target-type-string>
-				//     method <bare-method-name>
-				//     ...
-				//   }
-				// Cases and projections are recorded in the source order
-				// the producer emitted, which matches their declaration
-				// order in the bosc-side ValuesDecl. The projection
-				// table symbol is derived from (pkg, type-name, index)
-				// on the importer side, so no symbol name needs to flow
-				// through the directive.
-				rest := strings.TrimSpace(strings.TrimPrefix(line, "values"))
-				openIdx := strings.IndexByte(rest, '{')
-				if openIdx < 0 {
-					fmt.Printf("Fatal: values directive: missing '{' on the same line, got: %q\n", line)
-					os.Exit(1)
-				}
-				vname := strings.TrimSpace(rest[:openIdx])
-				if vname == "" {
-					fmt.Printf("Fatal: values directive: missing name before '{'\n")
-					os.Exit(1)
+					o.Vars[name].Kind = gbasm.KindTypedescCache
+					continue
 				}
-				var tagType string
-				var cases []gbasm.ValuesCaseShape
-				var projections []gbasm.ProjectionShape
-				var methodNames []string
-				for {
-					if !scanner.Scan() {
-						fmt.Printf("Fatal: values %s: unexpected EOF before '}'\n", vname)
-						os.Exit(1)
-					}
-					ln++
-					body := strings.TrimSpace(scanner.Text())
-					if body == "" || strings.HasPrefix(body, "//") {
-						continue
-					}
-					if body == "}" {
-						break
+				if strings.HasPrefix(line, "typedesc") {
+					// Multi-line directive:
+					//   typedesc <name> {
+					//     name "<type-name>"
+					//     size <i64>
+					//     cache_ref <cache-symbol>
+					//     method <name> <sig> <name_hash> <sig_hash> <recv_shape> <fn_reloc>
+					//     ...
+					//   }
+					rest := strings.TrimSpace(strings.TrimPrefix(line, "typedesc"))
+					openIdx := strings.IndexByte(rest, '{')
+					if openIdx < 0 {
+						fatalf("typedesc directive: missing '{' on the same line, got: %q", line)
 					}
-					if strings.HasPrefix(body, "tag ") {
-						tagType = strings.TrimSpace(strings.TrimPrefix(body, "tag"))
-						continue
+					tname := strings.TrimSpace(rest[:openIdx])
+					if tname == "" {
+						fatalf("typedesc directive: missing name before '{'")
 					}
-					if strings.HasPrefix(body, "case ") {
-						parts := strings.Fields(strings.TrimPrefix(body, "case "))
-						if len(parts) != 2 {
-							fmt.Printf("Fatal: values %s: case line %q expected `case <name> <tag>`\n", vname, body)
-							os.Exit(1)
+					rec := &gbasm.TypedescRecord{}
+					for {
+						if !src.ScanRaw() {
+							fatalf("typedesc %s: unexpected EOF before '}'", tname)
 						}
-						tag, err := strconv.ParseInt(parts[1], 10, 64)
-						if err != nil {
-							fmt.Printf("Fatal: values %s: case %s tag %q: %s\n", vname, parts[0], parts[1], err)
-							os.Exit(1)
+						body := strings.TrimSpace(src.Text())
+						if body == "" || strings.HasPrefix(body, "//") {
+							continue
 						}
-						cases = append(cases, gbasm.ValuesCaseShape{Name: parts[0], Tag: tag})
-						continue
-					}
-					if strings.HasPrefix(body, "projection ") {
-						pt := strings.TrimSpace(strings.TrimPrefix(body, "projection"))
-						if pt == "" {
-							fmt.Printf("Fatal: values %s: projection line %q has empty target type\n", vname, body)
-							os.Exit(1)
+						if body == "}" {
+							break
 						}
-						projections = append(projections, gbasm.ProjectionShape{TargetType: pt})
-						continue
-					}
-					if strings.HasPrefix(body, "method ") {
-						mn := strings.TrimSpace(strings.TrimPrefix(body, "method"))
-						if mn == "" {
-							fmt.Printf("Fatal: values %s: method line %q has empty name\n", vname, body)
-							os.Exit(1)
+						switch {
+						case strings.HasPrefix(body, "name "):
+							s := strings.TrimSpace(strings.TrimPrefix(body, "name"))
+							rec.TypeName = strings.Trim(s, `"`)
+						case strings.HasPrefix(body, "size "):
+							s := strings.TrimSpace(strings.TrimPrefix(body, "size"))
+							n, err := strconv.ParseUint(s, 10, 64)
+							if err != nil {
+								fatalf("typedesc %s: bad size %q: %v", tname, s, err)
+							}
+							rec.SizeBytes = n
+						case strings.HasPrefix(body, "cache_ref "):
+							rec.CacheSym = strings.TrimSpace(strings.TrimPrefix(body, "cache_ref"))
+						case strings.HasPrefix(body, "method "):
+							f := strings.Fields(strings.TrimPrefix(body, "method"))
+							if len(f) < 6 {
+								fatalf("typedesc %s: method requires >=6 fields (name sig name_hash sig_hash recv_shape fn_reloc [slot_mask...]), got %v", tname, f)
+							}
+							nh := mustParseU64("typedesc", tname, "name_hash", f[2])
+							sh := mustParseU64("typedesc", tname, "sig_hash", f[3])
+							rs := mustParseU64("typedesc", tname, "recv_shape", f[4])
+							rec.Methods = append(rec.Methods, gbasm.TypedescMethod{
+								Name:      f[0],
+								Sig:       basUnquoteSigTok(f[1]),
+								NameHash:  nh,
+								SigHash:   sh,
+								RecvShape: rs,
+								FnSym:     f[5],
+								SlotMasks: parseSlotMasks("typedesc", tname, f[6:]),
+							})
+						default:
+							fatalf("typedesc %s: unknown line %q", tname, body)
 						}
-						methodNames = append(methodNames, mn)
-						continue
 					}
-					fmt.Printf("Fatal: values %s: unknown line %q\n", vname, body)
-					os.Exit(1)
-				}
-				if tagType == "" {
-					fmt.Printf("Fatal: values %s: missing 'tag <type>' line\n", vname)
-					os.Exit(1)
-				}
-				if err := o.AddValues(vname, tagType, cases, projections, methodNames, isPub); err != nil {
-					fmt.Printf("Fatal: values %s: %s\n", vname, err)
-					os.Exit(1)
-				}
-				continue
-			}
-			if strings.HasPrefix(line, "struct") {
-				// Multi-line directive:
-				//   struct Name {
-				//     field1 type1
-				//     field2 type2
-				//     ...
-				//   }
-				// Each field-line: first whitespace-delimited token is
-				// the field name, everything after is the type string
-				// (verbatim, so types containing spaces like '*mut Foo'
-				// or 'byte[100]' survive). Whitespace-only lines and //
-				// comments inside the body are ignored.
-				rest := strings.TrimSpace(strings.TrimPrefix(line, "struct"))
-				openIdx := strings.IndexByte(rest, '{')
-				if openIdx < 0 {
-					fmt.Printf("Fatal: struct directive: missing '{' on the same line, got: %q\n", line)
-					os.Exit(1)
-				}
-				// The pre-'{' segment is `Name [method1 method2 ...]` — the
-				// first token is the struct name; any trailing tokens are
-				// method names recorded for cross-package method resolution.
-				header := strings.Fields(strings.TrimSpace(rest[:openIdx]))
-				if len(header) == 0 {
-					fmt.Printf("Fatal: struct directive: missing name before '{'\n")
-					os.Exit(1)
+					data, relocs := gbasm.EncodeTypedesc(rec)
+					if err := o.AddData(tname, fmt.Sprintf("byte[%d]", len(data)), data, isPub); err != nil {
+						fatalf("typedesc %s: %s", tname, err)
+					}
+					o.Data[tname].Relocs = relocs
+					o.Data[tname].Kind = gbasm.KindTypedesc
+					continue
 				}
-				sname := header[0]
-				methodNames := header[1:]
-				var fields []gbasm.FieldShape
-				for {
-					if !scanner.Scan() {
-						fmt.Printf("Fatal: struct %s: unexpected EOF before '}'\n", sname)
-						os.Exit(1)
-					}
-					ln++
-					body := strings.TrimSpace(scanner.Text())
-					if body == "" || strings.HasPrefix(body, "//") {
-						continue
+				if strings.HasPrefix(line, "iface_desc") {
+					// Multi-line directive:
+					//   iface_desc <name> {
+					//     name "<iface-name>"
+					//     method <name> <sig> <name_hash> <sig_hash> <decl_idx>
+					//     ...
+					//   }
+					rest := strings.TrimSpace(strings.TrimPrefix(line, "iface_desc"))
+					openIdx := strings.IndexByte(rest, '{')
+					if openIdx < 0 {
+						fatalf("iface_desc directive: missing '{' on the same line, got: %q", line)
 					}
-					if body == "}" {
-						break
+					iname := strings.TrimSpace(rest[:openIdx])
+					if iname == "" {
+						fatalf("iface_desc directive: missing name before '{'")
 					}
-					// Field line: first whitespace token is name, rest is type.
-					sp := strings.IndexAny(body, " \t")
-					if sp < 0 {
-						fmt.Printf("Fatal: struct %s: field line %q lacks a type\n", sname, body)
-						os.Exit(1)
+					rec := &gbasm.IfaceDescRecord{}
+					for {
+						if !src.ScanRaw() {
+							fatalf("iface_desc %s: unexpected EOF before '}'", iname)
+						}
+						body := strings.TrimSpace(src.Text())
+						if body == "" || strings.HasPrefix(body, "//") {
+							continue
+						}
+						if body == "}" {
+							break
+						}
+						switch {
+						case strings.HasPrefix(body, "name "):
+							s := strings.TrimSpace(strings.TrimPrefix(body, "name"))
+							rec.IfaceName = strings.Trim(s, `"`)
+						case strings.HasPrefix(body, "method "):
+							f := strings.Fields(strings.TrimPrefix(body, "method"))
+							if len(f) < 5 {
+								fatalf("iface_desc %s: method requires >=5 fields (name sig name_hash sig_hash decl_idx [slot_mask...]), got %v", iname, f)
+							}
+							nh := mustParseU64("iface_desc", iname, "name_hash", f[2])
+							sh := mustParseU64("iface_desc", iname, "sig_hash", f[3])
+							di := mustParseU64("iface_desc", iname, "decl_idx", f[4])
+							rec.Methods = append(rec.Methods, gbasm.IfaceDescMethod{
+								Name:     f[0],
+								Sig:      basUnquoteSigTok(f[1]),
+								NameHash: nh,
+								SigHash:  sh,
+								DeclIdx:  di,
+								SlotMasks: parseSlotMasks("iface_desc", iname, f[5:]),
+							})
+						default:
+							fatalf("iface_desc %s: unknown line %q", iname, body)
+						}
 					}
-					fname := body[:sp]
-					ftype := strings.TrimSpace(body[sp+1:])
-					if ftype == "" {
-						fmt.Printf("Fatal: struct %s: field %s has empty type\n", sname, fname)
-						os.Exit(1)
+					data, _ := gbasm.EncodeIfaceDesc(rec)
+					if err := o.AddData(iname, fmt.Sprintf("byte[%d]", len(data)), data, isPub); err != nil {
+						fatalf("iface_desc %s: %s", iname, err)
 					}
-					fields = append(fields, gbasm.FieldShape{Name: fname, Type: ftype})
-				}
-				if err := o.AddStruct(sname, fields, methodNames, isPub); err != nil {
-					fmt.Printf("Fatal: struct %s: %s\n", sname, err)
-					os.Exit(1)
-				}
-				continue
-			}
-			if strings.HasPrefix(line, "function") {
-				fname := strings.TrimSpace(strings.TrimPrefix(line, "function"))
-				if strings.Contains(fname, " ") {
-					fmt.Printf("Fatal: Function name \"%s\" contains a space.\n", fname)
-					os.Exit(1)
-				}
-				f, err = o.NewFunction(flag.Arg(fi), ln, fname)
-				if err != nil {
-					fmt.Printf("Fatal: Failed to create function \"%s\": %s\n", fname, err)
-					os.Exit(1)
-				}
-				f.IsPub = isPub
-				//locals = make(map[string]*gbasm.Ralloc)
-				continue
-			}
-
-			if strings.HasPrefix(line, "data") {
-				f = nil // A new data declaration ends any current function
-				parts := SplitNSpace(strings.TrimSpace(strings.TrimPrefix(line, "data")), 3)
-				if len(parts) != 3 {
-					fmt.Printf("Fatal: data declaration requires a name, type, and initial data, but got: %v\n", parts)
-					os.Exit(1)
-				}
-				data, err := parseData(parts[2])
-				if err != nil {
-					fmt.Printf("Fatal: failed to parse data for data declaration %s: %v", parts[0], err)
-					os.Exit(1)
-				}
-				if err := o.AddData(parts[0], parts[1], data, isPub); err != nil {
-					fmt.Printf("Fatal: data %s: %s\n", parts[0], err)
-					os.Exit(1)
-				}
-				continue
-			}
-			if strings.HasPrefix(line, "var") {
-				f = nil // A new var declaration ends any current function
-				parts := SplitNSpace(strings.TrimSpace(strings.TrimPrefix(line, "var")), 3)
-				if len(parts) != 3 {
-					fmt.Printf("Fatal: var declaration requires a name, type, and either a byte-count, a string literal, or a '{' block, but got: %v\n", parts)
-					os.Exit(1)
+					o.Data[iname].Kind = gbasm.KindIfaceDesc
+					continue
 				}
-				var data []byte
-				var relocs []gbasm.DataReloc
-				switch {
-				case strings.HasPrefix(parts[2], `"`):
-					// String-literal form: "..." with escapes (\n, \\, \", \0, \xHH).
-					d, err := parseData(parts[2])
-					if err != nil {
-						fmt.Printf("Fatal: failed to parse data for var %s: %v\n", parts[0], err)
-						os.Exit(1)
-					}
-					data = d
-				case parts[2] == "{":
-					// Block form:
-					//   var name type {
-					//     bytes "<escaped>"
-					//     reloc <offset> <symbol> <addend>
+				if strings.HasPrefix(line, "values ") {
+					// Multi-line directive:
+					//   values <Name> {
+					//     tag <tag-type>
+					//     case <case-name> <tag-int>
+					//     projection <target-type-string>
+					//     method <bare-method-name>
 					//     ...
 					//   }
-					// `bytes` and `reloc` lines may appear in any order;
-					// `bytes` is mandatory (use an explicit zero-filled
-					// string literal if the var is otherwise empty),
-					// `reloc` is optional and may appear multiple times.
-					sawBytes := false
+					// Cases and projections are recorded in the source order
+					// the producer emitted, which matches their declaration
+					// order in the bosc-side ValuesDecl. The projection
+					// table symbol is derived from (pkg, type-name, index)
+					// on the importer side, so no symbol name needs to flow
+					// through the directive.
+					rest := strings.TrimSpace(strings.TrimPrefix(line, "values"))
+					openIdx := strings.IndexByte(rest, '{')
+					if openIdx < 0 {
+						fatalf("values directive: missing '{' on the same line, got: %q", line)
+					}
+					vname := strings.TrimSpace(rest[:openIdx])
+					if vname == "" {
+						fatalf("values directive: missing name before '{'")
+					}
+					var tagType string
+					var cases []gbasm.ValuesCaseShape
+					var projections []gbasm.ProjectionShape
+					var methodNames []string
 					for {
-						if !scanner.Scan() {
-							fmt.Printf("Fatal: var %s: unexpected EOF before '}'\n", parts[0])
-							os.Exit(1)
+						if !src.ScanRaw() {
+							fatalf("values %s: unexpected EOF before '}'", vname)
 						}
-						ln++
-						body := strings.TrimSpace(scanner.Text())
+						body := strings.TrimSpace(src.Text())
 						if body == "" || strings.HasPrefix(body, "//") {
 							continue
 						}
 						if body == "}" {
 							break
 						}
-						switch {
-						case strings.HasPrefix(body, "bytes"):
-							if sawBytes {
-								fmt.Printf("Fatal: var %s: duplicate 'bytes' line in block\n", parts[0])
-								os.Exit(1)
-							}
-							payload := strings.TrimSpace(strings.TrimPrefix(body, "bytes"))
-							if !strings.HasPrefix(payload, `"`) {
-								fmt.Printf("Fatal: var %s: 'bytes' payload must be a string literal, got: %s\n", parts[0], payload)
-								os.Exit(1)
+						if strings.HasPrefix(body, "tag ") {
+							tagType = strings.TrimSpace(strings.TrimPrefix(body, "tag"))
+							continue
+						}
+						if strings.HasPrefix(body, "case ") {
+							parts := strings.Fields(strings.TrimPrefix(body, "case "))
+							if len(parts) != 2 {
+								fatalf("values %s: case line %q expected `case <name> <tag>`", vname, body)
 							}
-							d, err := parseData(payload)
+							tag, err := strconv.ParseInt(parts[1], 10, 64)
 							if err != nil {
-								fmt.Printf("Fatal: var %s: failed to parse bytes payload: %v\n", parts[0], err)
-								os.Exit(1)
-							}
-							data = d
-							sawBytes = true
-						case strings.HasPrefix(body, "reloc"):
-							rp := SplitSpace(strings.TrimSpace(strings.TrimPrefix(body, "reloc")))
-							if len(rp) != 3 {
-								fmt.Printf("Fatal: var %s: 'reloc' requires three args (offset, symbol, addend), got: %v\n", parts[0], rp)
-								os.Exit(1)
+								fatalf("values %s: case %s tag %q: %s", vname, parts[0], parts[1], err)
 							}
-							off, err := strconv.ParseUint(rp[0], 10, 32)
-							if err != nil {
-								fmt.Printf("Fatal: var %s: reloc offset must be a non-negative integer, got %q: %v\n", parts[0], rp[0], err)
-								os.Exit(1)
+							cases = append(cases, gbasm.ValuesCaseShape{Name: parts[0], Tag: tag})
+							continue
+						}
+						if strings.HasPrefix(body, "projection ") {
+							pt := strings.TrimSpace(strings.TrimPrefix(body, "projection"))
+							if pt == "" {
+								fatalf("values %s: projection line %q has empty target type", vname, body)
 							}
-							addend, err := strconv.ParseInt(rp[2], 10, 64)
-							if err != nil {
-								fmt.Printf("Fatal: var %s: reloc addend must be an integer, got %q: %v\n", parts[0], rp[2], err)
-								os.Exit(1)
+							projections = append(projections, gbasm.ProjectionShape{TargetType: pt})
+							continue
+						}
+						if strings.HasPrefix(body, "method ") {
+							mn := strings.TrimSpace(strings.TrimPrefix(body, "method"))
+							if mn == "" {
+								fatalf("values %s: method line %q has empty name", vname, body)
 							}
-							relocs = append(relocs, gbasm.DataReloc{
-								Offset: uint32(off),
-								Symbol: rp[1],
-								Addend: addend,
-							})
-						default:
-							fmt.Printf("Fatal: var %s: unknown line in block: %q\n", parts[0], body)
-							os.Exit(1)
+							methodNames = append(methodNames, mn)
+							continue
 						}
+						fatalf("values %s: unknown line %q", vname, body)
+					}
+					if tagType == "" {
+						fatalf("values %s: missing 'tag <type>' line", vname)
+					}
+					if err := o.AddValues(vname, tagType, cases, projections, methodNames, isPub); err != nil {
+						fatalf("values %s: %s", vname, err)
+					}
+					continue
+				}
+				if strings.HasPrefix(line, "struct") {
+					// Multi-line directive:
+					//   struct Name {
+					//     field1 type1
+					//     field2 type2
+					//     ...
+					//   }
+					// Each field-line: first whitespace-delimited token is
+					// the field name, everything after is the type string
+					// (verbatim, so types containing spaces like '*mut Foo'
+					// or 'byte[100]' survive). Whitespace-only lines and //
+					// comments inside the body are ignored.
+					rest := strings.TrimSpace(strings.TrimPrefix(line, "struct"))
+					openIdx := strings.IndexByte(rest, '{')
+					if openIdx < 0 {
+						fatalf("struct directive: missing '{' on the same line, got: %q", line)
 					}
-					if !sawBytes {
-						fmt.Printf("Fatal: var %s: block form requires a 'bytes' line\n", parts[0])
-						os.Exit(1)
+					// The pre-'{' segment is `Name [method1 method2 ...]` — the
+					// first token is the struct name; any trailing tokens are
+					// method names recorded for cross-package method resolution.
+					header := strings.Fields(strings.TrimSpace(rest[:openIdx]))
+					if len(header) == 0 {
+						fatalf("struct directive: missing name before '{'")
 					}
-					// Validate reloc offsets fit within the payload.
-					for _, r := range relocs {
-						if int(r.Offset)+8 > len(data) {
-							fmt.Printf("Fatal: var %s: reloc at offset %d would write past end of %d-byte payload\n", parts[0], r.Offset, len(data))
-							os.Exit(1)
+					sname := header[0]
+					methodNames := header[1:]
+					var fields []gbasm.FieldShape
+					for {
+						if !src.ScanRaw() {
+							fatalf("struct %s: unexpected EOF before '}'", sname)
+						}
+						body := strings.TrimSpace(src.Text())
+						if body == "" || strings.HasPrefix(body, "//") {
+							continue
+						}
+						if body == "}" {
+							break
 						}
+						// Field line: first whitespace token is name, rest is type.
+						sp := strings.IndexAny(body, " \t")
+						if sp < 0 {
+							fatalf("struct %s: field line %q lacks a type", sname, body)
+						}
+						fname := body[:sp]
+						ftype := strings.TrimSpace(body[sp+1:])
+						if ftype == "" {
+							fatalf("struct %s: field %s has empty type", sname, fname)
+						}
+						fields = append(fields, gbasm.FieldShape{Name: fname, Type: ftype})
 					}
-				default:
-					// Size form: an integer giving the number of zero-filled bytes.
-					n, err := strconv.Atoi(parts[2])
+					if err := o.AddStruct(sname, fields, methodNames, isPub); err != nil {
+						fatalf("struct %s: %s", sname, err)
+					}
+					continue
+				}
+				// line "<file>" <n>
+				// Attributes the code that follows to line n of file, the
+				// source the assembly was compiled from, in debug info. On
+				// the line before a var, it gives where the var was
+				// declared.
+				if strings.HasPrefix(line, "line ") {
+					rest := strings.TrimSpace(strings.TrimPrefix(line, "line"))
+					quoted, err := strconv.QuotedPrefix(rest)
 					if err != nil {
-						fmt.Printf("Fatal: var %s: third argument must be a string literal, an integer byte-count, or '{', got: %s\n", parts[0], parts[2])
-						os.Exit(1)
+						fatalf("line directive requires a quoted file name: %q", line)
 					}
-					if n < 0 {
-						fmt.Printf("Fatal: var %s: byte-count cannot be negative: %d\n", parts[0], n)
-						os.Exit(1)
+					file, _ := strconv.Unquote(quoted)
+					n, err := strconv.Atoi(strings.TrimSpace(rest[len(quoted):]))
+					if err != nil || n <= 0 {
+						fatalf("line directive has invalid line number: %q", line)
 					}
-					data = make([]byte, n)
-				}
-				if err := o.AddVar(parts[0], parts[1], data, isPub); err != nil {
-					fmt.Printf("Fatal: var %s: %s\n", parts[0], err)
-					os.Exit(1)
+					lineDirective = &srcPos{file: file, line: n}
+					if f != nil {
+						sourceLines = true
+						f.SourceLine(file, n)
+					}
+					continue
 				}
-				if len(relocs) > 0 {
-					o.Vars[parts[0]].Relocs = relocs
+				if strings.HasPrefix(line, "function") {
+					fname := strings.TrimSpace(strings.TrimPrefix(line, "function"))
+					// Until the function is created, its body has nowhere
+					// to go.
+					broken = true
+					if strings.Contains(fname, " ") {
+						fatalf("Function name \"%s\" contains a space.", fname)
+					}
+					f, err = o.NewFunction(at.file, at.line, fname)
+					if err != nil {
+						fatalf("Failed to create function \"%s\": %s", fname, err)
+					}
+					broken = false
+					f.IsPub = isPub
+					if declAlign != 0 {
+						if err := f.SetAlign(declAlign); err != nil {
+							fatalf("function %s: %s", fname, err)
+						}
+					}
+					if *listing != "" {
+						f.EnableListing()
+					}
+					if *optimize {
+						f.EnablePeephole()
+					}
+					plan = nil
+					sourceLines = false
+					if *regalloc == "scan" {
+						plan = planFunction(src, f)
+					}
+					//locals = make(map[string]*gbasm.Ralloc)
+					continue
 				}
-				continue
-			}
 
-			// Handle Regular Line. Must be inside a function.
-			if f == nil {
-				fmt.Printf("Fatal: All assembly must be inside a function\n")
-				os.Exit(1)
-			}
-			if strings.HasPrefix(line, "type") {
-				ftype := strings.TrimSpace(strings.TrimPrefix(line, "type"))
-				//fmt.Printf("DECL DECL DECL %s -> %s\n", f.Name, ftype)
-				f.Type = ftype
-				continue
-			}
-			// retaliases <slot>: <param-index>...
-			// Records inferred return-parameter aliasing for return slot
-			// <slot>. One directive per non-empty slot; accumulate into
-			// f.ReturnAliases, growing the outer slice to index <slot>.
-			// Must be dispatched here, ahead of the generic instruction
-			// matcher, or `retaliases 0: 0` is misparsed as an opcode.
-			if strings.HasPrefix(line, "retaliases") {
-				rest := strings.TrimSpace(strings.TrimPrefix(line, "retaliases"))
-				colon := strings.IndexByte(rest, ':')
-				if colon < 0 {
-					fmt.Printf("Fatal: retaliases directive missing ':' separator: %q\n", line)
-					os.Exit(1)
-				}
-				slot, err := strconv.Atoi(strings.TrimSpace(rest[:colon]))
-				if err != nil || slot < 0 {
-					fmt.Printf("Fatal: retaliases directive has invalid slot index: %q\n", line)
-					os.Exit(1)
-				}
-				var params []int
-				for _, tok := range SplitSpace(strings.TrimSpace(rest[colon+1:])) {
-					idx, err := strconv.Atoi(tok)
-					if err != nil || idx < 0 {
-						fmt.Printf("Fatal: retaliases directive has invalid param index %q: %q\n", tok, line)
-						os.Exit(1)
-					}
-					params = append(params, idx)
-				}
-				for len(f.ReturnAliases) <= slot {
-					f.ReturnAliases = append(f.ReturnAliases, nil)
-				}
-				f.ReturnAliases[slot] = params
-				continue
-			}
-			if strings.HasPrefix(line, "local") {
-				lnamesize := SplitSpace(strings.TrimSpace(strings.TrimPrefix(line, "local")))
-				if len(lnamesize) < 2 || len(lnamesize) > 3 {
-					fmt.Printf("Fatal: Expect a local declaration to contain a name and bit size, and optionally a register, but have %v\n", lnamesize)
-					os.Exit(1)
-				}
-				size, err := strconv.Atoi(lnamesize[1])
-				if err != nil {
-					fmt.Printf("Expected local size to be an integer, but have: %s\n", lnamesize[1])
-					os.Exit(1)
-				}
-				l, err := f.NewLocal(lnamesize[0], size)
-				if err != nil {
-					fmt.Printf("Fatal: Failed to declare local %s: %s\n", lnamesize[1], err)
-					os.Exit(1)
-				}
-				if len(lnamesize) == 3 {
-					reg, err := gbasm.ParseReg(lnamesize[2])
+				if strings.HasPrefix(line, "data") {
+					f = nil // A new data declaration ends any current function
+					parts := SplitNSpace(strings.TrimSpace(strings.TrimPrefix(line, "data")), 3)
+					if len(parts) != 3 {
+						fatalf("data declaration requires a name, type, and initial data, but got: %v", parts)
+					}
+					data, err := parseData(parts[2])
 					if err != nil {
-						fmt.Printf("Fatal: Failed to use register %s: %s\n", lnamesize[2], err)
-						os.Exit(1)
+						fatalf("failed to parse data for data declaration %s: %v", parts[0], err)
 					}
-					l.UseRegister(reg)
-				}
-				//locals[lnamesize[0]] = l
-				continue
-			}
-			if strings.HasPrefix(line, "bytes") {
-				bnamesize := SplitSpace(strings.TrimSpace(strings.TrimPrefix(line, "bytes")))
-				if len(bnamesize) < 2 || len(bnamesize) > 3 {
-					fmt.Printf("Fatal: Expect a bytes declaration to contain a name and byte size, and optionally a register, but have %v\n", bnamesize)
-					os.Exit(1)
-				}
-				size, err := strconv.Atoi(bnamesize[1])
-				if err != nil {
-					fmt.Printf("Expected bytes size to be an integer, but have: %s\n", bnamesize[1])
-					os.Exit(1)
-				}
-				l, err := f.AllocBytes(bnamesize[0], size)
-				if err != nil {
-					fmt.Printf("Fatal: Failed to declare bytes %s: %s\n", bnamesize[1], err)
-					os.Exit(1)
+					if err := o.AddData(parts[0], parts[1], data, isPub); err != nil {
+						fatalf("data %s: %s", parts[0], err)
+					}
+					if declAlign != 0 {
+						if err := o.Data[parts[0]].SetAlign(declAlign); err != nil {
+							fatalf("data %s: %s", parts[0], err)
+						}
+					}
+					continue
 				}
-				if len(bnamesize) == 3 {
-					reg, err := gbasm.ParseReg(bnamesize[2])
+				if strings.HasPrefix(line, "var") {
+					f = nil // A new var declaration ends any current function
+					parts := SplitNSpace(strings.TrimSpace(strings.TrimPrefix(line, "var")), 3)
+					if len(parts) != 3 {
+						fatalf("var declaration requires a name, type, and either a byte-count, a string literal, or a '{' block, but got: %v", parts)
+					}
+					var data []byte
+					var relocs []gbasm.DataReloc
+					var sites []basError // where each of relocs was written
+					var zeroFill int
+					switch {
+					case strings.HasPrefix(parts[2], `"`):
+						// String-literal form: "..." with escapes (\n, \\, \", \0, \xHH).
+						d, err := parseData(parts[2])
+						if err != nil {
+							fatalf("failed to parse data for var %s: %v", parts[0], err)
+						}
+						data = d
+					case parts[2] == "{":
+						// Block form:
+						//   var name type {
+						//     bytes "<escaped>"
+						//     reloc <offset> <symbol> <addend>
+						//     ...
+						//   }
+						// `bytes` and `reloc` lines may appear in any order;
+						// `bytes` is mandatory (use an explicit zero-filled
+						// string literal if the var is otherwise empty),
+						// `reloc` is optional and may appear multiple times.
+						sawBytes := false
+						for {
+							if !src.ScanRaw() {
+								fatalf("var %s: unexpected EOF before '}'", parts[0])
+							}
+							body := strings.TrimSpace(src.Text())
+							if body == "" || strings.HasPrefix(body, "//") {
+								continue
+							}
+							if body == "}" {
+								break
+							}
+							switch {
+							case strings.HasPrefix(body, "bytes"):
+								if sawBytes {
+									fatalf("var %s: duplicate 'bytes' line in block", parts[0])
+								}
+								payload := strings.TrimSpace(strings.TrimPrefix(body, "bytes"))
+								if !strings.HasPrefix(payload, `"`) {
+									fatalf("var %s: 'bytes' payload must be a string literal, got: %s", parts[0], payload)
+								}
+								d, err := parseData(payload)
+								if err != nil {
+									fatalf("var %s: failed to parse bytes payload: %v", parts[0], err)
+								}
+								data = d
+								sawBytes = true
+							case strings.HasPrefix(body, "reloc"):
+								rp := SplitSpace(strings.TrimSpace(strings.TrimPrefix(body, "reloc")))
+								if len(rp) != 3 {
+									fatalf("var %s: 'reloc' requires three args (offset, symbol, addend), got: %v", parts[0], rp)
+								}
+								off, err := strconv.ParseUint(rp[0], 10, 32)
+								if err != nil {
+									fatalf("var %s: reloc offset must be a non-negative integer, got %q: %v", parts[0], rp[0], err)
+								}
+								addend, err := strconv.ParseInt(rp[2], 10, 64)
+								if err != nil {
+									fatalf("var %s: reloc addend must be an integer, got %q: %v", parts[0], rp[2], err)
+								}
+								relocs = append(relocs, gbasm.DataReloc{
+									Offset: uint32(off),
+									Symbol: rp[1],
+									Addend: addend,
+								})
+								sites = append(sites, basError{pos: at, col: fieldCol(2)})
+							default:
+								fatalf("var %s: unknown line in block: %q", parts[0], body)
+							}
+						}
+						if !sawBytes {
+							fatalf("var %s: block form requires a 'bytes' line", parts[0])
+						}
+						// Validate reloc offsets fit within the payload.
+						for _, r := range relocs {
+							if int(r.Offset)+8 > len(data) {
+								fatalf("var %s: reloc at offset %d would write past end of %d-byte payload", parts[0], r.Offset, len(data))
+							}
+						}
+					default:
+						// Size form: an integer giving the number of zero-filled bytes.
+						n, err := strconv.Atoi(parts[2])
+						if _, ok := src.consts[parts[2]]; ok || isExpr(parts[2]) {
+							v, err := src.env().eval(parts[2])
+							if err != nil {
+								fatalf("var %s: %s", parts[0], err)
+							}
+							if v > math.MaxInt32 {
+								fatalf("var %s: byte-count %d is too large", parts[0], v)
+							}
+							n = int(v)
+						} else if err != nil {
+							fatalf("var %s: third argument must be a string literal, an integer byte-count, or '{', got: %s", parts[0], parts[2])
+						}
+						if n < 0 {
+							fatalf("var %s: byte-count cannot be negative: %d", parts[0], n)
+						}
+						zeroFill = n
+					}
+					var err error
+					if zeroFill > 0 {
+						err = o.AddZeroVar(parts[0], parts[1], zeroFill, isPub)
+					} else {
+						err = o.AddVar(parts[0], parts[1], data, isPub)
+					}
 					if err != nil {
-						fmt.Printf("Fatal: Failed to use register %s: %s\n", bnamesize[2], err)
-						os.Exit(1)
+						fatalf("var %s: %s", parts[0], err)
 					}
-					l.UseRegister(reg)
-				}
-				continue
-			}
-			if strings.HasPrefix(line, "volatile") {
-				name := strings.TrimSpace(strings.TrimPrefix(line, "volatile"))
-				if err := f.VolatileLocal(name); err != nil {
-					fmt.Printf("Fatal: volatile %s: %s\n", name, err)
-					os.Exit(1)
-				}
-				continue
-			}
-			if strings.HasPrefix(line, "forgetall") {
-				f.ForgetAll()
-				continue
-			}
-			if strings.HasPrefix(line, "forget") {
-				name := SplitSpace(strings.TrimSpace(strings.TrimPrefix(line, "forget")))
-				if len(name) != 1 {
-					fmt.Printf("Fatal: Expect a forget instruction to contain a name, but have %v\n", name)
-					os.Exit(1)
-				}
-				err = f.Forget(name[0])
-				if err != nil {
-					fmt.Printf("Fatal: Failed to forget local %s: %s\n", name[0], err)
-					os.Exit(1)
-				}
-				//locals[lnamesize[0]] = l
-				continue
-			}
-			if strings.HasPrefix(line, "inreg") {
-				// put a var in a specific reg
-				ireg := SplitSpace(strings.TrimSpace(strings.TrimPrefix(line, "inreg")))
-				if len(ireg) != 2 {
-					fmt.Printf("Fatal: Expect an inreg specify a variable and a register, but have: %v\n", ireg)
-					os.Exit(1)
-				}
-				ra := f.AllocFor(ireg[0])
-				if ra == nil {
-					fmt.Printf("Fatal: No such var: %v\n", ireg[0])
-					os.Exit(1)
-				}
-				reg, err := gbasm.ParseReg(ireg[1])
-				if err != nil {
-					fmt.Printf("Fatal: For inreg, cannot parse register %s: %v\n", ireg[1], err)
-					os.Exit(1)
-				}
-				ra.UseRegister(reg)
-				continue
-			}
-			if strings.HasPrefix(line, "use") {
-				rname := strings.TrimSpace(strings.TrimPrefix(line, "use"))
-				reg, err := gbasm.ParseReg(rname)
-				if err != nil {
-					fmt.Printf("Fatal: Failed to use register %s: %s\n", rname, err)
-					os.Exit(1)
-				}
-				if !f.Use(reg) {
-					fmt.Printf("Fatal: Failed to use register %s. Already in use.\n", rname)
-					os.Exit(1)
+					if len(relocs) > 0 {
+						o.Vars[parts[0]].Relocs = relocs
+						relocSites[parts[0]] = sites
+					}
+					o.Vars[parts[0]].IsConst = isConst
+					pos := at
+					if declared != nil {
+						pos = *declared
+					}
+					o.Vars[parts[0]].SrcFile, o.Vars[parts[0]].SrcLine = pos.file, pos.line
+					if declAlign != 0 {
+						if err := o.Vars[parts[0]].SetAlign(declAlign); err != nil {
+							fatalf("var %s: %s", parts[0], err)
+						}
+					}
+					continue
 				}
-				continue
-			}
-			if strings.HasPrefix(line, "argi") {
-				params := SplitSpace(strings.TrimSpace(strings.TrimPrefix(line, "argi")))
-				if len(params) < 2 || len(params) > 3 {
-					fmt.Printf("Fatal: Expect an argi declaration to contain a name, index, and optional bit size, but have %v\n", line)
-					os.Exit(1)
+
+				// Handle Regular Line. Must be inside a function.
+				if f == nil {
+					fatalf("All assembly must be inside a function")
+				}
+				if strings.HasPrefix(line, "type") {
+					ftype := strings.TrimSpace(strings.TrimPrefix(line, "type"))
+					//fmt.Printf("DECL DECL DECL %s -> %s\n", f.Name, ftype)
+					f.Type = ftype
+					continue
 				}
-				name := params[0]
-				num, err := strconv.ParseInt(params[1], 10, 64)
-				if err != nil {
-					fmt.Printf("Fatal: Expect an argi declaration to contain a name register/offset, but have %v\n", line)
-					os.Exit(1)
+				// retaliases <slot>: <param-index>...
+				// Records inferred return-parameter aliasing for return slot
+				// <slot>. One directive per non-empty slot; accumulate into
+				// f.ReturnAliases, growing the outer slice to index <slot>.
+				// Must be dispatched here, ahead of the generic instruction
+				// matcher, or `retaliases 0: 0` is misparsed as an opcode.
+				if strings.HasPrefix(line, "retaliases") {
+					rest := strings.TrimSpace(strings.TrimPrefix(line, "retaliases"))
+					colon := strings.IndexByte(rest, ':')
+					if colon < 0 {
+						fatalf("retaliases directive missing ':' separator: %q", line)
+					}
+					slot, err := strconv.Atoi(strings.TrimSpace(rest[:colon]))
+					if err != nil || slot < 0 {
+						fatalf("retaliases directive has invalid slot index: %q", line)
+					}
+					var params []int
+					for _, tok := range SplitSpace(strings.TrimSpace(rest[colon+1:])) {
+						idx, err := strconv.Atoi(tok)
+						if err != nil || idx < 0 {
+							fatalf("retaliases directive has invalid param index %q: %q", tok, line)
+						}
+						params = append(params, idx)
+					}
+					for len(f.ReturnAliases) <= slot {
+						f.ReturnAliases = append(f.ReturnAliases, nil)
+					}
+					f.ReturnAliases[slot] = params
+					continue
 				}
-				size := 64
-				if len(params) == 3 {
-					sz, err := strconv.ParseInt(params[2], 10, 64)
+				if strings.HasPrefix(line, "local") {
+					lnamesize := SplitSpace(strings.TrimSpace(strings.TrimPrefix(line, "local")))
+					if len(lnamesize) < 2 || len(lnamesize) > 3 {
+						fatalf("Expect a local declaration to contain a name and bit size, and optionally a register, but have %v", lnamesize)
+					}
+					size, err := strconv.Atoi(lnamesize[1])
 					if err != nil {
-						fmt.Printf("Fatal: Expect argi size to be an integer, but have: %v\n", params[2])
-						os.Exit(1)
+						fatalf("Expected local size to be an integer, but have: %s", lnamesize[1])
 					}
-					size = int(sz)
-				}
-				if _, err := f.ArgI(name, int(num), size); err != nil {
-					fmt.Printf("Fatal: Failed to mark arg %s: %s\n", name, err)
-					os.Exit(1)
-				}
-				continue
-			}
-			if strings.HasPrefix(line, "arg") {
-				params := SplitSpace(strings.TrimSpace(strings.TrimPrefix(line, "arg")))
-				if len(params) != 2 {
-					fmt.Printf("Fatal: Expect an arg declaration to contain a name register/offset, but have %v\n", params)
-					os.Exit(1)
-				}
-				name := params[0]
-				if reg, err := gbasm.ParseReg(params[1]); err == nil {
-					if _, err := f.Arg(name, reg); err != nil {
-						fmt.Printf("Fatal: Failed to mark arg %s: %s\n", name, err)
-						os.Exit(1)
-					}
-				} else if num, err := strconv.ParseInt(params[1], 10, 64); err == nil {
-					if _, err := f.StackArg(name, int(num)); err != nil {
-						fmt.Printf("Fatal: Failed to mark arg %s: %s\n", name, err)
-						os.Exit(1)
-					}
-				} else {
-					fmt.Printf("Fatal: Expect an arg declaration to contain a name register/offset, but have %v\n", params)
-					os.Exit(1)
-				}
-				continue
-			}
-			if strings.HasPrefix(line, "evict") {
-				params := SplitSpace(strings.TrimSpace(strings.TrimPrefix(line, "evict")))
-				if len(params) == 0 {
-					f.EvictAll()
-				}
-				for _, p := range params {
-					if reg, err := gbasm.ParseReg(p); err == nil {
-						f.EvictReg(reg)
+					// The optional third field is either a register to pin the
+					// local to or "xmm" to allocate it from the SSE registers.
+					// A local pinned to an SSE register is an xmm local too.
+					class := gbasm.ClassGP
+					var reg gbasm.Register
+					var pin bool
+					if len(lnamesize) == 3 {
+						if strings.EqualFold(lnamesize[2], "xmm") {
+							class = gbasm.ClassXMM
+						} else {
+							reg, err = gbasm.ParseReg(lnamesize[2])
+							if err != nil {
+								fatalf("Failed to use register %s: %s", lnamesize[2], err)
+							}
+							if reg.Width() == 128 {
+								class = gbasm.ClassXMM
+							}
+							pin = true
+						}
+					}
+					l, err := f.NewLocal(lnamesize[0], size, class)
+					if err != nil {
+						fatalf("Failed to declare local %s: %s", lnamesize[1], err)
+					}
+					if pin {
+						l.UseRegister(reg)
 					} else {
-						fmt.Printf("Fatal: Expect an evict argument to be a register, but have %v\n", p)
-						os.Exit(1)
+						plan.declared(lnamesize[0], l)
 					}
+					//locals[lnamesize[0]] = l
+					continue
 				}
-				continue
-			}
-			if strings.HasPrefix(line, "acquire") {
-				// acquire will acquire a register for use by evicting any variables in it and marking it as in use
-				params := SplitSpace(strings.TrimSpace(strings.TrimPrefix(line, "acquire")))
-				if len(params) == 0 {
-					fmt.Printf("Fatal: Expect acquire to have register arguments, but have nothing.\n")
-					os.Exit(1)
+				if strings.HasPrefix(line, "bytes") {
+					bnamesize := SplitSpace(strings.TrimSpace(strings.TrimPrefix(line, "bytes")))
+					if len(bnamesize) < 2 || len(bnamesize) > 3 {
+						fatalf("Expect a bytes declaration to contain a name and byte size, and optionally a register, but have %v", bnamesize)
+					}
+					size, err := strconv.Atoi(bnamesize[1])
+					if _, ok := src.consts[bnamesize[1]]; ok || isExpr(bnamesize[1]) {
+						v, err := src.env().eval(bnamesize[1])
+						if err != nil {
+							fatalf("bytes %s: %s", bnamesize[0], err)
+						}
+						if v < 0 || v > math.MaxInt32 {
+							fatalf("bytes %s: size %d out of range", bnamesize[0], v)
+						}
+						size = int(v)
+					} else if err != nil {
+						fatalf("Expected bytes size to be an integer, but have: %s", bnamesize[1])
+					}
+					l, err := f.AllocBytes(bnamesize[0], size)
+					if err != nil {
+						fatalf("Failed to declare bytes %s: %s", bnamesize[1], err)
+					}
+					if len(bnamesize) == 3 {
+						reg, err := gbasm.ParseReg(bnamesize[2])
+						if err != nil {
+							fatalf("Failed to use register %s: %s", bnamesize[2], err)
+						}
+						l.UseRegister(reg)
+					}
+					continue
 				}
-				for _, p := range params {
-					if reg, err := gbasm.ParseReg(p); err == nil {
-						f.Acquire(reg)
-					} else {
-						fmt.Printf("Fatal: Expect an acquire argument to be a register, but have %v\n", p)
-						os.Exit(1)
+				if strings.HasPrefix(line, "volatile") {
+					name := strings.TrimSpace(strings.TrimPrefix(line, "volatile"))
+					if err := f.VolatileLocal(name); err != nil {
+						fatalf("volatile %s: %s", name, err)
 					}
+					continue
 				}
-				continue
-			}
-			if strings.HasPrefix(line, "release") {
-				// release will release a register acquired by "use" or "acquire"
-				params := SplitSpace(strings.TrimSpace(strings.TrimPrefix(line, "release")))
-				if len(params) == 0 {
-					fmt.Printf("Fatal: Expect release to have register arguments, but have nothing.\n")
-					os.Exit(1)
+				if strings.HasPrefix(line, "forgetall") {
+					f.ForgetAll()
+					continue
 				}
-				for _, p := range params {
-					if reg, err := gbasm.ParseReg(p); err == nil {
-						f.Release(reg)
-					} else {
-						fmt.Printf("Fatal: Expect a release argument to be a register, but have %v\n", p)
-						os.Exit(1)
+				if strings.HasPrefix(line, "forget") {
+					name := SplitSpace(strings.TrimSpace(strings.TrimPrefix(line, "forget")))
+					if len(name) != 1 {
+						fatalf("Expect a forget instruction to contain a name, but have %v", name)
 					}
+					err = f.Forget(name[0])
+					if err != nil {
+						fatalf("Failed to forget local %s: %s", name[0], err)
+					}
+					//locals[lnamesize[0]] = l
+					continue
 				}
-				continue
-			}
-
-			if strings.HasPrefix(line, "label") {
-				lname := strings.TrimSpace(strings.TrimPrefix(line, "label"))
-				err := f.Label(lname)
-				if err != nil {
-					fmt.Printf("Fatal: Failed to set label %s: %s\n", lname, err)
-					os.Exit(1)
-				}
-				continue
-			}
-			if line == "prologue" {
-				err = f.Prologue()
-				if err != nil {
-					fmt.Printf("Fatal: Failed to write function prologue: %s\n", err)
-					os.Exit(1)
+				if strings.HasPrefix(line, "inreg") {
+					// put a var in a specific reg
+					ireg := SplitSpace(strings.TrimSpace(strings.TrimPrefix(line, "inreg")))
+					if len(ireg) != 2 {
+						fatalf("Expect an inreg specify a variable and a register, but have: %v", ireg)
+					}
+					ra := f.AllocFor(ireg[0])
+					if ra == nil {
+						fatalf("No such var: %v", ireg[0])
+					}
+					reg, err := gbasm.ParseReg(ireg[1])
+					if err != nil {
+						fatalf("For inreg, cannot parse register %s: %v", ireg[1], err)
+					}
+					ra.UseRegister(reg)
+					continue
 				}
-				continue
-			}
-			if line == "epilogue" {
-				err = f.Epilogue()
-				if err != nil {
-					fmt.Printf("Fatal: Failed to write function epilogue: %s\n", err)
-					os.Exit(1)
+				if strings.HasPrefix(line, "use") {
+					rname := strings.TrimSpace(strings.TrimPrefix(line, "use"))
+					reg, err := gbasm.ParseReg(rname)
+					if err != nil {
+						fatalf("Failed to use register %s: %s", rname, err)
+					}
+					if !f.Use(reg) {
+						fatalf("Failed to use register %s. Already in use.", rname)
+					}
+					continue
 				}
-				continue
-			}
-
-			parts := SplitSpace(line)
-			instrUp := strings.ToUpper(parts[0])
-			for _, i := range jumps {
-				if i == instrUp {
-					if len(parts) != 2 {
-						fmt.Printf("Fatal: Jumps take exactly 1 argument, but got: %v\n", line)
-						os.Exit(1)
-					}
-					// Indirect CALL: when the operand is a local (Ralloc)
-					// or a register, emit the r/m64 form rather than the
-					// rel32 relocation form. Function-pointer call sites
-					// rely on this; everything else still goes through
-					// the Jump (symbol-relocation) path.
-					if instrUp == "CALL" {
-						if alloc := f.AllocFor(parts[1]); alloc != nil {
-							f.EvictForCall()
-							if err := f.Instr("CALL", alloc); err != nil {
-								fmt.Printf("Fatal: Instruction %v: %s\n", parts, err)
-								os.Exit(1)
-							}
-							continue lines
+				if strings.HasPrefix(line, "argi") {
+					params := SplitSpace(strings.TrimSpace(strings.TrimPrefix(line, "argi")))
+					if len(params) < 2 || len(params) > 4 {
+						fatalf("Expect an argi declaration to contain a name, index, and optional bit size and class, but have %v", line)
+					}
+					name := params[0]
+					num, err := strconv.ParseInt(params[1], 10, 64)
+					if err != nil {
+						fatalf("Expect an argi declaration to contain a name register/offset, but have %v", line)
+					}
+					size := 64
+					if len(params) >= 3 {
+						sz, err := strconv.ParseInt(params[2], 10, 64)
+						if err != nil {
+							fatalf("Expect argi size to be an integer, but have: %v", params[2])
 						}
-						if reg, err := gbasm.ParseReg(parts[1]); err == nil {
-							f.EvictForCall()
-							if err := f.Instr("CALL", reg); err != nil {
-								fmt.Printf("Fatal: Instruction %v: %s\n", parts, err)
-								os.Exit(1)
-							}
-							continue lines
+						size = int(sz)
+					}
+					var l *gbasm.Ralloc
+					if len(params) == 4 {
+						if !strings.EqualFold(params[3], "xmm") {
+							fatalf("Expect argi class to be xmm, but have: %v", params[3])
 						}
+						l, err = f.ArgXMM(name, int(num), size)
+					} else {
+						l, err = f.ArgI(name, int(num), size)
 					}
-					err = f.Jump(instrUp, parts[1])
 					if err != nil {
-						fmt.Printf("Fatal: Instruction %v: %s\n", parts, err)
-						os.Exit(1)
+						fatalf("Failed to mark arg %s: %s", name, err)
 					}
-					continue lines
+					plan.declared(name, l)
+					continue
 				}
-			}
-
-			args := make([]interface{}, len(parts)-1)
-			for i := 1; i < len(parts); i++ {
-				// Partial-of-alloc syntax: name:N where N is 8, 16, 32, or 64.
-				// Refers to the low N bits of the named allocation.
-				if colon := strings.IndexByte(parts[i], ':'); colon > 0 {
-					name := parts[i][:colon]
-					sizeStr := parts[i][colon+1:]
-					if alloc := f.AllocFor(name); alloc != nil {
-						bits, err := strconv.Atoi(sizeStr)
-						if err == nil && (bits == 8 || bits == 16 || bits == 32 || bits == 64) {
-							args[i-1] = &gbasm.RallocPartial{Ra: alloc, Bits: bits}
-							continue
+				if strings.HasPrefix(line, "arg") {
+					params := SplitSpace(strings.TrimSpace(strings.TrimPrefix(line, "arg")))
+					if len(params) != 2 {
+						fatalf("Expect an arg declaration to contain a name register/offset, but have %v", params)
+					}
+					name := params[0]
+					if reg, err := gbasm.ParseReg(params[1]); err == nil {
+						l, err := f.Arg(name, reg)
+						if err != nil {
+							fatalf("Failed to mark arg %s: %s", name, err)
+						}
+						plan.declared(name, l)
+					} else if num, err := strconv.ParseInt(params[1], 10, 64); err == nil {
+						l, err := f.StackArg(name, int(num))
+						if err != nil {
+							fatalf("Failed to mark arg %s: %s", name, err)
 						}
-						fmt.Printf("Fatal: bad partial size in %q: must be 8, 16, 32, or 64\n", parts[i])
-						os.Exit(1)
+						plan.declared(name, l)
+					} else {
+						fatalf("Expect an arg declaration to contain a name register/offset, but have %v", params)
 					}
+					continue
 				}
-
-				if alloc := f.AllocFor(parts[i]); alloc != nil {
-					args[i-1] = alloc //alloc.Register()
+				if strings.HasPrefix(line, "evict") {
+					params := SplitSpace(strings.TrimSpace(strings.TrimPrefix(line, "evict")))
+					if len(params) == 0 {
+						f.EvictAll()
+					}
+					for _, p := range params {
+						if reg, err := gbasm.ParseReg(p); err == nil {
+							f.EvictReg(reg)
+						} else {
+							fatalf("Expect an evict argument to be a register, but have %v", p)
+						}
+					}
 					continue
 				}
-				if v := o.VarFor(parts[i]); v != nil {
-					//panic(fmt.Sprintf("VAR FOR %s\n", parts[i]))
-					args[i-1] = v
+				if strings.HasPrefix(line, "acquire") {
+					// acquire will acquire a register for use by evicting any variables in it and marking it as in use
+					params := SplitSpace(strings.TrimSpace(strings.TrimPrefix(line, "acquire")))
+					if len(params) == 0 {
+						fatalf("Expect acquire to have register arguments, but have nothing.")
+					}
+					for _, p := range params {
+						if reg, err := gbasm.ParseReg(p); err == nil {
+							f.Acquire(reg)
+						} else {
+							fatalf("Expect an acquire argument to be a register, but have %v", p)
+						}
+					}
 					continue
 				}
-
-				if reg, err := gbasm.ParseReg(parts[i]); err == nil {
-					args[i-1] = reg
+				if strings.HasPrefix(line, "release") {
+					// release will release a register acquired by "use" or "acquire"
+					params := SplitSpace(strings.TrimSpace(strings.TrimPrefix(line, "release")))
+					if len(params) == 0 {
+						fatalf("Expect release to have register arguments, but have nothing.")
+					}
+					for _, p := range params {
+						if reg, err := gbasm.ParseReg(p); err == nil {
+							f.Release(reg)
+						} else {
+							fatalf("Expect a release argument to be a register, but have %v", p)
+						}
+					}
 					continue
 				}
 
-				if bits, rest, ok := parseSizePrefix(parts[i]); ok {
-					ind, err := ParseIndirect(o, f, rest)
+				if strings.HasPrefix(line, "label") {
+					lname := strings.TrimSpace(strings.TrimPrefix(line, "label"))
+					err := f.Label(lname)
 					if err != nil {
-						fmt.Printf("Fatal: Failed to parse indirection: %v\n", err)
-						os.Exit(1)
+						fatalf("Failed to set label %s: %s", lname, err)
 					}
-					if indirect, ok := ind.(gbasm.Indirect); ok {
-						indirect.Size = bits
-						args[i-1] = indirect
-					} else {
-						args[i-1] = ind
+					continue
+				}
+				if strings.HasPrefix(line, "table ") {
+					// table name label1 label2 ...
+					// Declares read-only data holding the addresses of
+					// labels in this function, to jump through with
+					// `lea rax name` then `jmp [rax+index*8]`.
+					params := SplitSpace(strings.TrimSpace(strings.TrimPrefix(line, "table")))
+					if len(params) < 2 {
+						fatalf("Expect a table to contain a name and at least one label, but have %v", params)
+					}
+					if err := o.AddTable(params[0], f.Name, params[1:], isPub); err != nil {
+						fatalf("table %s: %s", params[0], err)
+					}
+					first := 2 // the field of the first label
+					if isPub {
+						first++
+					}
+					for i := range params[1:] {
+						relocSites[params[0]] = append(relocSites[params[0]], basError{pos: at, col: fieldCol(first + i)})
 					}
 					continue
 				}
-
-				if strings.HasPrefix(parts[i], "[") {
-					ind, err := ParseIndirect(o, f, parts[i])
+				if line == "prologue" {
+					err = f.Prologue()
 					if err != nil {
-						fmt.Printf("Fatal: Failed to parse indirection: %v\n", err)
-						os.Exit(1)
+						fatalf("Failed to write function prologue: %s", err)
 					}
-					args[i-1] = ind
+					plan.prologue()
 					continue
 				}
-
-				if strings.HasPrefix(parts[i], "0x") {
-					num, err := strconv.ParseUint(strings.TrimPrefix(parts[i], "0x"), 16, 64)
+				if line == "epilogue" {
+					err = f.Epilogue()
 					if err != nil {
-						fmt.Printf("Fatal: Failed to parse hex %s: %s\n", parts[i], err)
-						os.Exit(1)
+						fatalf("Failed to write function epilogue: %s", err)
 					}
-					//fmt.Printf("%v -> Parsed %s into %d (%X)(%v)\n", parts, parts[i], smallestUi(num), smallestUi(num), reflect.TypeOf(smallestUi(num)).String())
-					args[i-1] = smallestUi(num)
 					continue
 				}
-				if num, err := strconv.ParseInt(parts[i], 10, 64); err == nil {
-					args[i-1] = smallestInt(num)
-					continue
+
+				parts := SplitSpace(line)
+				instrUp := strings.ToUpper(parts[0])
+				for _, i := range jumps {
+					if i == instrUp {
+						if len(parts) != 2 {
+							fatalf("Jumps take exactly 1 argument, but got: %v", line)
+						}
+						// Indirect CALL or JMP: when the operand is a local
+						// (Ralloc), a register or a memory operand, emit the
+						// r/m64 form rather than the rel32 relocation form.
+						// Function-pointer call sites and jump tables rely on
+						// this; everything else still goes through the Jump
+						// (symbol-relocation) path.
+						if instrUp == "CALL" || instrUp == "JMP" {
+							var target interface{}
+							if alloc := f.AllocFor(parts[1]); alloc != nil {
+								target = alloc
+							} else if reg, err := gbasm.ParseReg(parts[1]); err == nil {
+								target = reg
+							} else if bits, rest, ok := parseSizePrefix(parts[1]); ok {
+								ind, err := ParseIndirect(o, f, src.env(), rest)
+								if err != nil {
+									fatalfField(1, "Failed to parse indirection: %v", err)
+								}
+								if indirect, ok := ind.(gbasm.Indirect); ok {
+									indirect.Size = bits
+									ind = indirect
+								}
+								target = ind
+							} else if strings.HasPrefix(parts[1], "[") {
+								ind, err := ParseIndirect(o, f, src.env(), parts[1])
+								if err != nil {
+									fatalfField(1, "Failed to parse indirection: %v", err)
+								}
+								target = ind
+							}
+							if target != nil {
+								if instrUp == "CALL" {
+									f.EvictForCall()
+								} else {
+									// As for a jump to a label, the target
+									// expects every local in memory.
+									f.EvictAll()
+								}
+								if err := f.Instr(instrUp, target); err != nil {
+									fatalf("Instruction %v: %s", parts, err)
+								}
+								continue lines
+							}
+						}
+						err = f.Jump(instrUp, parts[1])
+						if err != nil {
+							fatalf("Instruction %v: %s", parts, err)
+						}
+						continue lines
+					}
 				}
-				// Try unsigned for values larger than INT64_MAX.
-				if num, err := strconv.ParseUint(parts[i], 10, 64); err == nil {
-					args[i-1] = smallestUi(num)
-					continue
+
+				args := make([]interface{}, len(parts)-1)
+				// exprs holds the values of the arguments given as expressions,
+				// to check them against the width of the first operand.
+				var exprs map[int]int64
+				for i := 1; i < len(parts); i++ {
+					// Partial-of-alloc syntax: name:N where N is 8, 16, 32, or 64.
+					// Refers to the low N bits of the named allocation.
+					if colon := strings.IndexByte(parts[i], ':'); colon > 0 {
+						name := parts[i][:colon]
+						sizeStr := parts[i][colon+1:]
+						if alloc := f.AllocFor(name); alloc != nil {
+							bits, err := strconv.Atoi(sizeStr)
+							if err == nil && (bits == 8 || bits == 16 || bits == 32 || bits == 64) {
+								args[i-1] = &gbasm.RallocPartial{Ra: alloc, Bits: bits}
+								continue
+							}
+							fatalfField(i, "bad partial size in %q: must be 8, 16, 32, or 64", parts[i])
+						}
+					}
+
+					if alloc := f.AllocFor(parts[i]); alloc != nil {
+						args[i-1] = alloc //alloc.Register()
+						continue
+					}
+					if _, ok := src.consts[parts[i]]; ok || isExpr(parts[i]) {
+						v, err := src.env().eval(parts[i])
+						if err != nil {
+							fatalfField(i, "%s", err)
+						}
+						if exprs == nil {
+							exprs = make(map[int]int64)
+						}
+						exprs[i-1] = v
+						args[i-1] = smallestInt(v)
+						continue
+					}
+					if v := o.VarFor(parts[i]); v != nil {
+						//panic(fmt.Sprintf("VAR FOR %s\n", parts[i]))
+						args[i-1] = v
+						continue
+					}
+
+					if reg, err := gbasm.ParseReg(parts[i]); err == nil {
+						args[i-1] = reg
+						continue
+					}
+
+					if bits, rest, ok := parseSizePrefix(parts[i]); ok {
+						ind, err := ParseIndirect(o, f, src.env(), rest)
+						if err != nil {
+							fatalfField(i, "Failed to parse indirection: %v", err)
+						}
+						if indirect, ok := ind.(gbasm.Indirect); ok {
+							indirect.Size = bits
+							args[i-1] = indirect
+						} else {
+							args[i-1] = ind
+						}
+						continue
+					}
+
+					if strings.HasPrefix(parts[i], "[") {
+						ind, err := ParseIndirect(o, f, src.env(), parts[i])
+						if err != nil {
+							fatalfField(i, "Failed to parse indirection: %v", err)
+						}
+						args[i-1] = ind
+						continue
+					}
+
+					if strings.HasPrefix(parts[i], "0x") {
+						num, err := strconv.ParseUint(strings.TrimPrefix(parts[i], "0x"), 16, 64)
+						if err != nil {
+							fatalfField(i, "Failed to parse hex %s: %s", parts[i], err)
+						}
+						//fmt.Printf("%v -> Parsed %s into %d (%X)(%v)\n", parts, parts[i], smallestUi(num), smallestUi(num), reflect.TypeOf(smallestUi(num)).String())
+						args[i-1] = smallestUi(num)
+						continue
+					}
+					if num, err := strconv.ParseInt(parts[i], 10, 64); err == nil {
+						args[i-1] = smallestInt(num)
+						continue
+					}
+					// Try unsigned for values larger than INT64_MAX.
+					if num, err := strconv.ParseUint(parts[i], 10, 64); err == nil {
+						args[i-1] = smallestUi(num)
+						continue
+					}
+					// Unresolved identifier: treat as an external symbol
+					// reference (e.g. a function name like "pkg.fn"). The
+					// encoder turns *Var operands into RIP-relative
+					// references with a relocation against ot.Name, which
+					// the linker resolves to whichever symbol matches —
+					// data or code. Only fields used by that path need to
+					// be set; VType/Val stay empty.
+					if isIdentifier(parts[i]) {
+						args[i-1] = &gbasm.Var{Name: parts[i]}
+						continue
+					}
+					args[i-1] = parts[i]
 				}
-				// Unresolved identifier: treat as an external symbol
-				// reference (e.g. a function name like "pkg.fn"). The
-				// encoder turns *Var operands into RIP-relative
-				// references with a relocation against ot.Name, which
-				// the linker resolves to whichever symbol matches —
-				// data or code. Only fields used by that path need to
-				// be set; VType/Val stay empty.
-				if isIdentifier(parts[i]) {
-					args[i-1] = &gbasm.Var{Name: parts[i]}
-					continue
+				if len(exprs) > 0 {
+					width := operandWidth(args[0])
+					for i, v := range exprs {
+						if i > 0 && !fitsWidth(v, width) {
+							fatalfField(i+1, "%s = %d overflows the %d-bit operand %s", parts[i+1], v, width, parts[1])
+						}
+					}
+				}
+				//fmt.Printf("INSTRUP: %#v\n args: %#v\n", instrUp, args)
+				err := f.Instr(instrUp, args...)
+				if err != nil {
+					fatalf("Instruction %v: %s", parts, err)
 				}
-				args[i-1] = parts[i]
-			}
-			//fmt.Printf("INSTRUP: %#v\n args: %#v\n", instrUp, args)
-			err := f.Instr(instrUp, args...)
-			if err != nil {
-				fmt.Printf("Fatal: Instruction %v: %s\n", parts, err)
-				os.Exit(1)
 			}
+		}, &broken) {
+			src.SkipBlock()
 		}
 	}
+	exitOnErrors()
 	if o == nil {
 		fmt.Printf("Fatal: No non-empty files found.\n")
 		os.Exit(1)
@@ -1460,12 +1677,21 @@ func main() {
 		cacheName := "__typedesc_cache_" + strings.TrimPrefix(name, "__typedesc_")
 		cv := o.Vars[cacheName]
 		if cv == nil || cv.Kind != gbasm.KindTypedescCache {
-			fmt.Printf("Fatal: typedesc %s has no matching typedesc_cache %s in the same object file\n", name, cacheName)
-			os.Exit(1)
+			errs = append(errs, basError{msg: fmt.Sprintf("typedesc %s has no matching typedesc_cache %s in the same object file", name, cacheName)})
 		}
 	}
+	// Export the labels that jump tables and other data relocations
+	// refer to, so the linker can resolve them.
+	for _, err := range o.ExportLabelRefs() {
+		e := basError{msg: err.Error()}
+		if le, ok := err.(*gbasm.LabelRefError); ok && le.Reloc < len(relocSites[le.Var]) {
+			e.pos, e.col = relocSites[le.Var][le.Reloc].pos, relocSites[le.Var][le.Reloc].col
+		}
+		errs = append(errs, e)
+	}
+	exitOnErrors()
 
-	err := o.Output()
+	err := writeObject(o)
 	if err != nil {
 		fmt.Printf("Fatal: Failed to write object file: %s\n", err)
 		os.Exit(1)
@@ -1484,6 +1710,17 @@ func main() {
 		// 		fmt.Printf("\n")
 	}
 
+	if *verbose {
+		reportPeephole(o)
+	}
+
+	if *listing != "" {
+		if err := writeListing(*listing, o); err != nil {
+			fmt.Printf("Fatal: Failed to write listing: %s\n", err)
+			os.Exit(1)
+		}
+	}
+
 	// 	// This part should be moved to the linker, but for now we'll put it here for testing.
 	// 	text := gbasm.Link([]*gbasm.OFile{o})
 	// 	for _, b := range text {
@@ -0,0 +1,25 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/knusbaum/gbasm"
+)
+
+var optimize = flag.Bool("O", false, "Run the peephole optimizer over each function")
+var verbose = flag.Bool("v", false, "Report how many times each peephole rule fired")
+
+// reportPeephole prints, for each peephole rule, how many times it
+// rewrote the instructions of o's functions.
+func reportPeephole(o *gbasm.OFile) {
+	totals := make(map[string]int)
+	for _, f := range o.Funcs {
+		for rule, n := range f.PeepholeStats() {
+			totals[rule] += n
+		}
+	}
+	for _, rule := range gbasm.PeepholeRules() {
+		fmt.Printf("peephole %-14s %d\n", rule, totals[rule])
+	}
+}
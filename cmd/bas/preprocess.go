@@ -0,0 +1,470 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/knusbaum/gbasm"
+)
+
+// srcPos is the position of a line of assembler input.
+type srcPos struct {
+	file string
+	line int
+	// via describes the macro invocation the line was expanded from, if
+	// any, e.g. "macro exit at init.bs:12".
+	via string
+}
+
+func (p srcPos) String() string {
+	s := fmt.Sprintf("%s:%d", p.file, p.line)
+	if p.via != "" {
+		s += " (" + p.via + ")"
+	}
+	return s
+}
+
+type srcLine struct {
+	text string
+	pos  srcPos
+}
+
+// A macro is a named, parameterized sequence of lines defined with
+// "macro name params..." and "endm".
+type macro struct {
+	name   string
+	params []string
+	body   []srcLine
+	// labels are the labels the body declares. Each expansion renames them
+	// so a macro can be used more than once in a function.
+	labels []string
+	pos    srcPos
+}
+
+type srcFile struct {
+	name    string
+	file    *os.File
+	scanner *bufio.Scanner
+	line    int
+}
+
+// maxMacroDepth bounds nested macro expansion, catching macros that expand
+// to themselves.
+const maxMacroDepth = 64
+
+// A source reads the lines of one assembler input file with the
+// preprocessor directives resolved:
+//
+//	include "file.bs"       read file.bs (relative to this file) in place
+//	const NAME expr         define an integer constant (equ is a synonym)
+//	macro name [params...]  define a macro, up to a line reading endm
+//	name [args...]          expand macro name, substituting its params
+//
+// Scan and Text stand in for a bufio.Scanner over the file. Constants are
+// substituted later, wherever an expression is evaluated.
+type source struct {
+	files   []*srcFile // include stack, innermost last
+	pending []srcLine  // lines of the macro expansions in progress
+	depth   []int      // expansion depth of each pending line
+	ahead   []srcLine  // lines read by Lookahead, to be returned again
+	cur     srcLine
+	// open counts the braces of a block directive opened on the last line
+	// Scan returned and not yet closed by the lines ScanRaw has read.
+	open int
+
+	consts    map[string]int64
+	constPos  map[string]srcPos
+	o         *gbasm.OFile // for sizeof and offsetof in constants
+	macros    map[string]*macro
+	expansion int // counts expansions to make their labels unique
+}
+
+func newSource(name string) (*source, error) {
+	s := &source{
+		consts:   make(map[string]int64),
+		constPos: make(map[string]srcPos),
+		macros:   make(map[string]*macro),
+	}
+	if err := s.push(name); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *source) push(name string) error {
+	name = filepath.Clean(name)
+	for _, f := range s.files {
+		if f.name == name {
+			var chain []string
+			for _, f := range s.files {
+				chain = append(chain, f.name)
+			}
+			return fmt.Errorf("include cycle: %s -> %s", strings.Join(chain, " -> "), name)
+		}
+	}
+	file, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	s.files = append(s.files, &srcFile{name: name, file: file, scanner: bufio.NewScanner(file)})
+	return nil
+}
+
+func (s *source) Close() {
+	for _, f := range s.files {
+		f.file.Close()
+	}
+	s.files = nil
+}
+
+// Text returns the line read by the last call to Scan or ScanRaw.
+func (s *source) Text() string {
+	return s.cur.text
+}
+
+// next returns the next line of input, from a pending macro expansion or
+// the innermost file, along with its expansion depth.
+func (s *source) next() (srcLine, int, bool) {
+	if len(s.pending) > 0 {
+		l, d := s.pending[0], s.depth[0]
+		s.pending, s.depth = s.pending[1:], s.depth[1:]
+		return l, d, true
+	}
+	for len(s.files) > 0 {
+		f := s.files[len(s.files)-1]
+		if f.scanner.Scan() {
+			f.line++
+			return srcLine{text: f.scanner.Text(), pos: srcPos{file: f.name, line: f.line}}, 0, true
+		}
+		if err := f.scanner.Err(); err != nil {
+			fatalf("%s", err)
+		}
+		f.file.Close()
+		s.files = s.files[:len(s.files)-1]
+	}
+	return srcLine{}, 0, false
+}
+
+// ScanRaw advances to the next line without interpreting it. Multi-line
+// directives read their bodies with it, so a struct field named "include"
+// is just a field.
+func (s *source) ScanRaw() bool {
+	l, ok := srcLine{}, true
+	if len(s.ahead) > 0 {
+		l, s.ahead = s.ahead[0], s.ahead[1:]
+	} else {
+		l, _, ok = s.next()
+	}
+	if !ok {
+		return false
+	}
+	s.setCur(l)
+	switch t := strings.TrimSpace(l.text); {
+	case t == "}":
+		s.open--
+	case strings.HasSuffix(t, "{") && !strings.HasPrefix(t, "//"):
+		s.open++
+	}
+	return true
+}
+
+func (s *source) setCur(l srcLine) {
+	s.cur = l
+	at = l.pos
+	atText = l.text
+}
+
+// SkipBlock skips the rest of the block directive the last line Scan
+// returned opened, after an error abandoned it part way through.
+func (s *source) SkipBlock() {
+	for s.open > 0 && s.ScanRaw() {
+	}
+}
+
+// Scan advances to the next line that is not a preprocessor directive,
+// processing the directives it passes.
+func (s *source) Scan() bool {
+	if len(s.ahead) > 0 {
+		// Already interpreted by Lookahead.
+		s.setCur(s.ahead[0])
+		s.ahead = s.ahead[1:]
+		s.open = 0
+		if strings.HasSuffix(strings.TrimSpace(s.cur.text), "{") {
+			s.open = 1
+		}
+		return true
+	}
+	for {
+		l, depth, ok := s.next()
+		if !ok {
+			return false
+		}
+		s.setCur(l)
+		s.open = 0
+		fields := SplitSpace(strings.TrimSpace(l.text))
+		if len(fields) == 0 {
+			return true
+		}
+		switch fields[0] {
+		case "include":
+			s.include(fields)
+			continue
+		case "const", "equ":
+			s.defineConst(fields)
+			continue
+		case "macro":
+			s.defineMacro(fields)
+			continue
+		case "endm":
+			fatalf("endm without macro")
+		}
+		if m, ok := s.macros[fields[0]]; ok {
+			if depth >= maxMacroDepth {
+				fatalf("macro %s: expansion nested more than %d deep", m.name, maxMacroDepth)
+			}
+			s.expand(m, fields[1:], depth+1)
+			continue
+		}
+		if strings.HasSuffix(fields[len(fields)-1], "{") {
+			s.open = 1
+		}
+		return true
+	}
+}
+
+// Lookahead reads lines with Scan up to and including the first one stop
+// accepts, or to the end of the input, and returns them. Scan returns them
+// again afterwards. Errors in the directives passed on the way are
+// reported as they are read, and the lines that had them are dropped, as
+// they would be by the assembler's own loop.
+func (s *source) Lookahead(stop func(line string) bool) []srcLine {
+	cur, open, pos, text := s.cur, s.open, at, atText
+	defer func() {
+		s.cur, s.open, at, atText = cur, open, pos, text
+	}()
+	var lines []srcLine
+	for {
+		ok, aborted := s.scanAhead()
+		if aborted {
+			continue
+		}
+		if !ok {
+			break
+		}
+		lines = append(lines, s.cur)
+		if stop(strings.TrimSpace(s.cur.text)) {
+			break
+		}
+	}
+	s.ahead = append(lines[:len(lines):len(lines)], s.ahead...)
+	return lines
+}
+
+// scanAhead is Scan for Lookahead, recovering from an error in a directive.
+func (s *source) scanAhead() (ok, aborted bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(lineAbort); !ok {
+				panic(r)
+			}
+			aborted = true
+		}
+	}()
+	return s.Scan(), false
+}
+
+func (s *source) include(fields []string) {
+	if len(fields) != 2 || len(fields[1]) < 2 || !strings.HasPrefix(fields[1], `"`) || !strings.HasSuffix(fields[1], `"`) {
+		fatalf("include expects a quoted file name, but have %v", fields[1:])
+	}
+	name := fields[1][1 : len(fields[1])-1]
+	if !filepath.IsAbs(name) {
+		name = filepath.Join(filepath.Dir(at.file), name)
+	}
+	if err := s.push(name); err != nil {
+		fatalf("include %s: %s", fields[1], err)
+	}
+}
+
+func (s *source) defineConst(fields []string) {
+	if len(fields) < 3 {
+		fatalf("%s expects a name and a value, but have %v", fields[0], fields[1:])
+	}
+	name := fields[1]
+	if !isIdentifier(name) || strings.Contains(name, ".") {
+		fatalf("%s: %q is not a valid constant name", fields[0], name)
+	}
+	if _, err := gbasm.ParseReg(name); err == nil {
+		fatalf("%s: %s is a register", fields[0], name)
+	}
+	if pos, ok := s.constPos[name]; ok {
+		fatalf("constant %s already defined at %s", name, pos)
+	}
+	v, err := s.env().eval(strings.Join(fields[2:], " "))
+	if err != nil {
+		fatalf("%s %s: %s", fields[0], name, err)
+	}
+	s.consts[name] = v
+	s.constPos[name] = at
+}
+
+// env returns the environment expressions are evaluated in.
+func (s *source) env() exprEnv {
+	return exprEnv{consts: s.consts, o: s.o}
+}
+
+func (s *source) defineMacro(fields []string) {
+	if len(fields) < 2 {
+		fields = append(fields, "")
+	}
+	m := &macro{name: fields[1], params: fields[2:], pos: at}
+	// Read the body before reporting a bad header, so that its lines are
+	// not assembled as though they were outside the macro.
+	start, startText := at, atText
+	var nested srcLine
+	for {
+		if !s.ScanRaw() {
+			at, atText = start, startText
+			fatalf("macro %s: unexpected EOF before endm", m.name)
+		}
+		body := SplitSpace(strings.TrimSpace(s.cur.text))
+		if len(body) > 0 && body[0] == "endm" {
+			break
+		}
+		if len(body) > 0 && body[0] == "macro" && nested.pos.file == "" {
+			nested = s.cur
+		}
+		if len(body) == 2 && body[0] == "label" {
+			m.labels = append(m.labels, body[1])
+		}
+		m.body = append(m.body, s.cur)
+	}
+	end, endText := at, atText
+	at, atText = start, startText
+	if err := m.check(s.macros); err != nil {
+		fatalf("%s", err)
+	}
+	if nested.pos.file != "" {
+		at, atText = nested.pos, nested.text
+		fatalf("macro %s: macro definitions cannot be nested", m.name)
+	}
+	at, atText = end, endText
+	s.macros[m.name] = m
+}
+
+// directives are the words bas reads as directives at the start of a
+// line, which a macro must not shadow.
+var directives = map[string]bool{
+	"acquire": true, "align": true, "arg": true, "argi": true, "bytes": true,
+	"data": true, "epilogue": true, "evict": true, "forget": true,
+	"forgetall": true, "function": true, "iface_desc": true,
+	"immutable": true, "importhash": true, "inreg": true, "interface": true,
+	"label": true, "line": true, "local": true, "package": true,
+	"prologue": true, "pub": true, "release": true, "retaliases": true,
+	"struct": true, "table": true, "type": true, "typealias": true,
+	"typedesc": true, "typedesc_cache": true, "use": true, "values": true,
+	"var": true, "volatile": true,
+}
+
+// check reports whether m's name and parameters are valid, given the
+// macros already defined.
+func (m *macro) check(macros map[string]*macro) error {
+	switch m.name {
+	case "":
+		return fmt.Errorf("macro expects a name")
+	case "include", "const", "equ", "macro", "endm":
+		return fmt.Errorf("macro: %s is a preprocessor directive", m.name)
+	}
+	if directives[m.name] {
+		return fmt.Errorf("macro: %s is a bas directive", m.name)
+	}
+	if asm, err := gbasm.LoadAsm(gbasm.AMD64); err == nil && asm.IsInstruction(m.name) {
+		return fmt.Errorf("macro: %s is an instruction", m.name)
+	}
+	if prev, ok := macros[m.name]; ok {
+		return fmt.Errorf("macro %s already defined at %s", m.name, prev.pos)
+	}
+	for i, p := range m.params {
+		if !isIdentifier(p) || strings.Contains(p, ".") {
+			return fmt.Errorf("macro %s: %q is not a valid parameter name", m.name, p)
+		}
+		for _, q := range m.params[:i] {
+			if p == q {
+				return fmt.Errorf("macro %s: duplicate parameter %s", m.name, p)
+			}
+		}
+	}
+	return nil
+}
+
+// expand queues the body of m with args substituted for its parameters
+// and its labels renamed, ahead of any lines already pending.
+func (s *source) expand(m *macro, args []string, depth int) {
+	if len(args) != len(m.params) {
+		fatalf("macro %s takes %d arguments, but have %d", m.name, len(m.params), len(args))
+	}
+	s.expansion++
+	subst := make(map[string]string)
+	for _, l := range m.labels {
+		subst[l] = fmt.Sprintf("%s__%s%d", l, m.name, s.expansion)
+	}
+	for i, p := range m.params {
+		subst[p] = args[i]
+	}
+	via := fmt.Sprintf("macro %s at %s", m.name, at)
+	lines := make([]srcLine, len(m.body))
+	depths := make([]int, len(m.body))
+	for i, l := range m.body {
+		pos := l.pos
+		pos.via = via
+		lines[i] = srcLine{text: replaceWords(l.text, subst), pos: pos}
+		depths[i] = depth
+	}
+	s.pending = append(lines, s.pending...)
+	s.depth = append(depths, s.depth...)
+}
+
+// replaceWords replaces each whole identifier in text that is a key of
+// subst. Quoted strings are left alone.
+func replaceWords(text string, subst map[string]string) string {
+	var b strings.Builder
+	isWord := func(c byte) bool {
+		return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+	}
+	for i := 0; i < len(text); {
+		c := text[i]
+		switch {
+		case c == '"':
+			j := i + 1
+			for j < len(text) && text[j] != '"' {
+				if text[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			// Take the closing quote. An unterminated string that ends
+			// in a backslash has already been skipped past the end.
+			j = min(j+1, len(text))
+			b.WriteString(text[i:j])
+			i = j
+		case isWord(c):
+			j := i
+			for j < len(text) && isWord(text[j]) {
+				j++
+			}
+			w := text[i:j]
+			if r, ok := subst[w]; ok {
+				w = r
+			}
+			b.WriteString(w)
+			i = j
+		default:
+			b.WriteByte(c)
+			i++
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,21 @@
+package main
+
+import "testing"
+
+func TestReplaceWords(t *testing.T) {
+	subst := map[string]string{"r": "rax", "n": "8"}
+	for _, tt := range []struct {
+		text, want string
+	}{
+		{"mov r n", "mov rax 8"},
+		{"mov rr n", "mov rr 8"},
+		{`data s "r n"`, `data s "r n"`},
+		{`data s "r \" n" r`, `data s "r \" n" rax`},
+		{`data s "r n`, `data s "r n`},
+		{`data s "abc\`, `data s "abc\`},
+	} {
+		if got := replaceWords(tt.text, subst); got != tt.want {
+			t.Errorf("replaceWords(%q): expected %q, got %q", tt.text, tt.want, got)
+		}
+	}
+}
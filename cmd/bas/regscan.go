@@ -0,0 +1,330 @@
+package main
+
+import (
+	"flag"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/knusbaum/gbasm"
+)
+
+var regalloc = flag.String("ra", "lru", "Register allocation for locals: lru, or scan to plan callee-saved registers for them by linear scan")
+
+// calleeSaved are the registers an epilogue restores, and the ones
+// gbasm.PlanRegisters hands out.
+var calleeSaved = []gbasm.Register{gbasm.R_RBX, gbasm.R12, gbasm.R13, gbasm.R14, gbasm.R15}
+
+// defOnly are the instructions that write their first operand without
+// reading it.
+var defOnly = map[string]bool{
+	"MOV": true, "MOVZX": true, "MOVSX": true, "MOVSXD": true, "LEA": true, "POP": true,
+}
+
+// implicitRegs are the callee-saved registers instructions use without
+// naming them.
+var implicitRegs = map[string][]gbasm.Register{
+	"CPUID":      {gbasm.R_RBX},
+	"CMPXCHG8B":  {gbasm.R_RBX},
+	"CMPXCHG16B": {gbasm.R_RBX},
+}
+
+var identRe = regexp.MustCompile(`[_a-zA-Z][_a-zA-Z0-9]*`)
+
+// A regPlan holds the registers planned for the locals of the function
+// being assembled, by the position of the line declaring each.
+type regPlan struct {
+	regs map[srcPos]gbasm.Register
+	f    *gbasm.Function
+	// waiting are the locals declared before the prologue, which is where
+	// the registers they are planned in are saved.
+	waiting   []pin
+	prologued bool
+}
+
+type pin struct {
+	name string
+	l    *gbasm.Ralloc
+	reg  gbasm.Register
+}
+
+// planFunction reads ahead the body of f and plans registers for its
+// locals with gbasm.PlanRegisters. It returns nil for a function without a
+// prologue, since nothing would save the registers.
+func planFunction(src *source, f *gbasm.Function) *regPlan {
+	lines := src.Lookahead(func(line string) bool {
+		return isDeclaration(line)
+	})
+	b := scanBuilder{names: make(map[string]int), bad: make(map[int]bool)}
+	for _, l := range lines {
+		line := strings.TrimSpace(l.text)
+		if line == "" || strings.HasPrefix(line, "//") {
+			continue
+		}
+		if isDeclaration(line) {
+			break
+		}
+		b.line(strings.TrimPrefix(line, "pub "), l.pos)
+	}
+	if !b.prologue {
+		return nil
+	}
+	for i := range b.ops {
+		b.ops[i].Uses = b.good(b.ops[i].Uses)
+		b.ops[i].Defs = b.good(b.ops[i].Defs)
+	}
+	p := &regPlan{regs: make(map[srcPos]gbasm.Register), f: f}
+	for v, r := range gbasm.PlanRegisters(b.ops, b.avoid) {
+		p.regs[b.decls[v]] = r
+		f.Reserve(r)
+	}
+	return p
+}
+
+// declared pins l, declared on the current line, to the register planned
+// for it, if there is one.
+func (p *regPlan) declared(name string, l *gbasm.Ralloc) {
+	if p == nil {
+		return
+	}
+	reg, ok := p.regs[at]
+	if !ok {
+		return
+	}
+	if !p.prologued {
+		p.waiting = append(p.waiting, pin{name, l, reg})
+		return
+	}
+	if err := l.Pin(reg); err != nil {
+		fatalf("%s", err)
+	}
+}
+
+// prologue pins the locals that were waiting for the prologue and are
+// still declared.
+func (p *regPlan) prologue() {
+	if p == nil || p.prologued {
+		return
+	}
+	p.prologued = true
+	for _, w := range p.waiting {
+		if p.f.AllocFor(w.name) != w.l {
+			continue
+		}
+		if err := w.l.Pin(w.reg); err != nil {
+			fatalf("%s", err)
+		}
+	}
+	p.waiting = nil
+}
+
+// A scanBuilder turns the lines of a function body into the ScanOps
+// gbasm.PlanRegisters reads, numbering its general-purpose locals as
+// values.
+type scanBuilder struct {
+	ops      []gbasm.ScanOp
+	names    map[string]int // the value each local name refers to now; -1 if it is not planned
+	decls    []srcPos       // the line declaring each value
+	bad      map[int]bool   // values that must not be pinned
+	avoid    []gbasm.Register
+	prologue bool
+}
+
+func (b *scanBuilder) good(vs []int) []int {
+	var out []int
+	for _, v := range vs {
+		if !b.bad[v] {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// declare starts a new value for the local name, declared at pos.
+func (b *scanBuilder) declare(name string, size int, pos srcPos) {
+	if size != 8 && size != 16 && size != 32 && size != 64 {
+		b.names[name] = -1
+		return
+	}
+	v := len(b.decls)
+	b.decls = append(b.decls, pos)
+	b.names[name] = v
+	b.ops = append(b.ops, gbasm.ScanOp{Defs: []int{v}})
+}
+
+// reg notes a register the function names, if s is one.
+func (b *scanBuilder) reg(s string) bool {
+	r, err := gbasm.ParseReg(s)
+	if err != nil {
+		return false
+	}
+	b.avoid = append(b.avoid, r)
+	return true
+}
+
+func (b *scanBuilder) value(name string) (int, bool) {
+	v, ok := b.names[name]
+	return v, ok && v >= 0
+}
+
+// operand returns the values an operand mentions, and whether it is
+// exactly one local, rather than part of one or an address.
+func (b *scanBuilder) operand(s string) (vs []int, whole bool) {
+	if v, ok := b.value(s); ok {
+		return []int{v}, true
+	}
+	if b.reg(s) {
+		return nil, false
+	}
+	if colon := strings.IndexByte(s, ':'); colon > 0 {
+		if v, ok := b.value(s[:colon]); ok {
+			return []int{v}, false
+		}
+	}
+	if _, rest, ok := parseSizePrefix(s); ok {
+		s = rest
+	}
+	if strings.HasPrefix(s, "[") {
+		for _, id := range identRe.FindAllString(s, -1) {
+			if v, ok := b.value(id); ok {
+				vs = append(vs, v)
+			} else {
+				b.reg(id)
+			}
+		}
+	}
+	return vs, false
+}
+
+// line adds the ScanOps for one line of the body, mirroring how the
+// assembler's loop reads it.
+func (b *scanBuilder) line(line string, pos srcPos) {
+	fields := SplitSpace(line)
+	args := fields[1:]
+	switch {
+	case strings.HasPrefix(line, "type"), strings.HasPrefix(line, "retaliases"), strings.HasPrefix(line, "line "):
+	case strings.HasPrefix(line, "local"):
+		if len(args) == 2 {
+			size, _ := strconv.Atoi(args[1])
+			b.declare(args[0], size, pos)
+		} else if len(args) == 3 {
+			b.names[args[0]] = -1
+			b.reg(args[2])
+		}
+	case strings.HasPrefix(line, "bytes"):
+		if len(args) > 0 {
+			b.names[args[0]] = -1
+		}
+		if len(args) == 3 {
+			b.reg(args[2])
+		}
+	case strings.HasPrefix(line, "volatile"):
+		if v, ok := b.value(strings.TrimSpace(strings.TrimPrefix(line, "volatile"))); ok {
+			b.bad[v] = true
+		}
+	case strings.HasPrefix(line, "forgetall"):
+		b.names = make(map[string]int)
+	case strings.HasPrefix(line, "forget"):
+		if len(args) == 1 {
+			delete(b.names, args[0])
+		}
+	case strings.HasPrefix(line, "inreg"):
+		if len(args) == 2 {
+			if v, ok := b.value(args[0]); ok {
+				b.bad[v] = true
+			}
+			b.reg(args[1])
+		}
+	case strings.HasPrefix(line, "use"), strings.HasPrefix(line, "evict"),
+		strings.HasPrefix(line, "acquire"), strings.HasPrefix(line, "release"):
+		for _, a := range args {
+			b.reg(a)
+		}
+	case strings.HasPrefix(line, "argi"):
+		if len(args) < 2 || len(args) == 4 {
+			// xmm arguments are not planned.
+			if len(args) > 0 {
+				b.names[args[0]] = -1
+			}
+			return
+		}
+		size := 64
+		if len(args) == 3 {
+			size, _ = strconv.Atoi(args[2])
+		}
+		b.declare(args[0], size, pos)
+	case strings.HasPrefix(line, "arg"):
+		if len(args) != 2 {
+			return
+		}
+		if r, err := gbasm.ParseReg(args[1]); err == nil {
+			b.avoid = append(b.avoid, r)
+			if r.Width() == 128 {
+				b.names[args[0]] = -1
+				return
+			}
+			b.declare(args[0], r.Width(), pos)
+		} else {
+			b.declare(args[0], 64, pos)
+		}
+	case strings.HasPrefix(line, "label"):
+		b.ops = append(b.ops, gbasm.ScanOp{Label: strings.TrimSpace(strings.TrimPrefix(line, "label"))})
+	case strings.HasPrefix(line, "table "):
+	case line == "prologue":
+		b.prologue = true
+	case line == "epilogue":
+		b.ops = append(b.ops, gbasm.ScanOp{Clobbers: calleeSaved})
+	default:
+		b.instr(strings.ToUpper(fields[0]), args)
+	}
+}
+
+func (b *scanBuilder) instr(instr string, args []string) {
+	var op gbasm.ScanOp
+	b.avoid = append(b.avoid, implicitRegs[instr]...)
+	for i, a := range args {
+		vs, whole := b.operand(a)
+		switch {
+		case whole && i == 0 && defOnly[instr]:
+			op.Defs = append(op.Defs, vs...)
+		case whole && i == 1 && instr == "LEA":
+			// Taking the address of a local keeps it in memory.
+			b.bad[vs[0]] = true
+		default:
+			op.Uses = append(op.Uses, vs...)
+		}
+	}
+	switch {
+	case instr == "RET":
+		op.Exit = true
+	case instr == "CALL":
+		op.Call = true
+	case isJump(instr) && len(args) == 1:
+		if _, isLocal := b.names[args[0]]; isLocal || isIndirectTarget(args[0]) {
+			op.Indirect = true
+		} else {
+			op.Jump = args[0]
+			op.Cond = instr != "JMP"
+		}
+	}
+	b.ops = append(b.ops, op)
+}
+
+func isJump(instr string) bool {
+	for _, j := range jumps {
+		if j == instr && j != "CALL" {
+			return true
+		}
+	}
+	return false
+}
+
+// isIndirectTarget reports whether a jump operand is a register or memory
+// operand rather than a label.
+func isIndirectTarget(s string) bool {
+	if _, err := gbasm.ParseReg(s); err == nil {
+		return true
+	}
+	_, _, sized := parseSizePrefix(s)
+	return sized || strings.HasPrefix(s, "[")
+}
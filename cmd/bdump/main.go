@@ -4,11 +4,17 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 
 	"github.com/knusbaum/gbasm"
 )
 
+var disasm = flag.Bool("d", false, "Disassemble function bodies instead of dumping their bytes.")
+var exportHash = flag.Bool("exporthash", false, "Print the export hash of each object file, instead of dumping it.")
+var jsonOut = flag.Bool("json", false, "Dump each object file as JSON, with its declarations sorted by name.")
+var importHashes = flag.Bool("importhashes", false, "Print the export hash of each package each object file was compiled against, instead of dumping it.")
+
 func main() {
 	flag.Parse()
 
@@ -31,10 +37,34 @@ func main() {
 			os.Exit(1)
 		}
 
+		if *jsonOut {
+			if err := o.WriteJSON(os.Stdout); err != nil {
+				fmt.Fprintf(os.Stderr, "Failed to dump object file %s: %s\n", arg, err)
+				os.Exit(1)
+			}
+			continue
+		}
+		if *exportHash {
+			fmt.Printf("%s  %s\n", o.ExportHash, arg)
+			continue
+		}
+		if *importHashes {
+			for _, pkg := range sortedKeys(o.ImportHashes) {
+				fmt.Printf("%s %s\n", pkg, o.ImportHashes[pkg])
+			}
+			continue
+		}
+
 		fmt.Printf("Read from %s\n", arg)
 		fmt.Printf("\tFilename: %s\n", o.Filename)
+		fmt.Printf("\tProducer: %s\n", o.Producer)
 		fmt.Printf("\tPkgname: %s\n", o.Pkgname)
 		fmt.Printf("\tExeFormat: %s\n", o.ExeFormat)
+		fmt.Printf("\tExportHash: %s\n", o.ExportHash)
+		fmt.Printf("\tImportHashes:\n")
+		for _, pkg := range sortedKeys(o.ImportHashes) {
+			fmt.Printf("\t\t%s %s\n", pkg, o.ImportHashes[pkg])
+		}
 		fmt.Printf("\tTypes:\n")
 		for v, t := range o.Types {
 			fmt.Printf("\t\t%s :: %s\n", v, t.Name)
@@ -51,6 +81,9 @@ func main() {
 				continue
 			}
 			fmt.Printf("\t\t%s :: %s = %v\n", d, v.VType, v.Val)
+			if v.Align > 1 {
+				fmt.Printf("\t\t\tAlign: %d\n", v.Align)
+			}
 			if d != v.Name {
 				fmt.Printf("\t\t\tWARNING: Data name [%s] does not match variable name [%s].\n", d, v.Name)
 			}
@@ -60,7 +93,20 @@ func main() {
 			if printStructuredRecord(v) {
 				continue
 			}
-			fmt.Printf("\t\t%s :: %s = %v\n", d, v.VType, v.Val)
+			if v.ZeroFill > 0 {
+				fmt.Printf("\t\t%s :: %s = zero-fill %d bytes\n", d, v.VType, v.ZeroFill)
+			} else {
+				fmt.Printf("\t\t%s :: %s = %v\n", d, v.VType, v.Val)
+			}
+			if v.IsConst {
+				fmt.Printf("\t\t\tConst\n")
+			}
+			if v.SrcFile != "" {
+				fmt.Printf("\t\t\tDeclared at %s:%d\n", v.SrcFile, v.SrcLine)
+			}
+			if v.Align > 1 {
+				fmt.Printf("\t\t\tAlign: %d\n", v.Align)
+			}
 			if d != v.Name {
 				fmt.Printf("\t\t\tWARNING: Data name [%s] does not match variable name [%s].\n", d, v.Name)
 			}
@@ -100,6 +146,9 @@ func main() {
 			}
 			fmt.Printf("\t\t\tSrcFile: %s\n", v.SrcFile)
 			fmt.Printf("\t\t\tSrcLine: %d\n", v.SrcLine)
+			if v.Align > 1 {
+				fmt.Printf("\t\t\tAlign: %d\n", v.Align)
+			}
 			fmt.Printf("\t\t\tArgs:\n")
 			for _, a := range v.Args {
 				fmt.Printf("\t\t\t\t%s :: %s = %v\n", a.Name, a.VType, a.Val)
@@ -112,10 +161,28 @@ func main() {
 			for _, s := range v.Relocations {
 				fmt.Printf("\t\t\t\t0x%X -> %s\n", s.Offset, s.Symbol)
 			}
-			fmt.Printf("\t\t\tBODY:\n")
+			if len(v.Lines) > 0 {
+				fmt.Printf("\t\t\tLines:\n")
+				for _, l := range v.Lines {
+					fmt.Printf("\t\t\t\t0x%X %s:%d\n", l.Offset, l.File, l.Line)
+				}
+			}
+			if len(v.Locals) > 0 {
+				fmt.Printf("\t\t\tLocals:\n")
+				for _, l := range v.Locals {
+					fmt.Printf("\t\t\t\t%s :: %s at [RBP%+d], 0x%X-0x%X\n", l.Name, l.Type, l.Offset, l.Start, l.End)
+				}
+			}
 			bs, err := v.Body()
+			if *disasm {
+				fmt.Printf("\t\t\tDISASSEMBLY:\n")
+			} else {
+				fmt.Printf("\t\t\tBODY:\n")
+			}
 			if err != nil {
 				fmt.Printf("\t\t\t\tError resolving body: %v\n", err)
+			} else if *disasm {
+				printDisassembly(bs, v.Relocations)
 			} else {
 				for _, b := range bs {
 					fmt.Printf("%X ", b)
@@ -126,6 +193,52 @@ func main() {
 	}
 }
 
+func sortedKeys(m map[string]string) []string {
+	var ks []string
+	for k := range m {
+		ks = append(ks, k)
+	}
+	sort.Strings(ks)
+	return ks
+}
+
+// printDisassembly prints one line per instruction in bs. Instructions
+// holding a relocation are annotated with the symbol the linker will patch
+// in, since the encoded displacement is just a placeholder.
+func printDisassembly(bs []byte, relocs []gbasm.Relocation) {
+	ds, err := gbasm.Disassemble(bs)
+	end := 0
+	for _, d := range ds {
+		end = d.Offset + d.Len()
+		var hex strings.Builder
+		for i, b := range d.Bytes {
+			if i > 0 {
+				hex.WriteByte(' ')
+			}
+			fmt.Fprintf(&hex, "%02x", b)
+		}
+		fmt.Printf("\t\t\t\t%04x  %-30s %s", d.Offset, hex.String(), d)
+		for _, r := range relocs {
+			if int(r.Offset) >= d.Offset && int(r.Offset) < end {
+				if r.Addend != 0 {
+					fmt.Printf("\t; %s%+d", r.Symbol, r.Addend)
+				} else {
+					fmt.Printf("\t; %s", r.Symbol)
+				}
+			}
+		}
+		fmt.Printf("\n")
+	}
+	if err != nil {
+		fmt.Printf("\t\t\t\t%v\n", err)
+		fmt.Printf("\t\t\t\t%04x  ", end)
+		for _, b := range bs[end:] {
+			fmt.Printf("%X ", b)
+		}
+		fmt.Printf("\n")
+	}
+}
+
 // printStructuredRecord pretty-prints typedesc / iface_desc / typedesc_cache
 // records. Returns true if v was a structured record (and was printed).
 // formatBorrowMasks renders a method's per-slot borrow descriptor (one u64
@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strings"
 
 	"github.com/knusbaum/gbasm"
 )
 
 var out = flag.String("o", "b.out", "Write the linked executable to this file")
 var help = flag.Bool("h", false, "Print this help message.")
+var pie = flag.Bool("pie", false, "Write a position-independent executable, which the kernel loads at a random address")
 
 func main() {
 	flag.Parse()
@@ -27,23 +29,50 @@ func main() {
 	}
 
 	var ofs []*gbasm.OFile
-	pkgs := make(map[string]*gbasm.OFile)
+	var as []*gbasm.Archive
+	var es []*gbasm.ELFObject
 	for i := 0; i < flag.NArg(); i++ {
 		arg := flag.Arg(i)
+		// An ELF object is linked as the package its file is named for,
+		// or the one given as pkg=file.o.
+		pkg, file, named := strings.Cut(arg, "=")
+		if !named {
+			pkg, file = gbasm.ELFPackage(arg), arg
+		}
+		if gbasm.IsELF(file) {
+			e, err := gbasm.ReadELF(file, pkg)
+			if err != nil {
+				fmt.Printf("Failed to read ELF object %s: %s\n", file, err)
+				os.Exit(1)
+			}
+			es = append(es, e)
+			continue
+		}
+		// Archives only supply the packages the objects need.
+		if gbasm.IsArchive(arg) {
+			a, err := gbasm.ReadArchive(arg)
+			if err != nil {
+				fmt.Printf("Failed to read archive %s: %s\n", arg, err)
+				os.Exit(1)
+			}
+			as = append(as, a)
+			continue
+		}
 		o, err := gbasm.ReadOFile(arg)
 		if err != nil {
 			fmt.Printf("Failed to read object file %s: %s\n", arg, err)
 			os.Exit(1)
 		}
-		if o1, ok := pkgs[o.Pkgname]; ok {
-			fmt.Printf("Found duplicate package %s in object files %s and %s\n", o.Pkgname, o1.Filename, o.Filename)
-			os.Exit(1)
-		}
-		pkgs[o.Pkgname] = o
 		ofs = append(ofs, o)
 	}
 
-	err := gbasm.LinkExe(*out, gbasm.ELF, ofs)
+	err := gbasm.LinkExe(*out, gbasm.ELF, ofs, as, es, *pie)
+	if errs, ok := err.(gbasm.LinkErrors); ok {
+		for _, e := range errs {
+			fmt.Printf("Fatal: %s\n", e)
+		}
+		os.Exit(1)
+	}
 	if err != nil {
 		log.Fatalf("Failed to write exe: %s", err)
 	}
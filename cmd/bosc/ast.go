@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/davecgh/go-spew/spew"
@@ -91,10 +92,18 @@ type Context struct {
 	funcs map[string]*FuncDecl
 	// maps imported package names to that package's function declarations.
 	imports map[string]map[string]*FuncDecl
+	// maps imported package names to the export hash of the .bo each was
+	// imported from, recorded in the output so a build can tell whether
+	// this package needs recompiling when an import is rebuilt.
+	importHashes map[string]string
 	// maps imported package names to that package's exported variables
 	// (pkg → varname → ASTType). Used to resolve cross-package var reads
 	// like `io.STDIN` at type-check and codegen time.
 	importedVars map[string]map[string]ASTType
+	// maps imported package names to the exported variables that package
+	// declared immutable, and where (pkg → varname → file:line, or "" if
+	// the .bo does not say). Writes to these are rejected.
+	importedConsts map[string]map[string]string
 	// maps user-defined type alias names to their underlying types.
 	typeAliases map[string]ASTType
 	// maps interface names to their declarations.
@@ -176,6 +185,7 @@ func NewContext() *Context {
 		funcs:           make(map[string]*FuncDecl),
 		imports:         make(map[string]map[string]*FuncDecl),
 		importedVars:    make(map[string]map[string]ASTType),
+		importedConsts:  make(map[string]map[string]string),
 		typeAliases:     make(map[string]ASTType),
 		interfaceDecls:  make(map[string]*InterfaceDecl),
 		valuesDecls:     make(map[string]*ValuesDecl),
@@ -940,12 +950,39 @@ func (c *Context) DefineImportedFunc(pkg, name string, f *FuncDecl) {
 
 // DefineImportedVar registers a variable from an imported package along
 // with its type as seen from the consumer (i.e., struct/alias names
-// already qualified to the producer's package).
-func (c *Context) DefineImportedVar(pkg, name string, t ASTType) {
+// already qualified to the producer's package), whether the producer
+// declared it immutable, and where it did ("" if unknown).
+func (c *Context) DefineImportedVar(pkg, name string, t ASTType, isConst bool, declared string) {
 	if c.importedVars[pkg] == nil {
 		c.importedVars[pkg] = make(map[string]ASTType)
 	}
 	c.importedVars[pkg][name] = t
+	if isConst {
+		if c.importedConsts[pkg] == nil {
+			c.importedConsts[pkg] = make(map[string]string)
+		}
+		c.importedConsts[pkg][name] = declared
+	}
+}
+
+// IsImportedConst reports whether an imported package declared its
+// variable immutable.
+func (c *Context) IsImportedConst(pkg, name string) bool {
+	_, ok := c.ImportedConstPos(pkg, name)
+	return ok
+}
+
+// ImportedConstPos returns where an imported package declared its
+// immutable variable, as file:line, or "" if its .bo does not say. It
+// returns false if the variable is not an imported immutable one.
+func (c *Context) ImportedConstPos(pkg, name string) (string, bool) {
+	if c == nil {
+		return "", false
+	}
+	if pos, ok := c.importedConsts[pkg][name]; ok {
+		return pos, true
+	}
+	return c.parent.ImportedConstPos(pkg, name)
 }
 
 // ImportedVarType returns the type of an imported package's variable,
@@ -1171,14 +1208,21 @@ func parseTypeString(s string) (t ASTType, err error) {
 // source code used in its `import "..."` declaration; it's only used here
 // for diagnostics — what makes a package callable in source is the pkgname
 // embedded in the .bo file.
+//
+// path may also be a .ba archive, in which case the package is its member
+// for importKey.
 func (c *Context) Import(importKey, path string) error {
-	o, err := gbasm.ReadOFile(path)
+	o, err := gbasm.ReadPackage(path, importKey)
 	if err != nil {
 		return err
 	}
 	if o.Pkgname == "" {
 		return fmt.Errorf("import %q: .bo at %s has no package name", importKey, path)
 	}
+	if c.importHashes == nil {
+		c.importHashes = make(map[string]string)
+	}
+	c.importHashes[o.Pkgname] = o.ExportHash
 	// Type names inside an imported package's signatures and struct
 	// field types reference structs by their local (bare) name. From
 	// the consumer's perspective those need to become qualified
@@ -1286,7 +1330,11 @@ func (c *Context) Import(importKey, path string) error {
 			return fmt.Errorf("import %q: var %s: %v", importKey, v.Name, err)
 		}
 		qualifyImportedTypeFull(&vt, o.Pkgname, o.Structs, o.TypeAliases, o.Interfaces, o.Values)
-		c.DefineImportedVar(o.Pkgname, v.Name, vt)
+		declared := ""
+		if v.SrcFile != "" {
+			declared = fmt.Sprintf("%s:%d", v.SrcFile, v.SrcLine)
+		}
+		c.DefineImportedVar(o.Pkgname, v.Name, vt, v.IsConst, declared)
 	}
 	for _, ifc := range o.Interfaces {
 		if !ifc.IsPub {
@@ -1449,7 +1497,20 @@ func (c *Context) WriteStrSliceHeaders(of io.Writer) {
 		strSym := c.String(k)
 		payload := make([]byte, 16)
 		binary.LittleEndian.PutUint64(payload[8:], uint64(len(k)))
-		emitVarBlock(of, sym, byteSliceASTType().String(), payload, []relocSpec{{Offset: 0, Symbol: strSym, Addend: 0}}, false)
+		emitVarBlock(of, sym, byteSliceASTType().String(), payload, []relocSpec{{Offset: 0, Symbol: strSym, Addend: 0}}, false, false)
+	}
+}
+
+// WriteImportHashes emits an importhash directive for each imported
+// package, so bas records the export hashes in the .bo.
+func (c *Context) WriteImportHashes(of io.Writer) {
+	var pkgs []string
+	for pkg := range c.importHashes {
+		pkgs = append(pkgs, pkg)
+	}
+	sort.Strings(pkgs)
+	for _, pkg := range pkgs {
+		fmt.Fprintf(of, "importhash %s %s\n", pkg, c.importHashes[pkg])
 	}
 }
 
@@ -2760,12 +2821,16 @@ func (a *Address) ASTType(c *Context) ASTType {
 	}
 	if a.Lit != nil {
 		// Cross-package variable: `&pkg.varname` — Dot.ASTType already
-		// resolves the member type correctly. Shift and set mut (globals
-		// are always var) the same way the named-local path does.
+		// resolves the member type correctly. Shift and set mut (unless
+		// the producer declared the var immutable) the same way the
+		// named-local path does.
 		if dot, ok := a.Lit.(*Dot); ok {
 			if sym, ok2 := dot.Val.(*Symbol); ok2 && c.IsImportedPackage(sym.Name) {
 				t := a.Lit.ASTType(c)
-				t.MutMask = (t.MutMask << 1) | (1 << 1)
+				t.MutMask <<= 1
+				if !c.IsImportedConst(sym.Name, dot.Member) {
+					t.MutMask |= 1 << 1
+				}
 				t.OwnedMask <<= 1
 				t.NilMask <<= 1
 				t.Indirection++
@@ -3549,6 +3614,9 @@ func isLvalueMutable(c *Context, a AST) bool {
 		t := v.Val.ASTType(c)
 		return t.Indirection > 0 && t.MutMask&(1<<1) != 0
 	case *Dot:
+		if sym, ok := v.Val.(*Symbol); ok && c.IsImportedPackage(sym.Name) {
+			return !c.IsImportedConst(sym.Name, v.Member)
+		}
 		baseType := v.Val.ASTType(c)
 		if baseType.Indirection > 0 {
 			return baseType.MutMask&(1<<1) != 0
@@ -19,6 +19,16 @@ func note(of io.Writer, f string, args ...any) {
 	fmt.Fprintf(of, f, args...)
 }
 
+// lineDirective attributes the assembly compiled next to a's source line,
+// for the debug info bas records.
+func lineDirective(of io.Writer, a AST) {
+	p := a.Pos()
+	if p.fname == "" || p.lineoff == 0 {
+		return
+	}
+	fmt.Fprintf(of, "\tline %q %d\n", p.fname, p.lineoff)
+}
+
 func CompileErrorF(a AST, f string, args ...any) {
 	panic(&interpreterError{
 		msg: fmt.Sprintf(f, args...),
@@ -1731,15 +1741,19 @@ func lvalueIsWritable(c *Context, a AST) (bool, string) {
 		parent := ResolveSelector(c, v.Val)
 		switch parent.Kind {
 		case ResolvedPackage:
-			// pkg.member = …. The producer's const/var distinction isn't
-			// carried in the .bo today (Var has no IsConst flag), so we
-			// permit writes to any exported var by name and rely on the
-			// owning package to expose what it intends to be writable.
-			// TODO(cross-pkg-const): once Var.IsConst flows through .bo,
-			// reject writes here when the producer declared `const`.
+			// pkg.member = …. The .bo carries the producer's const/var
+			// distinction, so a write is rejected exactly when it would
+			// be inside the producing package.
 			if _, ok := c.ImportedVarType(parent.Name, v.Member); !ok {
 				return false, fmt.Sprintf("package %q has no variable %q", parent.Name, v.Member)
 			}
+			if pos, ok := c.ImportedConstPos(parent.Name, v.Member); ok {
+				msg := fmt.Sprintf("Cannot assign to immutable binding \"%s.%s\"; package %s declares it without var", parent.Name, v.Member, parent.Name)
+				if pos != "" {
+					msg += " at " + pos
+				}
+				return false, msg
+			}
 			return true, ""
 		case ResolvedRuntimeValue, ResolvedStructField:
 			baseType := parent.Type
@@ -3138,10 +3152,10 @@ func compileTop(of io.Writer, c *Context, a AST, dest spot) (spt spot) {
 			}
 			symName := projectionSymbolName(ast.Name, projIdx)
 			c.MarkAddress(symName)
-			emitVarBlock(of, symName, arrType.String(), data, relocs, false)
+			emitVarBlock(of, symName, arrType.String(), data, relocs, false, false)
 			for _, ag := range c.DrainAnonGlobals() {
 				c.MarkAddress(ag.Name)
-				emitVarBlock(of, ag.Name, ag.Type, ag.Bytes, ag.Relocs, false)
+				emitVarBlock(of, ag.Name, ag.Type, ag.Bytes, ag.Relocs, false, false)
 			}
 		}
 		// Value-receiver methods land alongside the projection tables,
@@ -3626,12 +3640,14 @@ func compileTop(of io.Writer, c *Context, a AST, dest spot) (spt spot) {
 			}
 			fmt.Fprintf(of, "\n")
 		}
+		lineDirective(of, ast)
 		compileFunctionBody(of, c, ast, retlab)
 		return nullspot
 	case *Block:
 		sc := c.SubContext()
 		for _, st := range ast.Body {
 			note(of, "\n")
+			lineDirective(of, st)
 			s := compileTop(of, sc, st, nullspot)
 			s.free(of)
 			if !fallsThrough(st) {
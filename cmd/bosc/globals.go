@@ -37,13 +37,16 @@ func emitGlobalVarDecl(of io.Writer, c *Context, a AST, ast *VarDecl) {
 	// type-based memory-backing — which is true for structs and large
 	// values but not for scalar globals like 'var x i64'.
 	c.MarkAddress(ast.Name)
+	// The line directive gives bas the position of the var, which the .bo
+	// carries to importers' diagnostics.
+	lineDirective(of, a)
 	size := ast.Type.Size(c)
 	if ast.Init == nil {
 		if !ast.Type.ZeroInitializable(c) {
 			CompileErrorF(a, "Variable \"%s\" of type %s requires an initializer", ast.Name, ast.Type)
 		}
 		// Zero-init form: bas allocates `size` zero bytes.
-		fmt.Fprintf(of, "%svar %s %s %d\n", pubPrefix(ast.IsPub), ast.Name, ast.Type, size)
+		fmt.Fprintf(of, "%s%svar %s %s %d\n", pubPrefix(ast.IsPub), immutablePrefix(ast.IsConst), ast.Name, ast.Type, size)
 		return
 	}
 
@@ -62,7 +65,7 @@ func emitGlobalVarDecl(of io.Writer, c *Context, a AST, ast *VarDecl) {
 		// rather than emit a globally-misaligned variable.
 		CompileErrorF(a, "internal: static initializer encoded %d bytes, but type %s has size %d", len(data), dstt, size)
 	}
-	emitVarBlock(of, ast.Name, ast.Type.String(), data, relocs, ast.IsPub)
+	emitVarBlock(of, ast.Name, ast.Type.String(), data, relocs, ast.IsPub, ast.IsConst)
 	// Any `&literal` forms encountered during the encode queued
 	// anonymous globals to back their pointer targets. Emit them now,
 	// alongside the named global they're nested inside. Mark each as
@@ -70,7 +73,7 @@ func emitGlobalVarDecl(of io.Writer, c *Context, a AST, ast *VarDecl) {
 	// file-scope name.
 	for _, ag := range c.DrainAnonGlobals() {
 		c.MarkAddress(ag.Name)
-		emitVarBlock(of, ag.Name, ag.Type, ag.Bytes, ag.Relocs, false)
+		emitVarBlock(of, ag.Name, ag.Type, ag.Bytes, ag.Relocs, false, false)
 	}
 }
 
@@ -78,12 +81,12 @@ func emitGlobalVarDecl(of io.Writer, c *Context, a AST, ast *VarDecl) {
 // no relocs) or the multi-line block form. Centralized so we don't repeat
 // the choice and the formatting in callers that might emit anonymous
 // globals later.
-func emitVarBlock(of io.Writer, name, vtype string, data []byte, relocs []relocSpec, isPub bool) {
+func emitVarBlock(of io.Writer, name, vtype string, data []byte, relocs []relocSpec, isPub, isConst bool) {
 	if len(relocs) == 0 {
-		fmt.Fprintf(of, "%svar %s %s \"%s\"\n", pubPrefix(isPub), name, vtype, bytesToBasStringLiteral(data))
+		fmt.Fprintf(of, "%s%svar %s %s \"%s\"\n", pubPrefix(isPub), immutablePrefix(isConst), name, vtype, bytesToBasStringLiteral(data))
 		return
 	}
-	fmt.Fprintf(of, "%svar %s %s {\n", pubPrefix(isPub), name, vtype)
+	fmt.Fprintf(of, "%s%svar %s %s {\n", pubPrefix(isPub), immutablePrefix(isConst), name, vtype)
 	fmt.Fprintf(of, "\tbytes \"%s\"\n", bytesToBasStringLiteral(data))
 	for _, r := range relocs {
 		fmt.Fprintf(of, "\treloc %d %s %d\n", r.Offset, r.Symbol, r.Addend)
@@ -98,6 +101,15 @@ func pubPrefix(isPub bool) string {
 	return ""
 }
 
+// immutablePrefix marks a global declared without `var`, so the .bo
+// carries its immutability to importers.
+func immutablePrefix(isConst bool) string {
+	if isConst {
+		return "immutable "
+	}
+	return ""
+}
+
 // encodeStaticInit serializes a compile-time constant AST node into a raw
 // byte payload paired with a list of pointer-slot relocations. Returns an
 // error if init is not a recognized compile-time constant form.
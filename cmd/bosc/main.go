@@ -13,7 +13,7 @@ import (
 
 var out = flag.String("o", "", "Write the linked executable to this file")
 var help = flag.Bool("h", false, "Print this help message.")
-var importcfg = flag.String("importcfg", "", "Path to importcfg file mapping package names to .bo paths")
+var importcfg = flag.String("importcfg", "", "Path to importcfg file mapping package names to .bo or .ba paths")
 var listImports = flag.Bool("listimports", false, "Print all import paths from the input files (one per line) and exit. No compilation is performed.")
 
 // loadImportcfg reads a file with lines of the form `name=path/to/file.bo` and
@@ -295,6 +295,7 @@ func main() {
 		// for name, f := range actx.funcs {
 		// 	fmt.Printf("func %v: %#v\n", name, f)
 		// }
+		actx.WriteImportHashes(of)
 		actx.WriteVtables(of)
 		actx.WriteStrings(of)
 		actx.WriteStrSliceHeaders(of)
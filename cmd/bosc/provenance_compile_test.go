@@ -48,7 +48,7 @@ func compileBosonSourceWithImportsForTest(src string, importedVars []importedVar
 		if ctx.imports[v.pkg] == nil {
 			ctx.imports[v.pkg] = make(map[string]*FuncDecl)
 		}
-		ctx.DefineImportedVar(v.pkg, v.name, v.typ)
+		ctx.DefineImportedVar(v.pkg, v.name, v.typ, false, "")
 	}
 	parser := NewParserAt("matrix.bos", reader, linesConsumed+1)
 	var asts []AST
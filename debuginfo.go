@@ -0,0 +1,304 @@
+package gbasm
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// A LineEntry maps part of a function body to the source line it was
+// generated from: the code from Offset up to the next entry's Offset, or to
+// the end of the body, comes from File:Line.
+type LineEntry struct {
+	Offset uint32
+	File   string
+	Line   int
+}
+
+// A FrameVar is a local or bytes allocation of a function, described for
+// debuggers. From Start up to End in the body it has the stack slot at
+// Offset from RBP, which holds its value unless the allocator is caching it
+// in a register. A local pinned to a register has no FrameVar while it is
+// pinned, since its slot is never written.
+type FrameVar struct {
+	Name string
+	// Type is i8, i16, i32 or i64 for a local, f32 or f64 for an xmm
+	// local, and byte[N] for bytes.
+	Type       string
+	Offset     int32
+	Size       int // in bytes
+	Start, End uint32
+}
+
+// A posMark is a source position recorded between the instructions given
+// to a function, once seq of them had been given.
+type posMark struct {
+	seq  int
+	file string
+	line int
+}
+
+// A varMark records when a FrameVar was allocated and forgotten, as
+// instruction counts like a posMark's. end is -1 while it is allocated.
+type varMark struct {
+	v          FrameVar
+	start, end int
+}
+
+// placedInstr is the offset in the body of the first instruction encoded
+// while seq instructions had been given.
+type placedInstr struct {
+	seq, off int
+}
+
+// SourceLine records that the instructions given to f from now on were
+// generated from line of file. Once f is resolved, Lines maps its body to
+// the positions given.
+func (f *Function) SourceLine(file string, line int) {
+	if n := len(f.posMarks); n > 0 && f.posMarks[n-1].file == file && f.posMarks[n-1].line == line {
+		return
+	}
+	f.posMarks = append(f.posMarks, posMark{seq: f.seq, file: file, line: line})
+}
+
+// notePlaced records the offset of the instruction about to be encoded.
+func (f *Function) notePlaced() {
+	if n := len(f.placed); n > 0 && f.placed[n-1].seq == f.seq {
+		return
+	}
+	f.placed = append(f.placed, placedInstr{seq: f.seq, off: f.bs.Len()})
+}
+
+// declareVar starts the FrameVar of r, a local or bytes allocation.
+func (f *Function) declareVar(r *Ralloc) {
+	typ := fmt.Sprintf("i%d", r.size)
+	if !r.regable {
+		typ = fmt.Sprintf("byte[%d]", r.size/8)
+	} else if r.class == ClassXMM {
+		typ = fmt.Sprintf("f%d", r.size)
+	}
+	v := FrameVar{Name: r.sym, Type: typ, Offset: r.offset, Size: r.size / 8}
+	f.varMarks = append(f.varMarks, varMark{v: v, start: f.seq, end: -1})
+}
+
+// endVar ends the FrameVar named name, if one is allocated.
+func (f *Function) endVar(name string) {
+	for i := len(f.varMarks) - 1; i >= 0; i-- {
+		if m := &f.varMarks[i]; m.v.Name == name && m.end < 0 {
+			m.end = f.seq
+			return
+		}
+	}
+}
+
+// placeDebugInfo sets Lines and Locals from the marks recorded while f
+// was given its instructions, once its body is laid out.
+func (f *Function) placeDebugInfo() {
+	// The peephole rules keep instructions in order, but sort anyway, and
+	// place a mark at the earliest instruction given after it.
+	placed := append([]placedInstr(nil), f.placed...)
+	sort.SliceStable(placed, func(i, j int) bool { return placed[i].seq < placed[j].seq })
+	for i := len(placed) - 2; i >= 0; i-- {
+		placed[i].off = min(placed[i].off, placed[i+1].off)
+	}
+	end := f.bs.Len()
+	at := func(seq int) uint32 {
+		i := sort.Search(len(placed), func(i int) bool { return placed[i].seq > seq })
+		if i == len(placed) {
+			return uint32(end)
+		}
+		return uint32(placed[i].off)
+	}
+
+	f.Lines = nil
+	for _, m := range f.posMarks {
+		e := LineEntry{Offset: at(m.seq), File: m.file, Line: m.line}
+		if n := len(f.Lines); n > 0 && f.Lines[n-1].Offset == e.Offset {
+			// The previous line generated no code.
+			f.Lines = f.Lines[:n-1]
+		}
+		if n := len(f.Lines); n > 0 && f.Lines[n-1].File == e.File && f.Lines[n-1].Line == e.Line {
+			continue
+		}
+		f.Lines = append(f.Lines, e)
+	}
+	if n := len(f.Lines); n > 0 && int(f.Lines[n-1].Offset) == end {
+		f.Lines = f.Lines[:n-1]
+	}
+
+	// Without a frame pointer nothing is in the frame: every local stayed
+	// in a register.
+	f.Locals = nil
+	if !f.framePointer() {
+		return
+	}
+	for _, m := range f.varMarks {
+		v := m.v
+		v.Start, v.End = at(m.start), uint32(end)
+		if m.end >= 0 {
+			v.End = at(m.end)
+		}
+		if v.Start < v.End {
+			f.Locals = append(f.Locals, v)
+		}
+	}
+}
+
+// writeDebugInfo writes the Lines and Locals of each function in fs that
+// has any, by name. The file names of a function's lines are written once,
+// in a table the lines index.
+func writeDebugInfo(w io.Writer, fs map[string]*Function) error {
+	var names []string
+	for _, name := range sortedKeys(fs) {
+		if f := fs[name]; len(f.Lines) > 0 || len(f.Locals) > 0 {
+			names = append(names, name)
+		}
+	}
+	if err := writeSize(w, len(names)); err != nil {
+		return err
+	}
+	for _, name := range names {
+		f := fs[name]
+		if err := writeString(w, name); err != nil {
+			return err
+		}
+		var files []string
+		fileIdx := make(map[string]int)
+		for _, l := range f.Lines {
+			if _, ok := fileIdx[l.File]; !ok {
+				fileIdx[l.File] = len(files)
+				files = append(files, l.File)
+			}
+		}
+		if err := writeSize(w, len(files)); err != nil {
+			return err
+		}
+		for _, file := range files {
+			if err := writeString(w, file); err != nil {
+				return err
+			}
+		}
+		if err := writeSize(w, len(f.Lines)); err != nil {
+			return err
+		}
+		for _, l := range f.Lines {
+			if err := binary.Write(w, binary.LittleEndian, l.Offset); err != nil {
+				return err
+			}
+			if err := writeSize(w, fileIdx[l.File]); err != nil {
+				return err
+			}
+			if err := writeSize(w, l.Line); err != nil {
+				return err
+			}
+		}
+		if err := writeSize(w, len(f.Locals)); err != nil {
+			return err
+		}
+		for _, v := range f.Locals {
+			if err := writeString(w, v.Name); err != nil {
+				return err
+			}
+			if err := writeString(w, v.Type); err != nil {
+				return err
+			}
+			if err := binary.Write(w, binary.LittleEndian, v.Offset); err != nil {
+				return err
+			}
+			if err := writeSize(w, v.Size); err != nil {
+				return err
+			}
+			if err := binary.Write(w, binary.LittleEndian, [2]uint32{v.Start, v.End}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// readDebugInfo reads what writeDebugInfo wrote into the functions of fs.
+func readDebugInfo(r io.Reader, fs map[string]*Function) error {
+	n, err := readSize(r)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < n; i++ {
+		name, err := readString(r)
+		if err != nil {
+			return err
+		}
+		f := fs[name]
+		if f == nil {
+			return fmt.Errorf("Debug info for undeclared function %s", name)
+		}
+		nfiles, err := readSize(r)
+		if err != nil {
+			return err
+		}
+		files := make([]string, nfiles)
+		for j := range files {
+			if files[j], err = readString(r); err != nil {
+				return err
+			}
+		}
+		nlines, err := readSize(r)
+		if err != nil {
+			return err
+		}
+		f.Lines = make([]LineEntry, nlines)
+		for j := range f.Lines {
+			l := &f.Lines[j]
+			if err := binary.Read(r, binary.LittleEndian, &l.Offset); err != nil {
+				return err
+			}
+			idx, err := readSize(r)
+			if err != nil {
+				return err
+			}
+			if idx >= len(files) {
+				return fmt.Errorf("Function %s: Line entry names file %d of %d", name, idx, len(files))
+			}
+			l.File = files[idx]
+			if l.Line, err = readSize(r); err != nil {
+				return err
+			}
+		}
+		nlocals, err := readSize(r)
+		if err != nil {
+			return err
+		}
+		f.Locals = make([]FrameVar, nlocals)
+		for j := range f.Locals {
+			v := &f.Locals[j]
+			if v.Name, err = readString(r); err != nil {
+				return err
+			}
+			if v.Type, err = readString(r); err != nil {
+				return err
+			}
+			if err := binary.Read(r, binary.LittleEndian, &v.Offset); err != nil {
+				return err
+			}
+			if v.Size, err = readSize(r); err != nil {
+				return err
+			}
+			var span [2]uint32
+			if err := binary.Read(r, binary.LittleEndian, &span); err != nil {
+				return err
+			}
+			v.Start, v.End = span[0], span[1]
+		}
+	}
+	return nil
+}
+
+// sourcePos returns the source position of the code at off in f's body:
+// the line covering it, or where f was declared if no line does.
+func (f *Function) sourcePos(off uint32) (string, int) {
+	i := sort.Search(len(f.Lines), func(i int) bool { return f.Lines[i].Offset > off })
+	if i == 0 {
+		return f.SrcFile, f.SrcLine
+	}
+	return f.Lines[i-1].File, f.Lines[i-1].Line
+}
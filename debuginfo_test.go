@@ -0,0 +1,130 @@
+package gbasm
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+)
+
+// debugTestFunction returns a function with a loop, whose jump relaxes,
+// and a local and bytes allocation, given source lines as it goes.
+func debugTestFunction(t *testing.T, o *OFile, peephole bool) *Function {
+	t.Helper()
+	f, err := o.NewFunction("loop.bs", 1, "loop")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if peephole {
+		f.EnablePeephole()
+	}
+	f.SourceLine("loop.bos", 1)
+	f.Prologue()
+	f.SourceLine("loop.bos", 2)
+	n, err := f.NewLocal("n", 64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Instr("MOV", n, int8(0))
+	f.SourceLine("loop.bos", 3)
+	buf, err := f.AllocBytes("buf", 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Instr("LEA", R_RAX, buf)
+	f.Label("top")
+	f.SourceLine("loop.bos", 4)
+	f.Instr("ADD", n, int8(1))
+	f.Instr("CMP", n, int8(10))
+	f.Jump("JNE", "top")
+	f.SourceLine("loop.bos", 5)
+	f.Forget("n")
+	f.Instr("MOV", R_RAX, int8(0))
+	// A line with no code of its own.
+	f.SourceLine("loop.bos", 6)
+	f.SourceLine("loop.bos", 7)
+	f.Epilogue()
+	f.Instr("RET")
+	if err := f.Resolve(); err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+func TestDebugLines(t *testing.T) {
+	for _, peephole := range []bool{false, true} {
+		o, err := NewOFile("loop", "main")
+		if err != nil {
+			t.Fatal(err)
+		}
+		f := debugTestFunction(t, o, peephole)
+		body, err := f.Body()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var lines []int
+		for i, l := range f.Lines {
+			if l.File != "loop.bos" || i > 0 && l.Offset <= f.Lines[i-1].Offset || int(l.Offset) >= len(body) {
+				t.Errorf("peephole %v: Bad line entry %+v in %+v", peephole, l, f.Lines)
+			}
+			lines = append(lines, l.Line)
+		}
+		if fmt.Sprint(lines) != "[1 2 3 4 5 7]" {
+			t.Errorf("peephole %v: Expected lines [1 2 3 4 5 7], got %+v", peephole, f.Lines)
+		}
+		if f.Lines[0].Offset != 0 {
+			t.Errorf("peephole %v: Expected line 1 to start with the prologue, got %+v", peephole, f.Lines[0])
+		}
+		// The loop's jump is relaxed to a JNE rel8, and line 5 starts
+		// right after it.
+		for _, l := range f.Lines {
+			if l.Line == 5 && body[l.Offset-2] != 0x75 {
+				t.Errorf("peephole %v: Expected line 5 to follow the relaxed JNE, got %x before it", peephole, body[l.Offset-2:l.Offset])
+			}
+		}
+
+		vars := make(map[string]FrameVar)
+		for _, v := range f.Locals {
+			vars[v.Name] = v
+		}
+		n, buf := vars["n"], vars["buf"]
+		if n.Type != "i64" || n.Size != 8 || n.Offset >= 0 || n.Start == 0 || n.End <= n.Start {
+			t.Errorf("peephole %v: Expected an i64 local n, got %+v", peephole, n)
+		}
+		if buf.Type != "byte[16]" || buf.Size != 16 || buf.Offset >= 0 || int(buf.End) != len(body) {
+			t.Errorf("peephole %v: Expected a byte[16] buf live to the end, got %+v", peephole, buf)
+		}
+		if n.End >= buf.End {
+			t.Errorf("peephole %v: Expected n to end when forgotten, got %+v", peephole, n)
+		}
+	}
+}
+
+func TestDebugInfoRoundTrip(t *testing.T) {
+	o, err := NewOFile("loop.bo", "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := debugTestFunction(t, o, false)
+	g, err := o.NewFunction("loop.bs", 20, "nodebug")
+	if err != nil {
+		t.Fatal(err)
+	}
+	g.Instr("RET")
+
+	var b bytes.Buffer
+	if err := writeOFile(&b, o); err != nil {
+		t.Fatal(err)
+	}
+	got, err := readOFile(&b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gf := got.Funcs["loop"]
+	if fmt.Sprint(gf.Lines) != fmt.Sprint(f.Lines) || fmt.Sprint(gf.Locals) != fmt.Sprint(f.Locals) {
+		t.Errorf("Expected lines %+v and locals %+v, got %+v and %+v", f.Lines, f.Locals, gf.Lines, gf.Locals)
+	}
+	if ng := got.Funcs["nodebug"]; len(ng.Lines) != 0 || len(ng.Locals) != 0 {
+		t.Errorf("Expected nodebug to have no debug info, got %+v", ng)
+	}
+}
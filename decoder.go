@@ -3,7 +3,6 @@ package gbasm
 import (
 	"encoding/xml"
 	"fmt"
-	"io"
 	"os"
 )
 
@@ -96,13 +95,3 @@ func DecodeFile(fname string) (*XInstructionSet, error) {
 	}
 	return instrs, nil
 }
-
-func decode(r io.Reader) (*XInstructionSet, error) {
-	instrs := &XInstructionSet{}
-	d := xml.NewDecoder(r)
-	err := d.Decode(instrs)
-	if err != nil {
-		return nil, err
-	}
-	return instrs, nil
-}
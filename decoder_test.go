@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"strings"
 	"testing"
 )
 
@@ -69,3 +70,41 @@ func TestEncoder(t *testing.T) {
 	fmt.Printf("\n")
 	//log.Printf("BS: %v\n", bs.Bytes())
 }
+
+func TestEncodeXMM(t *testing.T) {
+	a, err := LoadAsm(AMD64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		instr string
+		args  []interface{}
+		want  []byte
+	}{
+		{"MOVSD", []interface{}{R_XMM0, Indirect{Reg: R_RAX}}, []byte{0xf2, 0x0f, 0x10, 0x00}},
+		{"MOVSD", []interface{}{Indirect{Reg: R_RBP, Off: -8, Size: 64}, R_XMM3}, []byte{0xf2, 0x0f, 0x11, 0x9d, 0xf8, 0xff, 0xff, 0xff}},
+		{"ADDSD", []interface{}{R_XMM1, R_XMM9}, []byte{0xf2, 0x41, 0x0f, 0x58, 0xc9}},
+		{"CVTSI2SD", []interface{}{R_XMM2, R_RDI}, []byte{0xf2, 0x48, 0x0f, 0x2a, 0xd7}},
+		{"PXOR", []interface{}{R_XMM0, R_XMM15}, []byte{0x66, 0x41, 0x0f, 0xef, 0xc7}},
+		{"MOVQ", []interface{}{R_RAX, R_XMM1}, []byte{0x66, 0x48, 0x0f, 0x7e, 0xc8}},
+	}
+	for _, tt := range tests {
+		var bs bytes.Buffer
+		if _, err := a.Encode(&bs, tt.instr, tt.args...); err != nil {
+			t.Errorf("Failed to encode %s %v: %s", tt.instr, tt.args, err)
+			continue
+		}
+		if !bytes.Equal(bs.Bytes(), tt.want) {
+			t.Errorf("%s %v: expected % x, got % x", tt.instr, tt.args, tt.want, bs.Bytes())
+		}
+	}
+	for _, name := range []string{"xmm0", "XMM15"} {
+		r, err := ParseReg(name)
+		if err != nil || !strings.EqualFold(r.String(), name) {
+			t.Errorf("ParseReg(%q) = %v, %v", name, r, err)
+		}
+	}
+	if _, err := ParseReg("xmm16"); err == nil {
+		t.Errorf("ParseReg accepted xmm16")
+	}
+}
@@ -0,0 +1,575 @@
+package gbasm
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DecodedInstr is a single instruction recovered from machine code by
+// Disassemble.
+type DecodedInstr struct {
+	Offset   int    // Offset of the first byte of the instruction in the input
+	Bytes    []byte // The raw bytes of the instruction
+	Mnemonic string // Instruction name as accepted by Asm.Encode, e.g. "MOV"
+	Form     string // gas-name of the matched form, e.g. "MOVQ"
+
+	// Args holds the explicit operands in the same order and with the same
+	// Go types Asm.Encode accepts: Register, Indirect,
+	// IndirectBaseIndexScale, sized integers for immediates and int8/int32
+	// for branch displacements. RIP-relative memory operands come back as
+	// Indirect{Reg: R_RIP}; the symbol they refer to lives in the
+	// owning Function's Relocations.
+	Args []interface{}
+
+	ops []Op
+}
+
+// Len returns the encoded length of the instruction in bytes.
+func (d *DecodedInstr) Len() int {
+	return len(d.Bytes)
+}
+
+// Target returns the offset a relative branch transfers control to, measured
+// from the same origin as Offset.
+func (d *DecodedInstr) Target() (int, bool) {
+	for _, a := range d.Args {
+		switch rel := a.(type) {
+		case int8:
+			if d.isBranch() {
+				return d.Offset + d.Len() + int(rel), true
+			}
+		case int32:
+			if d.isBranch() {
+				return d.Offset + d.Len() + int(rel), true
+			}
+		}
+	}
+	return 0, false
+}
+
+func (d *DecodedInstr) isBranch() bool {
+	for _, op := range d.ops {
+		if op.TN == "rel8" || op.TN == "rel32" {
+			return true
+		}
+	}
+	return false
+}
+
+func (d DecodedInstr) String() string {
+	var args []string
+	idx := 0
+	for _, op := range d.ops {
+		if op.Implicit {
+			if r, err := ParseReg(op.TN); err == nil {
+				args = append(args, r.String())
+			}
+			continue
+		}
+		if idx >= len(d.Args) {
+			break
+		}
+		a := d.Args[idx]
+		idx++
+		if op.TN == "rel8" || op.TN == "rel32" {
+			if t, ok := d.Target(); ok {
+				args = append(args, fmt.Sprintf("0x%x", t))
+				continue
+			}
+		}
+		args = append(args, formatOperand(a))
+	}
+	if len(args) == 0 {
+		return d.Mnemonic
+	}
+	return d.Mnemonic + " " + strings.Join(args, ", ")
+}
+
+func formatOperand(o interface{}) string {
+	switch t := o.(type) {
+	case Register:
+		return t.String()
+	case Indirect:
+		var size string
+		switch t.Size {
+		case 8:
+			size = "BYTE "
+		case 16:
+			size = "WORD "
+		case 32:
+			size = "DWORD "
+		case 64:
+			size = "QWORD "
+		case 128:
+			size = "XMMWORD "
+		}
+		switch {
+		case t.Off > 0:
+			return fmt.Sprintf("%s[%s + 0x%x]", size, t.Reg, t.Off)
+		case t.Off < 0:
+			return fmt.Sprintf("%s[%s - 0x%x]", size, t.Reg, -int64(t.Off))
+		}
+		return fmt.Sprintf("%s[%s]", size, t.Reg)
+	case IndirectBaseIndexScale:
+		switch {
+		case t.Off > 0:
+			return fmt.Sprintf("[%s + %s*%d + 0x%x]", t.Base, t.Index, t.Scale, t.Off)
+		case t.Off < 0:
+			return fmt.Sprintf("[%s + %s*%d - 0x%x]", t.Base, t.Index, t.Scale, -int64(t.Off))
+		}
+		return fmt.Sprintf("[%s + %s*%d]", t.Base, t.Index, t.Scale)
+	case int8, int16, int32, int64:
+		return fmt.Sprintf("%d", t)
+	case uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("0x%x", t)
+	}
+	return fmt.Sprintf("%v", o)
+}
+
+// decodeForm is one encoding of an IForm, in the shape the disassembler
+// matches byte streams against.
+type decodeForm struct {
+	instr string
+	form  *IForm
+	enc   []Encoder
+	// explicit maps an XML operand number (which counts implicit operands)
+	// to its position in the explicit operand list, or -1.
+	explicit []int
+}
+
+type decodeTable struct {
+	// byOpcode holds candidate forms keyed by their first opcode byte.
+	// Forms with a register addend are entered under all 8 opcodes.
+	byOpcode [256][]*decodeForm
+}
+
+var (
+	defaultAsmOnce sync.Once
+	defaultAsm     *Asm
+	defaultAsmErr  error
+)
+
+// Disassemble decodes code as a sequence of x86-64 instructions using the
+// instruction forms the assembler itself knows how to encode. Decoding stops
+// at the first byte sequence that matches no known form; the instructions
+// decoded up to that point are returned along with the error.
+func Disassemble(code []byte) ([]DecodedInstr, error) {
+	defaultAsmOnce.Do(func() {
+		defaultAsm, defaultAsmErr = LoadAsm(AMD64)
+	})
+	if defaultAsmErr != nil {
+		return nil, defaultAsmErr
+	}
+	return defaultAsm.Disassemble(code)
+}
+
+// Disassemble decodes code using the instruction forms of a. See the
+// package-level Disassemble.
+func (a *Asm) Disassemble(code []byte) ([]DecodedInstr, error) {
+	a.decodeOnce.Do(a.buildDecodeTable)
+	var ret []DecodedInstr
+	for pos := 0; pos < len(code); {
+		d, ok := a.decode.decodeAt(code, pos)
+		if !ok {
+			end := pos + 15
+			if end > len(code) {
+				end = len(code)
+			}
+			return ret, fmt.Errorf("Cannot decode instruction at offset 0x%x: % x", pos, code[pos:end])
+		}
+		ret = append(ret, d)
+		pos += d.Len()
+	}
+	return ret, nil
+}
+
+func (a *Asm) buildDecodeTable() {
+	t := &decodeTable{}
+	names := make([]string, 0, len(a.instrs))
+	for n := range a.instrs {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	for _, n := range names {
+		instr := a.instrs[n]
+		for i := range instr.Forms {
+			f := &instr.Forms[i]
+			if !decodable(f) {
+				continue
+			}
+			explicit := make([]int, len(f.ops))
+			idx := 0
+			for j, op := range f.ops {
+				if op.Implicit {
+					explicit[j] = -1
+					continue
+				}
+				explicit[j] = idx
+				idx++
+			}
+			for _, enc := range f.enc {
+				df := &decodeForm{instr: n, form: f, enc: enc, explicit: explicit}
+				for _, e := range enc {
+					op, ok := e.(*opcode)
+					if !ok {
+						continue
+					}
+					if op.hasAddend {
+						for r := byte(0); r < 8; r++ {
+							t.byOpcode[op.op+r] = append(t.byOpcode[op.op+r], df)
+						}
+					} else {
+						t.byOpcode[op.op] = append(t.byOpcode[op.op], df)
+					}
+					break
+				}
+			}
+		}
+	}
+	a.decode = t
+}
+
+// decodable reports whether every explicit operand of f has a Go
+// representation the encoder understands.
+func decodable(f *IForm) bool {
+	for _, op := range f.ops {
+		if op.Implicit {
+			continue
+		}
+		switch op.TN {
+		case "r8", "r16", "r32", "r64", "xmm",
+			"m", "m8", "m16", "m32", "m64", "m128",
+			"imm8", "imm16", "imm32", "imm64",
+			"rel8", "rel32", "moffs32", "moffs64",
+			"1", "3":
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func isLegacyPrefix(b byte) bool {
+	switch b {
+	case 0x66, 0x67, 0xF0, 0xF2, 0xF3, 0x2E, 0x36, 0x3E, 0x26, 0x64, 0x65:
+		return true
+	}
+	return false
+}
+
+func (t *decodeTable) decodeAt(code []byte, start int) (DecodedInstr, bool) {
+	op := start
+	for op < len(code) && isLegacyPrefix(code[op]) {
+		op++
+	}
+	if op < len(code) && code[op]&0xF0 == 0x40 {
+		op++
+	}
+	if op >= len(code) {
+		return DecodedInstr{}, false
+	}
+	for _, df := range t.byOpcode[code[op]] {
+		if d, ok := df.match(code, start); ok {
+			return d, true
+		}
+	}
+	return DecodedInstr{}, false
+}
+
+var (
+	numRegs8  = [16]Register{R_AL, R_CL, R_DL, R_BL, R_AH, R_CH, R_DH, R_BH, R8B, R9B, R10B, R11B, R12B, R13B, R14B, R15B}
+	numRegs16 = [16]Register{R_AX, R_CX, R_DX, R_BX, R_SP, R_BP, R_SI, R_DI, R8W, R9W, R10W, R11W, R12W, R13W, R14W, R15W}
+	numRegs32 = [16]Register{R_EAX, R_ECX, R_EDX, R_EBX, R_ESP, R_EBP, R_ESI, R_EDI, R8D, R9D, R10D, R11D, R12D, R13D, R14D, R15D}
+	numRegs64 = [16]Register{R_RAX, R_RCX, R_RDX, R_RBX, R_RSP, R_RBP, R_RSI, R_RDI, R8, R9, R10, R11, R12, R13, R14, R15}
+)
+
+// regByNum maps a 4-bit register number to a Register of the width an
+// operand of type tn has.
+func regByNum(tn string, n byte, hasREX bool) (Register, bool) {
+	switch tn {
+	case "r8":
+		if hasREX && n >= 4 && n < 8 {
+			// SPL and BPL have no Register. SIL and DIL do.
+			switch n {
+			case 6:
+				return R_SIL, true
+			case 7:
+				return R_DIL, true
+			}
+			return 0, false
+		}
+		return numRegs8[n], true
+	case "r16":
+		return numRegs16[n], true
+	case "r32":
+		return numRegs32[n], true
+	case "r64":
+		return numRegs64[n], true
+	case "xmm":
+		return R_XMM0 + Register(n), true
+	}
+	return 0, false
+}
+
+func memSize(tn string) int {
+	switch tn {
+	case "m8":
+		return 8
+	case "m16":
+		return 16
+	case "m32":
+		return 32
+	case "m64":
+		return 64
+	case "m128":
+		return 128
+	}
+	return 0
+}
+
+type decodeState struct {
+	code  []byte
+	pos   int
+	rex   byte
+	isREX bool
+	args  []interface{}
+}
+
+func (s *decodeState) next() (byte, bool) {
+	if s.pos >= len(s.code) {
+		return 0, false
+	}
+	b := s.code[s.pos]
+	s.pos++
+	return b, true
+}
+
+func (s *decodeState) read(n int) ([]byte, bool) {
+	if s.pos+n > len(s.code) {
+		return nil, false
+	}
+	bs := s.code[s.pos : s.pos+n]
+	s.pos += n
+	return bs, true
+}
+
+func (df *decodeForm) set(s *decodeState, opnum byte, v interface{}) bool {
+	if int(opnum) >= len(df.explicit) {
+		return false
+	}
+	i := df.explicit[opnum]
+	if i < 0 {
+		return false
+	}
+	s.args[i] = v
+	return true
+}
+
+func (df *decodeForm) opType(opnum byte) string {
+	if int(opnum) >= len(df.form.ops) {
+		return ""
+	}
+	return df.form.ops[opnum].TN
+}
+
+func (df *decodeForm) match(code []byte, start int) (DecodedInstr, bool) {
+	s := &decodeState{code: code, pos: start, args: make([]interface{}, df.form.opcount)}
+	for _, e := range df.enc {
+		switch x := e.(type) {
+		case *prefix:
+			b, ok := s.next()
+			if !ok || b != x.b {
+				return DecodedInstr{}, false
+			}
+		case *rex:
+			if s.pos < len(code) && code[s.pos]&0xF0 == 0x40 {
+				s.rex = code[s.pos]
+				s.isREX = true
+				s.pos++
+			}
+			if (s.rex>>3)&1 != x.w {
+				return DecodedInstr{}, false
+			}
+			if x.mandatory && !s.isREX {
+				return DecodedInstr{}, false
+			}
+		case *opcode:
+			b, ok := s.next()
+			if !ok {
+				return DecodedInstr{}, false
+			}
+			if !x.hasAddend {
+				if b != x.op {
+					return DecodedInstr{}, false
+				}
+				continue
+			}
+			if b&^0b111 != x.op {
+				return DecodedInstr{}, false
+			}
+			r, ok := regByNum(df.opType(x.addend), (b&0b111)|((s.rex&0b1)<<3), s.isREX)
+			if !ok || !df.set(s, x.addend, r) {
+				return DecodedInstr{}, false
+			}
+		case *modrm:
+			if !df.decodeModRM(s, x) {
+				return DecodedInstr{}, false
+			}
+		case *immediate:
+			bs, ok := s.read(x.size)
+			if !ok || !df.set(s, x.value, decodeInt(bs)) {
+				return DecodedInstr{}, false
+			}
+		case *codeOffset:
+			bs, ok := s.read(x.size)
+			if !ok || !df.set(s, x.value, decodeInt(bs)) {
+				return DecodedInstr{}, false
+			}
+		case *dataOffset:
+			bs, ok := s.read(x.size)
+			if !ok || !df.set(s, x.value, decodeUint(bs)) {
+				return DecodedInstr{}, false
+			}
+		default:
+			return DecodedInstr{}, false
+		}
+	}
+	// Literal operands such as the 1 in "SHL r/m, 1" are not encoded.
+	for j, op := range df.form.ops {
+		i := df.explicit[j]
+		if i < 0 || s.args[i] != nil {
+			continue
+		}
+		n, err := strconv.ParseUint(op.TN, 10, 8)
+		if err != nil {
+			return DecodedInstr{}, false
+		}
+		s.args[i] = uint8(n)
+	}
+	return DecodedInstr{
+		Offset:   start,
+		Bytes:    code[start:s.pos],
+		Mnemonic: df.instr,
+		Form:     df.form.name,
+		Args:     s.args,
+		ops:      df.form.ops,
+	}, true
+}
+
+func (df *decodeForm) decodeModRM(s *decodeState, x *modrm) bool {
+	b, ok := s.next()
+	if !ok {
+		return false
+	}
+	mod := b >> 6
+	reg := (b>>3)&0b111 | ((s.rex>>2)&0b1)<<3
+	rm := b & 0b111
+
+	if x.reg&MODE_LITERAL != 0 {
+		if (b>>3)&0b111 != x.reg&^MODE_LITERAL {
+			return false
+		}
+	} else {
+		r, ok := regByNum(df.opType(x.reg), reg, s.isREX)
+		if !ok || !df.set(s, x.reg, r) {
+			return false
+		}
+	}
+
+	if x.mod&MODE_LITERAL != 0 {
+		if mod != x.mod&^MODE_LITERAL {
+			return false
+		}
+		if mod == 0b11 {
+			r, ok := regByNum(df.opType(x.rm), rm|(s.rex&0b1)<<3, s.isREX)
+			return ok && df.set(s, x.rm, r)
+		}
+	}
+	if mod == 0b11 {
+		return false
+	}
+
+	size := memSize(df.opType(x.rm))
+	if rm == 0b100 {
+		sib, ok := s.next()
+		if !ok {
+			return false
+		}
+		scale := 1 << (sib >> 6)
+		index := (sib>>3)&0b111 | ((s.rex>>1)&0b1)<<3
+		base := sib&0b111 | (s.rex&0b1)<<3
+		if sib&0b111 == 0b101 && mod == 0b00 {
+			// [index*scale + disp32] with no base register.
+			return false
+		}
+		disp, ok := readDisp(s, mod)
+		if !ok {
+			return false
+		}
+		if index == 0b100 {
+			return df.set(s, x.rm, Indirect{Reg: numRegs64[base], Off: disp, Size: size})
+		}
+		return df.set(s, x.rm, IndirectBaseIndexScale{
+			Base:  numRegs64[base],
+			Index: numRegs64[index],
+			Scale: scale,
+			Off:   disp,
+		})
+	}
+	if mod == 0b00 && rm == 0b101 {
+		bs, ok := s.read(4)
+		if !ok {
+			return false
+		}
+		disp := int32(binary.LittleEndian.Uint32(bs))
+		return df.set(s, x.rm, Indirect{Reg: R_RIP, Off: disp, Size: size})
+	}
+	disp, ok := readDisp(s, mod)
+	if !ok {
+		return false
+	}
+	return df.set(s, x.rm, Indirect{Reg: numRegs64[rm|(s.rex&0b1)<<3], Off: disp, Size: size})
+}
+
+func readDisp(s *decodeState, mod byte) (int32, bool) {
+	switch mod {
+	case 0b01:
+		b, ok := s.next()
+		return int32(int8(b)), ok
+	case 0b10:
+		bs, ok := s.read(4)
+		if !ok {
+			return 0, false
+		}
+		return int32(binary.LittleEndian.Uint32(bs)), true
+	}
+	return 0, true
+}
+
+func decodeInt(bs []byte) interface{} {
+	switch len(bs) {
+	case 1:
+		return int8(bs[0])
+	case 2:
+		return int16(binary.LittleEndian.Uint16(bs))
+	case 4:
+		return int32(binary.LittleEndian.Uint32(bs))
+	}
+	return int64(binary.LittleEndian.Uint64(bs))
+}
+
+func decodeUint(bs []byte) interface{} {
+	switch len(bs) {
+	case 1:
+		return bs[0]
+	case 2:
+		return binary.LittleEndian.Uint16(bs)
+	case 4:
+		return binary.LittleEndian.Uint32(bs)
+	}
+	return binary.LittleEndian.Uint64(bs)
+}
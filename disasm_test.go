@@ -0,0 +1,114 @@
+package gbasm
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestDisassembleRoundTrip(t *testing.T) {
+	a, err := LoadAsm(AMD64)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		instr string
+		args  []interface{}
+	}{
+		{"MOV", []interface{}{R_RAX, R_RBX}},
+		{"MOV", []interface{}{R_AL, R_AH}},
+		{"MOV", []interface{}{R_SIL, R_DIL}},
+		{"MOV", []interface{}{R12, Indirect{Reg: R_RBP, Off: -8, Size: 64}}},
+		{"MOV", []interface{}{Indirect{Reg: R_RSP, Off: 16, Size: 32}, R9D}},
+		{"MOV", []interface{}{Indirect{Reg: R13, Size: 8}, R_CL}},
+		{"MOV", []interface{}{R_RAX, IndirectBaseIndexScale{Base: R_RBX, Index: R_RCX, Scale: 8}}},
+		{"MOV", []interface{}{R_RAX, IndirectBaseIndexScale{Base: R_RBP, Index: R14, Scale: 2, Off: -24}}},
+		{"MOV", []interface{}{R11, IndirectBaseIndexScale{Base: R12, Index: R_RSI, Scale: 4, Off: 4096}}},
+		{"MOV", []interface{}{R15, int64(0x123456789)}},
+		{"ADD", []interface{}{R_RAX, int32(1000)}},
+		{"SUB", []interface{}{R_RSP, int8(16)}},
+		{"ADD", []interface{}{R_AX, R_BX}},
+		{"XOR", []interface{}{R_EAX, R_EAX}},
+		{"LEA", []interface{}{R_RDI, Indirect{Reg: R_RBP, Off: -32}}},
+		{"PUSH", []interface{}{R15}},
+		{"POP", []interface{}{R_RBX}},
+		{"CALL", []interface{}{int32(-5)}},
+		{"JMP", []interface{}{int8(2)}},
+		{"JE", []interface{}{int32(100)}},
+		{"SAR", []interface{}{R_RDX, int8(3)}},
+		{"MOVSD", []interface{}{R_XMM1, Indirect{Reg: R_RBP, Off: -16, Size: 64}}},
+		{"ADDSD", []interface{}{R_XMM12, R_XMM0}},
+		{"CVTTSD2SI", []interface{}{R_RAX, R_XMM8}},
+		{"PXOR", []interface{}{R_XMM2, Indirect{Reg: R_RDI, Size: 128}}},
+		{"RET", nil},
+	}
+
+	var bs bytes.Buffer
+	for _, tt := range tests {
+		if _, err := a.Encode(&bs, tt.instr, tt.args...); err != nil {
+			t.Fatalf("Failed to encode %s %v: %s", tt.instr, tt.args, err)
+		}
+	}
+
+	ds, err := a.Disassemble(bs.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ds) != len(tests) {
+		t.Fatalf("Expected %d instructions, but decoded %d: %v", len(tests), len(ds), ds)
+	}
+	off := 0
+	for i, d := range ds {
+		if d.Offset != off {
+			t.Errorf("Instruction %d (%s) at offset %d, expected %d", i, d, d.Offset, off)
+		}
+		off += d.Len()
+		if d.Mnemonic != tests[i].instr {
+			t.Errorf("Instruction %d: expected %s, but decoded %s", i, tests[i].instr, d)
+		}
+		var args []interface{}
+		if len(d.Args) > 0 {
+			args = d.Args
+		}
+		if !reflect.DeepEqual(args, tests[i].args) {
+			t.Errorf("Instruction %d: expected args %#v, but decoded %#v", i, tests[i].args, d.Args)
+		}
+	}
+}
+
+func TestDisassembleRIPRelative(t *testing.T) {
+	a, err := LoadAsm(AMD64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var bs bytes.Buffer
+	rels, err := a.Encode(&bs, "MOV", R_RAX, Indirect{Symbol: "pkg.x", Size: 64})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := a.Disassemble(bs.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ds) != 1 || len(rels) != 1 {
+		t.Fatalf("Expected one instruction with one relocation, got %v and %v", ds, rels)
+	}
+	want := []interface{}{R_RAX, Indirect{Reg: R_RIP, Size: 64}}
+	if !reflect.DeepEqual(ds[0].Args, want) {
+		t.Errorf("Expected %#v, but decoded %#v", want, ds[0].Args)
+	}
+	if got := ds[0].String(); got != "MOV RAX, QWORD [RIP]" {
+		t.Errorf("Unexpected rendering %q", got)
+	}
+}
+
+func TestDisassembleUnknown(t *testing.T) {
+	ds, err := Disassemble([]byte{0x90, 0x06})
+	if err == nil {
+		t.Fatalf("Expected an error decoding PUSH ES, got %v", ds)
+	}
+	if len(ds) != 1 || ds[0].Mnemonic != "NOP" {
+		t.Errorf("Expected the leading NOP to decode, got %v", ds)
+	}
+}
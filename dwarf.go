@@ -0,0 +1,376 @@
+package gbasm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"sort"
+)
+
+// The DWARF 4 constants debugSections uses.
+const (
+	dwTagArrayType    = 0x01
+	dwTagCompileUnit  = 0x11
+	dwTagSubrangeType = 0x21
+	dwTagBaseType     = 0x24
+	dwTagSubprogram   = 0x2e
+	dwTagVariable     = 0x34
+
+	dwAtLocation  = 0x02
+	dwAtName      = 0x03
+	dwAtByteSize  = 0x0b
+	dwAtStmtList  = 0x10
+	dwAtLowPC     = 0x11
+	dwAtHighPC    = 0x12
+	dwAtLanguage  = 0x13
+	dwAtCompDir   = 0x1b
+	dwAtProducer  = 0x25
+	dwAtCount     = 0x37
+	dwAtEncoding  = 0x3e
+	dwAtFrameBase = 0x40
+	dwAtType      = 0x49
+	dwAtRanges    = 0x55
+
+	dwFormAddr      = 0x01
+	dwFormData2     = 0x05
+	dwFormData8     = 0x07
+	dwFormData1     = 0x0b
+	dwFormStrp      = 0x0e
+	dwFormUdata     = 0x0f
+	dwFormRef4      = 0x13
+	dwFormSecOffset = 0x17
+	dwFormExprloc   = 0x18
+
+	dwAteFloat        = 0x04
+	dwAteSigned       = 0x05
+	dwAteUnsignedChar = 0x08
+
+	dwOpBreg6 = 0x76 // RBP plus an offset
+	dwOpFbreg = 0x91
+
+	dwLangMipsAssembler = 0x8001
+
+	dwLnsCopy        = 0x01
+	dwLnsAdvancePC   = 0x02
+	dwLnsAdvanceLine = 0x03
+	dwLnsSetFile     = 0x04
+	dwLneEndSequence = 0x01
+	dwLneSetAddress  = 0x02
+)
+
+// The abbreviations of the DIEs in .debug_info, by code.
+const (
+	abbrevCompileUnit = 1 + iota
+	abbrevSubprogram
+	abbrevLeafSubprogram
+	abbrevVariable
+	abbrevBaseType
+	abbrevArrayType
+	abbrevSubrange
+)
+
+var dwarfAbbrevs = []struct {
+	code, tag int
+	children  bool
+	attrs     [][2]int // attribute, form
+}{
+	{abbrevCompileUnit, dwTagCompileUnit, true, [][2]int{
+		{dwAtProducer, dwFormStrp},
+		{dwAtLanguage, dwFormData2},
+		{dwAtName, dwFormStrp},
+		{dwAtCompDir, dwFormStrp},
+		{dwAtStmtList, dwFormSecOffset},
+		{dwAtLowPC, dwFormAddr},
+		{dwAtRanges, dwFormSecOffset},
+	}},
+	{abbrevSubprogram, dwTagSubprogram, true, [][2]int{
+		{dwAtName, dwFormStrp},
+		{dwAtLowPC, dwFormAddr},
+		{dwAtHighPC, dwFormData8},
+		{dwAtFrameBase, dwFormExprloc},
+	}},
+	// A function with no locals in its frame: it may have no frame
+	// pointer, and it has no variables that need a frame base.
+	{abbrevLeafSubprogram, dwTagSubprogram, false, [][2]int{
+		{dwAtName, dwFormStrp},
+		{dwAtLowPC, dwFormAddr},
+		{dwAtHighPC, dwFormData8},
+	}},
+	{abbrevVariable, dwTagVariable, false, [][2]int{
+		{dwAtName, dwFormStrp},
+		{dwAtType, dwFormRef4},
+		{dwAtLocation, dwFormSecOffset},
+	}},
+	{abbrevBaseType, dwTagBaseType, false, [][2]int{
+		{dwAtName, dwFormStrp},
+		{dwAtEncoding, dwFormData1},
+		{dwAtByteSize, dwFormData1},
+	}},
+	{abbrevArrayType, dwTagArrayType, true, [][2]int{
+		{dwAtType, dwFormRef4},
+	}},
+	{abbrevSubrange, dwTagSubrangeType, false, [][2]int{
+		{dwAtCount, dwFormUdata},
+	}},
+}
+
+// dwarfBaseTypes are the types of FrameVars. A FrameVar of any other type
+// is described as an array of its size in bytes.
+var dwarfBaseTypes = []struct {
+	name     string
+	encoding byte
+	size     byte
+}{
+	{"i8", dwAteSigned, 1},
+	{"i16", dwAteSigned, 2},
+	{"i32", dwAteSigned, 4},
+	{"i64", dwAteSigned, 8},
+	{"f32", dwAteFloat, 4},
+	{"f64", dwAteFloat, 8},
+	{"byte", dwAteUnsignedChar, 1},
+}
+
+// debugFunc is a function placed in an executable, to be described in its
+// debug info.
+type debugFunc struct {
+	f    *Function
+	name string // qualified
+	addr uint64
+	size int
+}
+
+// dwarfBuilder accumulates the DWARF sections of an executable.
+type dwarfBuilder struct {
+	abbrev, info, line, str, ranges, loc bytes.Buffer
+	strs                                 map[string]uint32
+}
+
+// debugSections returns the DWARF 4 sections describing fs: .debug_info,
+// with a compile unit per package holding a subprogram per function and a
+// variable per FrameVar, and the .debug_abbrev, .debug_line, .debug_str,
+// .debug_ranges and .debug_loc sections it refers to. Relative file names
+// in the line tables are relative to the directory bld runs in, which is
+// each unit's DW_AT_comp_dir.
+func debugSections(fs []debugFunc) []*Section {
+	bypkg := make(map[string][]debugFunc)
+	for _, f := range fs {
+		bypkg[f.f.Pkgname] = append(bypkg[f.f.Pkgname], f)
+	}
+	compDir, _ := os.Getwd()
+	d := &dwarfBuilder{strs: make(map[string]uint32)}
+	for _, a := range dwarfAbbrevs {
+		d.abbrev.Write(appendULEB(nil, uint64(a.code)))
+		d.abbrev.Write(appendULEB(nil, uint64(a.tag)))
+		if a.children {
+			d.abbrev.WriteByte(1)
+		} else {
+			d.abbrev.WriteByte(0)
+		}
+		for _, at := range a.attrs {
+			d.abbrev.Write(appendULEB(nil, uint64(at[0])))
+			d.abbrev.Write(appendULEB(nil, uint64(at[1])))
+		}
+		d.abbrev.Write([]byte{0, 0})
+	}
+	d.abbrev.WriteByte(0)
+	for _, pkg := range sortedKeys(bypkg) {
+		d.unit(pkg, compDir, bypkg[pkg])
+	}
+	section := func(name string, b *bytes.Buffer) *Section {
+		return &Section{Name: name, permission: F_READ, val: b.Bytes()}
+	}
+	return []*Section{
+		section(".debug_abbrev", &d.abbrev),
+		section(".debug_info", &d.info),
+		section(".debug_line", &d.line),
+		section(".debug_str", &d.str),
+		section(".debug_ranges", &d.ranges),
+		section(".debug_loc", &d.loc),
+	}
+}
+
+// strp writes the offset of s in .debug_str to b.
+func (d *dwarfBuilder) strp(b *bytes.Buffer, s string) {
+	off, ok := d.strs[s]
+	if !ok {
+		off = uint32(d.str.Len())
+		d.str.WriteString(s)
+		d.str.WriteByte(0)
+		d.strs[s] = off
+	}
+	binary.Write(b, binary.LittleEndian, off)
+}
+
+// unit writes the compile unit of package pkg, whose functions are fs.
+func (d *dwarfBuilder) unit(pkg, compDir string, fs []debugFunc) {
+	var u bytes.Buffer
+	u.Write(make([]byte, 4)) // unit_length, filled in last
+	binary.Write(&u, binary.LittleEndian, uint16(4))
+	binary.Write(&u, binary.LittleEndian, uint32(0)) // the one abbreviation table
+	u.WriteByte(8)
+
+	u.Write(appendULEB(nil, abbrevCompileUnit))
+	d.strp(&u, "gbasm "+Producer)
+	binary.Write(&u, binary.LittleEndian, uint16(dwLangMipsAssembler))
+	d.strp(&u, pkg)
+	d.strp(&u, compDir)
+	binary.Write(&u, binary.LittleEndian, uint32(d.line.Len()))
+	binary.Write(&u, binary.LittleEndian, uint64(0))
+	binary.Write(&u, binary.LittleEndian, uint32(d.ranges.Len()))
+	for _, f := range fs {
+		binary.Write(&d.ranges, binary.LittleEndian, [2]uint64{f.addr, f.addr + uint64(f.size)})
+	}
+	binary.Write(&d.ranges, binary.LittleEndian, [2]uint64{})
+	d.lineProgram(fs)
+
+	// The types come first, so the variables can refer back to them.
+	types := make(map[string]uint32)
+	for _, t := range dwarfBaseTypes {
+		types[t.name] = uint32(u.Len())
+		u.Write(appendULEB(nil, abbrevBaseType))
+		d.strp(&u, t.name)
+		u.Write([]byte{t.encoding, t.size})
+	}
+	typeOf := func(v FrameVar) string {
+		if _, ok := types[v.Type]; ok {
+			return v.Type
+		}
+		return fmt.Sprintf("byte[%d]", v.Size)
+	}
+	var arrays []int
+	for _, f := range fs {
+		for _, v := range f.f.Locals {
+			t := typeOf(v)
+			if _, ok := types[t]; !ok {
+				types[t] = 0
+				arrays = append(arrays, v.Size)
+			}
+		}
+	}
+	sort.Ints(arrays)
+	for _, n := range arrays {
+		types[fmt.Sprintf("byte[%d]", n)] = uint32(u.Len())
+		u.Write(appendULEB(nil, abbrevArrayType))
+		binary.Write(&u, binary.LittleEndian, types["byte"])
+		u.Write(appendULEB(nil, abbrevSubrange))
+		u.Write(appendULEB(nil, uint64(n)))
+		u.WriteByte(0)
+	}
+
+	for _, f := range fs {
+		if len(f.f.Locals) == 0 {
+			u.Write(appendULEB(nil, abbrevLeafSubprogram))
+			d.strp(&u, f.name)
+			binary.Write(&u, binary.LittleEndian, [2]uint64{f.addr, uint64(f.size)})
+			continue
+		}
+		u.Write(appendULEB(nil, abbrevSubprogram))
+		d.strp(&u, f.name)
+		binary.Write(&u, binary.LittleEndian, [2]uint64{f.addr, uint64(f.size)})
+		u.Write([]byte{2, dwOpBreg6, 0})
+		for _, v := range f.f.Locals {
+			u.Write(appendULEB(nil, abbrevVariable))
+			d.strp(&u, v.Name)
+			binary.Write(&u, binary.LittleEndian, types[typeOf(v)])
+			binary.Write(&u, binary.LittleEndian, uint32(d.loc.Len()))
+			expr := appendSLEB([]byte{dwOpFbreg}, int64(v.Offset))
+			binary.Write(&d.loc, binary.LittleEndian, [2]uint64{f.addr + uint64(v.Start), f.addr + uint64(v.End)})
+			binary.Write(&d.loc, binary.LittleEndian, uint16(len(expr)))
+			d.loc.Write(expr)
+			binary.Write(&d.loc, binary.LittleEndian, [2]uint64{})
+		}
+		u.WriteByte(0)
+	}
+	u.WriteByte(0)
+	bs := u.Bytes()
+	binary.LittleEndian.PutUint32(bs, uint32(len(bs)-4))
+	d.info.Write(bs)
+}
+
+// lineProgram writes the line number program of a compile unit whose
+// functions are fs: a sequence for each function with Lines.
+func (d *dwarfBuilder) lineProgram(fs []debugFunc) {
+	var files []string
+	fileNum := make(map[string]uint64)
+	for _, f := range fs {
+		for _, l := range f.f.Lines {
+			if _, ok := fileNum[l.File]; !ok {
+				files = append(files, l.File)
+				fileNum[l.File] = uint64(len(files))
+			}
+		}
+	}
+
+	// The header from minimum_instruction_length on. Programs only use
+	// the standard opcodes, so line_base and line_range are the usual
+	// ones.
+	var h bytes.Buffer
+	h.Write([]byte{1, 1, 1, 0xfb, 14, 13})
+	h.Write([]byte{0, 1, 1, 1, 1, 0, 0, 0, 1, 0, 0, 1})
+	h.WriteByte(0) // no include_directories
+	for _, file := range files {
+		h.WriteString(file)
+		h.Write([]byte{0, 0, 0, 0}) // directory, mtime and length
+	}
+	h.WriteByte(0)
+
+	var p bytes.Buffer
+	for _, f := range fs {
+		if len(f.f.Lines) == 0 {
+			continue
+		}
+		p.Write([]byte{0, 9, dwLneSetAddress})
+		binary.Write(&p, binary.LittleEndian, f.addr)
+		file, line, pc := uint64(1), 1, uint32(0)
+		for _, l := range f.f.Lines {
+			if n := fileNum[l.File]; n != file {
+				p.Write(appendULEB([]byte{dwLnsSetFile}, n))
+				file = n
+			}
+			if l.Offset > pc {
+				p.Write(appendULEB([]byte{dwLnsAdvancePC}, uint64(l.Offset-pc)))
+				pc = l.Offset
+			}
+			if l.Line != line {
+				p.Write(appendSLEB([]byte{dwLnsAdvanceLine}, int64(l.Line-line)))
+				line = l.Line
+			}
+			p.WriteByte(dwLnsCopy)
+		}
+		if end := uint32(f.size); end > pc {
+			p.Write(appendULEB([]byte{dwLnsAdvancePC}, uint64(end-pc)))
+		}
+		p.Write([]byte{0, 1, dwLneEndSequence})
+	}
+
+	binary.Write(&d.line, binary.LittleEndian, uint32(2+4+h.Len()+p.Len()))
+	binary.Write(&d.line, binary.LittleEndian, uint16(4))
+	binary.Write(&d.line, binary.LittleEndian, uint32(h.Len()))
+	d.line.Write(h.Bytes())
+	d.line.Write(p.Bytes())
+}
+
+// appendULEB appends v to b as an unsigned LEB128 number.
+func appendULEB(b []byte, v uint64) []byte {
+	for {
+		c := byte(v & 0x7f)
+		v >>= 7
+		if v == 0 {
+			return append(b, c)
+		}
+		b = append(b, c|0x80)
+	}
+}
+
+// appendSLEB appends v to b as a signed LEB128 number.
+func appendSLEB(b []byte, v int64) []byte {
+	for {
+		c := byte(v & 0x7f)
+		v >>= 7
+		if v == 0 && c&0x40 == 0 || v == -1 && c&0x40 != 0 {
+			return append(b, c)
+		}
+		b = append(b, c|0x80)
+	}
+}
@@ -0,0 +1,243 @@
+package gbasm
+
+import (
+	"debug/dwarf"
+	"debug/elf"
+	"encoding/binary"
+	"fmt"
+	"path/filepath"
+	"testing"
+)
+
+func TestLinkDWARF(t *testing.T) {
+	o, err := NewOFile("loop", "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	loop := debugTestFunction(t, o, false)
+	init, err := NewOFile("init", "_init")
+	if err != nil {
+		t.Fatal(err)
+	}
+	start, err := init.NewFunction("init.bs", 1, "start")
+	if err != nil {
+		t.Fatal(err)
+	}
+	start.SourceLine("init.bs", 2)
+	start.Jump("CALL", "main.loop")
+	start.SourceLine("init.bs", 3)
+	start.Instr("RET")
+	if err := start.Resolve(); err != nil {
+		t.Fatal(err)
+	}
+
+	exe := filepath.Join(t.TempDir(), "loop")
+	bin, err := Link([]*OFile{init, o}, ENTRY_ADDR)
+	if err != nil {
+		t.Fatal(err)
+	}
+	WriteElf(exe, LinkedBinToElfSections(bin))
+	ef, err := elf.Open(exe)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ef.Close()
+	for _, p := range ef.Progs {
+		if p.Type == elf.PT_LOAD && p.Vaddr == 0 {
+			t.Errorf("Expected the debug sections not to be loaded, got %+v", p.ProgHeader)
+		}
+	}
+	d, err := ef.DWARF()
+	if err != nil {
+		t.Fatal(err)
+	}
+	syms, err := ef.Symbols()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var addr uint64
+	for _, s := range syms {
+		if s.Name == "main.loop" {
+			addr = s.Value
+		}
+	}
+
+	r := d.Reader()
+	units := make(map[string]*dwarf.Entry)
+	funcs := make(map[string]*dwarf.Entry)
+	vars := make(map[string]*dwarf.Entry)
+	for {
+		e, err := r.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if e == nil {
+			break
+		}
+		name, _ := e.Val(dwarf.AttrName).(string)
+		switch e.Tag {
+		case dwarf.TagCompileUnit:
+			units[name] = e
+		case dwarf.TagSubprogram:
+			funcs[name] = e
+		case dwarf.TagVariable:
+			vars[name] = e
+		}
+	}
+	if len(units) != 2 || units["main"] == nil || units["_init"] == nil {
+		t.Fatalf("Expected compile units main and _init, got %v", units)
+	}
+	f := funcs["main.loop"]
+	if f == nil || f.Val(dwarf.AttrLowpc) != addr || f.Val(dwarf.AttrHighpc) != int64(len(loop.bodyBs)) {
+		t.Errorf("Expected main.loop at %#x, %d bytes long, got %v", addr, len(loop.bodyBs), f)
+	}
+	if f == nil || !f.Children || f.Val(dwarf.AttrFrameBase) == nil {
+		t.Errorf("Expected main.loop to have a frame base and variables, got %v", f)
+	}
+	// _init.start has no locals, so it has neither.
+	if s := funcs["_init.start"]; s == nil || s.Children || s.Val(dwarf.AttrFrameBase) != nil {
+		t.Errorf("Expected _init.start without a frame base or children, got %v", s)
+	}
+	ranges, err := d.Ranges(units["main"])
+	if err != nil || fmt.Sprint(ranges) != fmt.Sprint([][2]uint64{{addr, addr + uint64(len(loop.bodyBs))}}) {
+		t.Errorf("Expected unit main to cover main.loop, got %v (%v)", ranges, err)
+	}
+
+	lr, err := d.LineReader(units["main"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got, want []string
+	for _, l := range loop.Lines {
+		want = append(want, fmt.Sprintf("%#x %s:%d", addr+uint64(l.Offset), l.File, l.Line))
+	}
+	var le dwarf.LineEntry
+	for lr.Next(&le) == nil {
+		if !le.EndSequence {
+			got = append(got, fmt.Sprintf("%#x %s:%d", le.Address, filepath.Base(le.File.Name), le.Line))
+		} else if le.Address != addr+uint64(len(loop.bodyBs)) {
+			t.Errorf("Expected the sequence to end at the end of main.loop, got %#x", le.Address)
+		}
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("Expected line table %v, got %v", want, got)
+	}
+
+	loc, err := ef.Section(".debug_loc").Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, lv := range loop.Locals {
+		v := vars[lv.Name]
+		if v == nil {
+			t.Errorf("Expected a variable %s", lv.Name)
+			continue
+		}
+		typ, err := d.Type(v.Val(dwarf.AttrType).(dwarf.Offset))
+		if err != nil || typ.Size() != int64(lv.Size) {
+			t.Errorf("Expected %s to be %d bytes, got %v (%v)", lv.Name, lv.Size, typ, err)
+		}
+		// A single location, in the frame, over the variable's range.
+		off := v.Val(dwarf.AttrLocation).(int64)
+		ent := loc[off:]
+		begin, end := binary.LittleEndian.Uint64(ent), binary.LittleEndian.Uint64(ent[8:])
+		n := binary.LittleEndian.Uint16(ent[16:])
+		expr := appendSLEB([]byte{dwOpFbreg}, int64(lv.Offset))
+		if begin != addr+uint64(lv.Start) || end != addr+uint64(lv.End) || string(ent[18:18+n]) != string(expr) {
+			t.Errorf("Expected %s at fbreg %d over %#x-%#x, got %x", lv.Name, lv.Offset, addr+uint64(lv.Start), addr+uint64(lv.End), ent[:18+n])
+		}
+		if binary.LittleEndian.Uint64(ent[18+n:]) != 0 || binary.LittleEndian.Uint64(ent[26+n:]) != 0 {
+			t.Errorf("Expected %s to have one location", lv.Name)
+		}
+	}
+	if len(loop.Locals) != 2 {
+		t.Errorf("Expected locals n and buf, got %+v", loop.Locals)
+	}
+}
+
+func TestLEB128(t *testing.T) {
+	for _, tt := range []struct {
+		v    int64
+		want string
+	}{
+		{0, "00"}, {2, "02"}, {-2, "7e"}, {127, "ff00"}, {-128, "807f"}, {-24, "68"}, {624485, "e58e26"},
+	} {
+		if got := fmt.Sprintf("%x", appendSLEB(nil, tt.v)); got != tt.want {
+			t.Errorf("SLEB %d: expected %s, got %s", tt.v, tt.want, got)
+		}
+	}
+	if got := fmt.Sprintf("%x", appendULEB(nil, 624485)); got != "e58e26" {
+		t.Errorf("ULEB 624485: expected e58e26, got %s", got)
+	}
+}
+
+func TestLinkDWARFPinned(t *testing.T) {
+	// bas -ra=scan pins locals to callee-saved registers, so their stack
+	// slots never hold their values.
+	o, err := NewOFile("pin", "_init")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := o.NewFunction("pin.bs", 1, "start")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Reserve(R12)
+	f.Prologue()
+	n, err := f.NewLocal("n", 64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := n.Pin(R12); err != nil {
+		t.Fatal(err)
+	}
+	m, err := f.NewLocal("m", 64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Instr("MOV", n, int8(1))
+	f.Instr("MOV", m, n)
+	f.Jump("CALL", "_init.start")
+	f.Instr("ADD", n, m)
+	f.Epilogue()
+	f.Instr("RET")
+	if err := f.Resolve(); err != nil {
+		t.Fatal(err)
+	}
+	if len(f.Locals) != 1 || f.Locals[0].Name != "m" {
+		t.Fatalf("Expected only m to have a frame location, got %+v", f.Locals)
+	}
+
+	exe := filepath.Join(t.TempDir(), "pin")
+	bin, err := Link([]*OFile{o}, ENTRY_ADDR)
+	if err != nil {
+		t.Fatal(err)
+	}
+	WriteElf(exe, LinkedBinToElfSections(bin))
+	ef, err := elf.Open(exe)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ef.Close()
+	d, err := ef.DWARF()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var vars []string
+	r := d.Reader()
+	for {
+		e, err := r.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if e == nil {
+			break
+		}
+		if e.Tag == dwarf.TagVariable {
+			vars = append(vars, e.Val(dwarf.AttrName).(string))
+		}
+	}
+	if fmt.Sprint(vars) != "[m]" {
+		t.Errorf("Expected only the variable m, got %v", vars)
+	}
+}
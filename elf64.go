@@ -123,6 +123,7 @@ const (
 	SHF_WRITE     = 0x1        // Section contains writable data
 	SHF_ALLOC     = 0x2        // Section is allocated in memory image of program
 	SHF_EXECINSTR = 0x4        // Section contains executable instructions
+	SHF_INFO_LINK = 0x40       // sh_info holds a section index
 	SHF_MASKOS    = 0x0F000000 // Environment-specific use
 	SHF_MASKPROC  = 0xF0000000 // Processor-specific use
 )
@@ -246,6 +247,43 @@ type Elf64_Phdr struct {
 	p_align  Elf64_Xword // Alignment of segment
 }
 
+const Elf64_RelaSize = 24
+
+type Elf64_Rela struct {
+	r_offset Elf64_Addr   // Address of reference
+	r_info   Elf64_Xword  // Symbol index and type of relocation
+	r_addend Elf64_Sxword // Constant part of expression
+}
+
+// r_info low bits
+const (
+	R_X86_64_64       = 1 // Symbol plus addend, 64 bits
+	R_X86_64_PC32     = 2 // Symbol plus addend minus place, 32 bits
+	R_X86_64_RELATIVE = 8 // Load bias plus addend
+)
+
+const Elf64_DynSize = 16
+
+type Elf64_Dyn struct {
+	d_tag Elf64_Sxword // Type of entry
+	d_val Elf64_Xword  // Integer value or address
+}
+
+// d_tag
+const (
+	DT_NULL      = 0 // Marks the end of the dynamic array
+	DT_RELA      = 7 // Address of the relocation table
+	DT_RELASZ    = 8 // Size in bytes of the relocation table
+	DT_RELAENT   = 9 // Size in bytes of each relocation entry
+	DT_FLAGS_1   = 0x6FFFFFFB
+	DT_RELACOUNT = 0x6FFFFFF9 // Number of R_X86_64_RELATIVE relocations
+)
+
+// DT_FLAGS_1 values
+const (
+	DF_1_PIE = 0x08000000 // Object is a position-independent executable
+)
+
 type Elf64_Symbol struct {
 	Name    string
 	Type    int
@@ -255,13 +293,23 @@ type Elf64_Symbol struct {
 
 type Elf64_Section struct {
 	//header Elf64_Shdr
-	name     string
-	s_type   Elf64_Word
-	flags    Elf64_Xword
-	addr     Elf64_Addr
-	data     []byte
+	name   string
+	s_type Elf64_Word
+	flags  Elf64_Xword
+	addr   Elf64_Addr
+	data   []byte
+	// nobits is the size of an SHT_NOBITS section, which has no data.
+	nobits   Elf64_Xword
 	loadable bool
 	syms     []Elf64_Symbol
+	// link names the section sh_link indexes, if any: the string table
+	// of a .dynamic section.
+	link string
+}
+
+// size is the size of the section in memory.
+func (s Elf64_Section) size() Elf64_Xword {
+	return Elf64_Xword(len(s.data)) + s.nobits
 }
 
 type strtab struct {
@@ -293,11 +341,20 @@ const ENTRY_ADDR = 0x30000
 
 func WriteElf(exename string, sections []Elf64_Section) {
 
+	// An image with a .dynamic section is position-independent: ET_DYN,
+	// with a PT_DYNAMIC segment as well as the PT_LOAD for the section.
 	var nphdrs int
-	for _, sect := range sections {
-		if sect.loadable {
+	var etype Elf64_Half = ET_EXEC
+	index := make(map[string]Elf64_Word)
+	for i, sect := range sections {
+		index[sect.name] = Elf64_Word(i + 1)
+		if sect.loadable && sect.size() > 0 {
 			nphdrs++
 		}
+		if sect.s_type == SHT_DYNAMIC {
+			nphdrs++
+			etype = ET_DYN
+		}
 	}
 
 	// Needs:
@@ -305,7 +362,7 @@ func WriteElf(exename string, sections []Elf64_Section) {
 	// e_shoff
 	elfHdr := Elf64_Ehdr{
 		e_ident:     makeHeaderIdent(),
-		e_type:      ET_EXEC,
+		e_type:      etype,
 		e_machine:   EM_AMD64,
 		e_version:   EV_CURRENT,
 		e_entry:     ENTRY_ADDR,
@@ -336,30 +393,49 @@ func WriteElf(exename string, sections []Elf64_Section) {
 			sh_flags:  sect.flags,
 			sh_addr:   sect.addr,
 			sh_offset: dataOff,
-			sh_size:   Elf64_Xword(len(sect.data)),
+			sh_size:   sect.size(),
+			sh_link:   index[sect.link],
 			//sh_addralign: 0x1000,
 			sh_addralign: 0x8,
 		}
+		switch sect.s_type {
+		case SHT_RELA:
+			sHdr.sh_entsize = Elf64_RelaSize
+		case SHT_DYNAMIC:
+			sHdr.sh_entsize = Elf64_DynSize
+		}
 		if sect.loadable {
+			// Each loadable section is a segment of its own, on its own
+			// pages, so the kernel maps it with exactly its permissions:
+			// R for .rodata, RW for .data and .bss and RX for .text. The
+			// kernel fills the p_memsz bytes past p_filesz with zeros, so
+			// .bss takes no space in the file.
 			pHdr := Elf64_Phdr{
 				p_type:   PT_LOAD,
-				p_flags:  PF_R, // | PF_W, //PF_X | PF_R,
-				p_align:  0x8,  //0x1000,
+				p_flags:  PF_R,
+				p_align:  0x1000,
 				p_offset: Elf64_Off(dataOff),
 				p_vaddr:  Elf64_Addr(sect.addr),
 				p_paddr:  Elf64_Addr(sect.addr), // needed?
 				p_filesz: Elf64_Xword(len(sect.data)),
-				p_memsz:  Elf64_Xword(len(sect.data)),
+				p_memsz:  sect.size(),
 			}
+			sHdr.sh_addralign = 0x1000
 
 			if sect.flags&SHF_EXECINSTR != 0 {
 				pHdr.p_flags |= PF_X
-				pHdr.p_align = 0x1000
-				sHdr.sh_addralign = 0x1000
 			} else if sect.flags&SHF_WRITE != 0 {
 				pHdr.p_flags |= PF_W
 			}
-			phdrs = append(phdrs, pHdr)
+			// An empty section needs no segment.
+			if pHdr.p_memsz > 0 {
+				phdrs = append(phdrs, pHdr)
+			}
+			if sect.s_type == SHT_DYNAMIC {
+				pHdr.p_type = PT_DYNAMIC
+				pHdr.p_align = 0x8
+				phdrs = append(phdrs, pHdr)
+			}
 
 			for _, sym := range sect.syms {
 				var info byte
@@ -379,7 +455,9 @@ func WriteElf(exename string, sections []Elf64_Section) {
 				symcount++
 			}
 		}
-		dataOff += Elf64_Off(len(sect.data)+0x1000) & (^Elf64_Off(0xFFF))
+		if sect.s_type != SHT_NOBITS {
+			dataOff += Elf64_Off(len(sect.data)+0x1000) & (^Elf64_Off(0xFFF))
+		}
 		shdrs = append(shdrs, sHdr)
 	}
 
@@ -0,0 +1,97 @@
+package gbasm
+
+import (
+	"debug/elf"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLinkSegments(t *testing.T) {
+	o, err := NewOFile("seg", "_init")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := o.AddData("msg", "string", "hi", false); err != nil {
+		t.Fatal(err)
+	}
+	if err := o.AddVar("count", "i64", uint64(0), false); err != nil {
+		t.Fatal(err)
+	}
+	if err := o.AddZeroVar("buf", "byte[1048576]", 1<<20, false); err != nil {
+		t.Fatal(err)
+	}
+	f, err := o.NewFunction("seg.bs", 1, "start")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Instr("LEA", R_RAX, o.Data["msg"])
+	f.Instr("LEA", R_RAX, o.Vars["count"])
+	f.Instr("LEA", R_RAX, o.Vars["buf"])
+	f.Instr("RET")
+	if err := f.Resolve(); err != nil {
+		t.Fatal(err)
+	}
+
+	exe := filepath.Join(t.TempDir(), "seg")
+	bin, err := Link([]*OFile{o}, ENTRY_ADDR)
+	if err != nil {
+		t.Fatal(err)
+	}
+	WriteElf(exe, LinkedBinToElfSections(bin))
+	ef, err := elf.Open(exe)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ef.Close()
+
+	want := map[string]elf.ProgFlag{
+		".text":   elf.PF_R | elf.PF_X,
+		".data":   elf.PF_R | elf.PF_W,
+		".bss":    elf.PF_R | elf.PF_W,
+		".rodata": elf.PF_R,
+	}
+	for name, flags := range want {
+		s := ef.Section(name)
+		if s == nil {
+			t.Errorf("Expected a %s section", name)
+			continue
+		}
+		var seg *elf.Prog
+		for _, p := range ef.Progs {
+			if p.Type == elf.PT_LOAD && p.Vaddr == s.Addr {
+				seg = p
+			}
+		}
+		if seg == nil {
+			t.Errorf("Expected a PT_LOAD segment for %s", name)
+			continue
+		}
+		if seg.Flags != flags || seg.Align != 0x1000 || seg.Vaddr%0x1000 != 0 || seg.Off%0x1000 != 0 {
+			t.Errorf("Expected %s in a page-aligned %v segment, got %v at %#x (offset %#x, align %#x)",
+				name, flags, seg.Flags, seg.Vaddr, seg.Off, seg.Align)
+		}
+		if name == ".bss" && (s.Type != elf.SHT_NOBITS || s.Size != 1<<20 || seg.Filesz != 0 || seg.Memsz != 1<<20) {
+			t.Errorf("Expected .bss to be 1MiB of NOBITS, got %v of size %#x in a segment of filesz %#x, memsz %#x",
+				s.Type, s.Size, seg.Filesz, seg.Memsz)
+		}
+	}
+	fi, err := os.Stat(exe)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fi.Size() >= 1<<20 {
+		t.Errorf("Expected .bss to take no space in the file, got a %d-byte file", fi.Size())
+	}
+
+	syms, err := ef.Symbols()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range syms {
+		want := map[string]string{"_init.msg": ".rodata", "_init.count": ".data", "_init.buf": ".bss", "_init.start": ".text"}[s.Name]
+		if want != "" && ef.Sections[s.Section].Name != want {
+			t.Errorf("Expected %s in %s, got %s", s.Name, want, ef.Sections[s.Section].Name)
+		}
+	}
+}
@@ -0,0 +1,247 @@
+package gbasm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// Section indices of an ELF relocatable object written by WriteELF.
+const (
+	elfText = iota + 1
+	elfRodata
+	elfData
+	elfBss
+	elfRelaText
+	elfRelaRodata
+	elfRelaData
+	elfSymtab
+	elfStrtab
+	elfShstrtab
+	elfNumSections
+)
+
+// elfSymbol is a global symbol of an ELF relocatable object. A symbol with
+// shndx SHN_UNDEF is one the object refers to but does not define.
+type elfSymbol struct {
+	name  string
+	typ   byte
+	shndx Elf64_Half
+	value uint64
+	size  uint64
+}
+
+// elfReloc is a relocation of an ELF relocatable object, against a symbol
+// by name.
+type elfReloc struct {
+	offset uint64
+	symbol string
+	typ    uint32
+	addend int64
+}
+
+// elfSection is the contents of one section of an ELF relocatable object.
+type elfSection struct {
+	bs    bytes.Buffer
+	align int
+	syms  []elfSymbol
+	rela  []elfReloc
+}
+
+// WriteELF writes o to w as an ELF64 relocatable object (ET_REL), for
+// tools outside the toolchain. Functions go in .text, data blocks in
+// .rodata and vars in .data, or .bss if zero-filled, each in name order. Every definition is a
+// global symbol under its package-qualified name, as is each exported label
+// (see LabelRef). Code relocations become R_X86_64_PC32 entries in
+// .rela.text, and data relocations R_X86_64_64 entries in .rela.data, or in
+// .rela.rodata for a data block. Symbols of package _link, and anything
+// else o refers to but does not define, are undefined.
+func (o *OFile) WriteELF(w io.Writer) error {
+	if o.Pkgname == "" {
+		return fmt.Errorf("Object file %s has no package name", o.Filename)
+	}
+	var text, rodata, data, bss elfSection
+	for _, name := range sortedKeys(o.Funcs) {
+		f := o.Funcs[name]
+		bs, err := f.Body()
+		if err != nil {
+			return fmt.Errorf("Failed to resolve function %s body: %s", name, err)
+		}
+		text.bs.Write(appendNops(nil, padLen(text.bs.Len(), f.Align)))
+		text.align = max(text.align, f.Align)
+		off := uint64(text.bs.Len())
+		qname := qualify(o.Pkgname, name)
+		text.syms = append(text.syms, elfSymbol{qname, STT_FUNC, elfText, off, uint64(len(bs))})
+		for _, s := range f.Symbols {
+			text.syms = append(text.syms, elfSymbol{LabelRef(qname, s.Name), STT_NOTYPE, elfText, off + uint64(s.Offset), 0})
+		}
+		for _, r := range f.Relocations {
+			// Relocation.Apply makes the field relative to its end, and
+			// PC32 relative to its start.
+			text.rela = append(text.rela, elfReloc{off + uint64(r.Offset), r.Symbol, R_X86_64_PC32, int64(r.Addend) - 4})
+		}
+		text.bs.Write(bs)
+	}
+	place := func(sect *elfSection, shndx Elf64_Half, vars map[string]*Var) {
+		for _, name := range sortedKeys(vars) {
+			v := vars[name]
+			sect.bs.Write(make([]byte, padLen(sect.bs.Len(), v.Align)))
+			sect.align = max(sect.align, v.Align)
+			off := uint64(sect.bs.Len())
+			sect.syms = append(sect.syms, elfSymbol{qualify(o.Pkgname, name), STT_OBJECT, shndx, off, uint64(v.Size())})
+			for _, dr := range v.Relocs {
+				target := dr.Symbol
+				if !isQualified(target) {
+					target = qualify(o.Pkgname, target)
+				}
+				sect.rela = append(sect.rela, elfReloc{off + uint64(dr.Offset), target, R_X86_64_64, dr.Addend})
+			}
+			// .bss has no contents in the file; its zeros only measure it.
+			sect.bs.Write(v.Val)
+			sect.bs.Write(make([]byte, v.ZeroFill))
+		}
+	}
+	vars := make(map[string]*Var)
+	zeroed := make(map[string]*Var)
+	for name, v := range o.Vars {
+		if v.ZeroFill > 0 {
+			zeroed[name] = v
+		} else {
+			vars[name] = v
+		}
+	}
+	place(&rodata, elfRodata, o.Data)
+	place(&data, elfData, vars)
+	place(&bss, elfBss, zeroed)
+
+	// The symbol table starts with the null symbol and one local symbol
+	// per section, as the ELF spec asks; the globals follow by name.
+	defined := make(map[string]bool)
+	var globals []elfSymbol
+	for _, sect := range []*elfSection{&text, &rodata, &data, &bss} {
+		for _, s := range sect.syms {
+			defined[s.name] = true
+			globals = append(globals, s)
+		}
+	}
+	for _, sect := range []*elfSection{&text, &rodata, &data} {
+		for _, r := range sect.rela {
+			if !defined[r.symbol] {
+				defined[r.symbol] = true
+				globals = append(globals, elfSymbol{name: r.symbol, typ: STT_NOTYPE, shndx: SHN_UNDEF})
+			}
+		}
+	}
+	sort.Slice(globals, func(i, j int) bool { return globals[i].name < globals[j].name })
+
+	const nlocals = 1 + elfBss
+	strs := newstrtab()
+	symidx := make(map[string]int)
+	var symbs bytes.Buffer
+	binary.Write(&symbs, binary.LittleEndian, Elf64_Sym{})
+	for shndx := elfText; shndx <= elfBss; shndx++ {
+		binary.Write(&symbs, binary.LittleEndian, Elf64_Sym{
+			st_info:  STB_LOCAL<<4 | STT_SECTION,
+			st_shndx: Elf64_Half(shndx),
+		})
+	}
+	for i, s := range globals {
+		symidx[s.name] = nlocals + i
+		binary.Write(&symbs, binary.LittleEndian, Elf64_Sym{
+			st_name:  strs.StrOff(s.name),
+			st_info:  STB_GLOBAL<<4 | s.typ,
+			st_shndx: s.shndx,
+			st_value: Elf64_Addr(s.value),
+			st_size:  Elf64_Xword(s.size),
+		})
+	}
+	relabs := func(sect *elfSection) []byte {
+		sort.SliceStable(sect.rela, func(i, j int) bool { return sect.rela[i].offset < sect.rela[j].offset })
+		var bs bytes.Buffer
+		for _, r := range sect.rela {
+			binary.Write(&bs, binary.LittleEndian, Elf64_Rela{
+				r_offset: Elf64_Addr(r.offset),
+				r_info:   Elf64_Xword(symidx[r.symbol])<<32 | Elf64_Xword(r.typ),
+				r_addend: Elf64_Sxword(r.addend),
+			})
+		}
+		return bs.Bytes()
+	}
+
+	shstrs := newstrtab()
+	shdrs := make([]Elf64_Shdr, elfNumSections)
+	contents := make([][]byte, elfNumSections)
+	section := func(idx int, name string, typ, flags uint64, align int, bs []byte) *Elf64_Shdr {
+		shdrs[idx] = Elf64_Shdr{
+			sh_name:      shstrs.StrOff(name),
+			sh_type:      Elf64_Word(typ),
+			sh_flags:     Elf64_Xword(flags),
+			sh_size:      Elf64_Xword(len(bs)),
+			sh_addralign: Elf64_Xword(max(align, 1)),
+		}
+		contents[idx] = bs
+		return &shdrs[idx]
+	}
+	section(elfText, ".text", SHT_PROGBITS, SHF_ALLOC|SHF_EXECINSTR, text.align, text.bs.Bytes())
+	section(elfRodata, ".rodata", SHT_PROGBITS, SHF_ALLOC, rodata.align, rodata.bs.Bytes())
+	section(elfData, ".data", SHT_PROGBITS, SHF_ALLOC|SHF_WRITE, data.align, data.bs.Bytes())
+	h := section(elfBss, ".bss", SHT_NOBITS, SHF_ALLOC|SHF_WRITE, bss.align, nil)
+	h.sh_size = Elf64_Xword(bss.bs.Len())
+	for _, r := range []struct {
+		idx, target int
+		name        string
+		sect        *elfSection
+	}{
+		{elfRelaText, elfText, ".rela.text", &text},
+		{elfRelaRodata, elfRodata, ".rela.rodata", &rodata},
+		{elfRelaData, elfData, ".rela.data", &data},
+	} {
+		h := section(r.idx, r.name, SHT_RELA, SHF_INFO_LINK, 8, relabs(r.sect))
+		h.sh_link = elfSymtab
+		h.sh_info = Elf64_Word(r.target)
+		h.sh_entsize = Elf64_RelaSize
+	}
+	h = section(elfSymtab, ".symtab", SHT_SYMTAB, 0, 8, symbs.Bytes())
+	h.sh_link = elfStrtab
+	h.sh_info = nlocals
+	h.sh_entsize = Elf64_SymSize
+	section(elfStrtab, ".strtab", SHT_STRTAB, 0, 1, strs.bs.Bytes())
+	// .shstrtab holds its own name, so its contents are only complete
+	// once it has a header.
+	h = section(elfShstrtab, ".shstrtab", SHT_STRTAB, 0, 1, nil)
+	contents[elfShstrtab] = shstrs.bs.Bytes()
+	h.sh_size = Elf64_Xword(len(contents[elfShstrtab]))
+
+	// The section contents follow the ELF header, each at its alignment,
+	// and the section header table comes last.
+	off := Elf64_EhdrSize
+	for i := 1; i < elfNumSections; i++ {
+		off += padLen(off, int(shdrs[i].sh_addralign))
+		shdrs[i].sh_offset = Elf64_Off(off)
+		off += len(contents[i])
+	}
+	shoff := off + padLen(off, 8)
+
+	var b bytes.Buffer
+	binary.Write(&b, binary.LittleEndian, Elf64_Ehdr{
+		e_ident:     makeHeaderIdent(),
+		e_type:      ET_REL,
+		e_machine:   EM_AMD64,
+		e_version:   EV_CURRENT,
+		e_shoff:     Elf64_Off(shoff),
+		e_ehsize:    Elf64_EhdrSize,
+		e_shentsize: Elf64_ShdrSize,
+		e_shnum:     elfNumSections,
+		e_shstrndx:  elfShstrtab,
+	})
+	for i := 1; i < elfNumSections; i++ {
+		b.Write(make([]byte, int(shdrs[i].sh_offset)-b.Len()))
+		b.Write(contents[i])
+	}
+	b.Write(make([]byte, shoff-b.Len()))
+	binary.Write(&b, binary.LittleEndian, shdrs)
+	_, err := w.Write(b.Bytes())
+	return err
+}
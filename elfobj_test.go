@@ -0,0 +1,153 @@
+package gbasm
+
+import (
+	"bytes"
+	"debug/elf"
+	"encoding/binary"
+	"fmt"
+	"testing"
+)
+
+func TestWriteELF(t *testing.T) {
+	o, err := NewOFile("api.o", "api")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := o.NewFunction("api.bs", 1, "start")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := o.AddTable("cases", "start", []string{"a", "b"}, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := o.AddVar("hook", "fn()", uint64(0), true); err != nil {
+		t.Fatal(err)
+	}
+	o.Vars["hook"].Relocs = []DataReloc{{Offset: 0, Symbol: "start", Addend: 2}}
+	if err := o.AddZeroVar("buf", "byte[64]", 64, false); err != nil {
+		t.Fatal(err)
+	}
+	f.Instr("LEA", R_RAX, o.Data["cases"])
+	f.Instr("JMP", Indirect{Reg: R_RAX, Size: 64})
+	f.Label("a")
+	f.Jump("CALL", "other.h")
+	f.Label("b")
+	f.Instr("RET")
+	if errs := o.ExportLabelRefs(); len(errs) != 0 {
+		t.Fatal(errs)
+	}
+
+	var b bytes.Buffer
+	if err := o.WriteELF(&b); err != nil {
+		t.Fatal(err)
+	}
+	ef, err := elf.NewFile(bytes.NewReader(b.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ef.Type != elf.ET_REL || ef.Machine != elf.EM_X86_64 || ef.Class != elf.ELFCLASS64 {
+		t.Errorf("Expected an x86-64 ELF64 relocatable object, got %v %v %v", ef.Class, ef.Type, ef.Machine)
+	}
+	for name, flags := range map[string]elf.SectionFlag{
+		".text":   elf.SHF_ALLOC | elf.SHF_EXECINSTR,
+		".rodata": elf.SHF_ALLOC,
+		".data":   elf.SHF_ALLOC | elf.SHF_WRITE,
+	} {
+		s := ef.Section(name)
+		if s == nil || s.Type != elf.SHT_PROGBITS || s.Flags != flags {
+			t.Errorf("Expected section %s with flags %v, got %+v", name, flags, s)
+		}
+	}
+	if s := ef.Section(".bss"); s == nil || s.Type != elf.SHT_NOBITS || s.Flags != elf.SHF_ALLOC|elf.SHF_WRITE || s.Size != 64 {
+		t.Errorf("Expected a 64-byte writable NOBITS .bss, got %+v", s)
+	}
+	body, err := f.Body()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text, err := ef.Section(".text").Data(); err != nil || !bytes.Equal(text, body) {
+		t.Errorf("Expected .text to hold the body of start, got %x (%v)", text, err)
+	}
+
+	syms, err := ef.Symbols()
+	if err != nil {
+		t.Fatal(err)
+	}
+	bysym := make(map[string]elf.Symbol)
+	for _, s := range syms {
+		if elf.ST_TYPE(s.Info) == elf.STT_SECTION {
+			continue
+		}
+		if elf.ST_BIND(s.Info) != elf.STB_GLOBAL {
+			t.Errorf("Expected symbol %s to be global", s.Name)
+		}
+		bysym[s.Name] = s
+	}
+	labels := make(map[string]uint32)
+	for _, s := range f.Symbols {
+		labels[s.Name] = s.Offset
+	}
+	for _, want := range []struct {
+		name    string
+		typ     elf.SymType
+		section string
+		value   uint64
+	}{
+		{"api.start", elf.STT_FUNC, ".text", 0},
+		{"api.start:a", elf.STT_NOTYPE, ".text", uint64(labels["a"])},
+		{"api.start:b", elf.STT_NOTYPE, ".text", uint64(labels["b"])},
+		{"api.cases", elf.STT_OBJECT, ".rodata", 0},
+		{"api.hook", elf.STT_OBJECT, ".data", 0},
+		{"api.buf", elf.STT_OBJECT, ".bss", 0},
+	} {
+		s, ok := bysym[want.name]
+		if !ok || elf.ST_TYPE(s.Info) != want.typ || int(s.Section) >= len(ef.Sections) ||
+			ef.Sections[s.Section].Name != want.section || s.Value != want.value {
+			t.Errorf("Expected %v symbol %s at %s+%#x, got %+v", want.typ, want.name, want.section, want.value, s)
+		}
+	}
+	if s, ok := bysym["other.h"]; !ok || s.Section != elf.SHN_UNDEF {
+		t.Errorf("Expected other.h to be undefined, got %+v", s)
+	}
+
+	relocs := func(name string) []string {
+		t.Helper()
+		s := ef.Section(name)
+		if s == nil {
+			t.Fatalf("No section %s", name)
+		}
+		if s.Link != uint32(elfSymtab) || s.Entsize != Elf64_RelaSize {
+			t.Errorf("Expected %s to use .symtab, got link %d", name, s.Link)
+		}
+		data, err := s.Data()
+		if err != nil {
+			t.Fatal(err)
+		}
+		rela := make([]elf.Rela64, len(data)/Elf64_RelaSize)
+		if err := binary.Read(bytes.NewReader(data), binary.LittleEndian, rela); err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, r := range rela {
+			got = append(got, fmt.Sprintf("%#x %v %s%+d", r.Off, elf.R_X86_64(elf.R_TYPE64(r.Info)),
+				syms[elf.R_SYM64(r.Info)-1].Name, r.Addend))
+		}
+		return got
+	}
+	for _, want := range []struct {
+		section string
+		relocs  []string
+	}{
+		{".rela.text", []string{
+			fmt.Sprintf("%#x R_X86_64_PC32 api.cases-4", f.Relocations[0].Offset),
+			fmt.Sprintf("%#x R_X86_64_PC32 other.h-4", f.Relocations[1].Offset),
+		}},
+		{".rela.rodata", []string{"0x0 R_X86_64_64 api.start:a+0", "0x8 R_X86_64_64 api.start:b+0"}},
+		{".rela.data", []string{"0x0 R_X86_64_64 api.start+2"}},
+	} {
+		got := relocs(want.section)
+		if fmt.Sprint(got) != fmt.Sprint(want.relocs) {
+			t.Errorf("Expected %s to hold %v, got %v", want.section, want.relocs, got)
+		}
+	}
+}
@@ -0,0 +1,279 @@
+package gbasm
+
+import (
+	"bytes"
+	"debug/elf"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ELFObject is an ELF64 relocatable object (ET_REL), such as GNU as or a C
+// compiler writes, to be linked into an executable alongside .bo files.
+// Unlike a .bo file it is linked whole: each of its allocated sections is
+// placed as one block, with its global symbols at their offsets in it.
+type ELFObject struct {
+	Filename string
+	// Pkgname qualifies the object's symbols. A global symbol f is
+	// pkg.f in the link, unless its name is already qualified (contains
+	// a '.'), and an undefined symbol f refers to pkg.f likewise.
+	Pkgname  string
+	sections []*elfInputSection
+	symbols  []elfInputSymbol // by symbol table index
+}
+
+// elfInputSection is an allocated section of an ELFObject.
+type elfInputSection struct {
+	name string
+	// permission is F_EXEC, F_WRITE or F_READ, choosing the linked
+	// section the block is placed in.
+	permission int
+	align      int
+	val        []byte
+	// nobits marks an SHT_NOBITS section, which has no val. It is size
+	// zero bytes, placed in .bss.
+	nobits bool
+	size   uint64
+	relocs []elfInputReloc
+	// off is where the linker placed the block in its linked section.
+	off uint32
+}
+
+// elfInputSymbol is a symbol table entry of an ELFObject. A symbol with no
+// section is undefined, unless it is absolute.
+type elfInputSymbol struct {
+	name   string // qualified; empty for a local symbol
+	global bool
+	sect   *elfInputSection
+	abs    bool
+	value  uint64
+	size   uint64
+}
+
+type elfInputReloc struct {
+	offset uint64
+	sym    int
+	typ    elf.R_X86_64
+	addend int64
+}
+
+// IsELF reports whether filename is an ELF file.
+func IsELF(filename string) bool {
+	f, err := os.Open(filename)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+	var magic [4]byte
+	if _, err := io.ReadFull(f, magic[:]); err != nil {
+		return false
+	}
+	return string(magic[:]) == elf.ELFMAG
+}
+
+// ELFPackage returns the package an ELF object is linked as when none is
+// given: its file name without the extension.
+func ELFPackage(filename string) string {
+	base := filepath.Base(filename)
+	return strings.TrimSuffix(base, filepath.Ext(base))
+}
+
+// ReadELF reads the ELF64 x86-64 relocatable object in filename, to be
+// linked as package pkg.
+func ReadELF(filename, pkg string) (*ELFObject, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return readELF(f, filename, pkg)
+}
+
+func readELF(r io.ReaderAt, filename, pkg string) (*ELFObject, error) {
+	if pkg == "" {
+		return nil, fmt.Errorf("ELF object %s has no package name", filename)
+	}
+	ef, err := elf.NewFile(r)
+	if err != nil {
+		return nil, err
+	}
+	if ef.Class != elf.ELFCLASS64 || ef.Machine != elf.EM_X86_64 || ef.Type != elf.ET_REL {
+		return nil, fmt.Errorf("%s is not an x86-64 ELF64 relocatable object (%v %v %v)", filename, ef.Class, ef.Machine, ef.Type)
+	}
+	e := &ELFObject{Filename: filename, Pkgname: pkg}
+	bySection := make(map[int]*elfInputSection)
+	for i, s := range ef.Sections {
+		if s.Flags&elf.SHF_ALLOC == 0 {
+			continue
+		}
+		if s.Type != elf.SHT_PROGBITS && s.Type != elf.SHT_NOBITS {
+			return nil, fmt.Errorf("%s: Section %s has type %v; only PROGBITS and NOBITS sections can be linked", filename, s.Name, s.Type)
+		}
+		if s.Flags&elf.SHF_TLS != 0 {
+			return nil, fmt.Errorf("%s: Section %s holds thread-local storage, which cannot be linked", filename, s.Name)
+		}
+		sect := &elfInputSection{name: s.Name, permission: F_READ, align: int(s.Addralign)}
+		if s.Flags&elf.SHF_EXECINSTR != 0 {
+			sect.permission = F_EXEC
+		} else if s.Flags&elf.SHF_WRITE != 0 {
+			sect.permission = F_WRITE
+		}
+		if s.Type == elf.SHT_NOBITS {
+			sect.nobits = true
+			sect.size = s.Size
+		} else if sect.val, err = s.Data(); err != nil {
+			return nil, fmt.Errorf("%s: Failed to read section %s: %s", filename, s.Name, err)
+		}
+		bySection[i] = sect
+		e.sections = append(e.sections, sect)
+	}
+
+	syms, err := ef.Symbols()
+	if err != nil && err != elf.ErrNoSymbols {
+		return nil, fmt.Errorf("%s: Failed to read symbols: %s", filename, err)
+	}
+	// debug/elf leaves out the null symbol, which relocations number
+	// from.
+	e.symbols = make([]elfInputSymbol, len(syms)+1)
+	e.symbols[0].abs = true
+	for i, s := range syms {
+		sym := &e.symbols[i+1]
+		sym.value = s.Value
+		sym.size = s.Size
+		sym.global = elf.ST_BIND(s.Info) != elf.STB_LOCAL
+		switch {
+		case s.Section == elf.SHN_ABS:
+			sym.abs = true
+		case s.Section == elf.SHN_COMMON:
+			return nil, fmt.Errorf("%s: Symbol %s is a common symbol; compile with -fno-common", filename, s.Name)
+		case s.Section != elf.SHN_UNDEF && s.Section < elf.SHN_LORESERVE:
+			// Symbols of sections that are not linked, such as debug
+			// info, are never the target of a linked relocation.
+			sym.sect = bySection[int(s.Section)]
+		}
+		if sym.global || s.Section == elf.SHN_UNDEF {
+			sym.name = s.Name
+			if !isQualified(s.Name) {
+				sym.name = qualify(pkg, s.Name)
+			}
+		}
+	}
+
+	for _, s := range ef.Sections {
+		if s.Type == elf.SHT_REL {
+			return nil, fmt.Errorf("%s: Section %s holds REL relocations; only RELA is supported", filename, s.Name)
+		}
+		if s.Type != elf.SHT_RELA {
+			continue
+		}
+		sect := bySection[int(s.Info)]
+		if sect == nil {
+			continue
+		}
+		bs, err := s.Data()
+		if err != nil {
+			return nil, fmt.Errorf("%s: Failed to read section %s: %s", filename, s.Name, err)
+		}
+		rela := make([]elf.Rela64, len(bs)/Elf64_RelaSize)
+		if err := binary.Read(bytes.NewReader(bs), binary.LittleEndian, rela); err != nil {
+			return nil, fmt.Errorf("%s: Failed to read section %s: %s", filename, s.Name, err)
+		}
+		for _, r := range rela {
+			rel := elfInputReloc{
+				offset: r.Off,
+				sym:    int(elf.R_SYM64(r.Info)),
+				typ:    elf.R_X86_64(elf.R_TYPE64(r.Info)),
+				addend: r.Addend,
+			}
+			switch rel.typ {
+			case elf.R_X86_64_PC32, elf.R_X86_64_PLT32, elf.R_X86_64_64, elf.R_X86_64_32S:
+			default:
+				return nil, fmt.Errorf("%s: Relocation %v in section %s is not supported; expected PC32, PLT32, 64 or 32S", filename, rel.typ, sect.name)
+			}
+			if rel.sym >= len(e.symbols) || r.Off+rel.size() > uint64(len(sect.val)) {
+				return nil, fmt.Errorf("%s: Malformed relocation in section %s at %#x", filename, sect.name, r.Off)
+			}
+			if sym := e.symbols[rel.sym]; sym.sect == nil && !sym.abs && sym.name == "" {
+				return nil, fmt.Errorf("%s: Relocation in section %s at %#x refers to a section that is not linked", filename, sect.name, r.Off)
+			}
+			sect.relocs = append(sect.relocs, rel)
+		}
+	}
+	return e, nil
+}
+
+// size is the size of the field r patches.
+func (r elfInputReloc) size() uint64 {
+	if r.typ == elf.R_X86_64_64 {
+		return 8
+	}
+	return 4
+}
+
+// apply patches the field of r in bs, the section being relocated, for a
+// target symbol at address target and a field at address place.
+func (r elfInputReloc) apply(bs []byte, target, place uint64) error {
+	v := int64(target) + r.addend
+	switch r.typ {
+	case elf.R_X86_64_64:
+		binary.LittleEndian.PutUint64(bs[r.offset:], uint64(v))
+		return nil
+	case elf.R_X86_64_PC32, elf.R_X86_64_PLT32:
+		// Every symbol is linked into the executable, so a call through
+		// the PLT is a direct call.
+		v -= int64(place)
+	}
+	if v != int64(int32(v)) {
+		return fmt.Errorf("%v relocation at %#x does not fit in 32 bits", r.typ, r.offset)
+	}
+	binary.LittleEndian.PutUint32(bs[r.offset:], uint32(v))
+	return nil
+}
+
+// absRelocs returns the number of R_X86_64_64 relocations of e against
+// symbols that are not absolute, each of which a position-independent link
+// records for _init.start to apply.
+func (e *ELFObject) absRelocs() int {
+	n := 0
+	for _, sect := range e.sections {
+		for _, r := range sect.relocs {
+			if r.typ == elf.R_X86_64_64 && !e.symbols[r.sym].abs {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+// defines returns the qualified names of the global symbols e defines.
+func (e *ELFObject) defines() map[string]*elfInputSymbol {
+	defs := make(map[string]*elfInputSymbol)
+	for i := range e.symbols {
+		s := &e.symbols[i]
+		if s.global && (s.sect != nil || s.abs) {
+			defs[s.name] = s
+		}
+	}
+	return defs
+}
+
+// undefined returns the qualified names of the symbols e's relocations
+// refer to without defining, in the order they are first referred to.
+func (e *ELFObject) undefined() []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, sect := range e.sections {
+		for _, r := range sect.relocs {
+			s := e.symbols[r.sym]
+			if s.sect != nil || s.abs || seen[s.name] {
+				continue
+			}
+			seen[s.name] = true
+			names = append(names, s.name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,181 @@
+package gbasm
+
+import (
+	"bytes"
+	"debug/elf"
+	"encoding/binary"
+	"testing"
+)
+
+func TestLinkELF(t *testing.T) {
+	// The ELF object is written by WriteELF, from package cm.
+	c, err := NewOFile("cm.o", "cm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.AddVar("count", "i64", uint64(7), false); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.AddVar("self", "*i64", uint64(0), false); err != nil {
+		t.Fatal(err)
+	}
+	c.Vars["self"].Relocs = []DataReloc{{Offset: 0, Symbol: "count"}}
+	if err := c.AddZeroVar("buf", "byte[4096]", 4096, false); err != nil {
+		t.Fatal(err)
+	}
+	get, err := c.NewFunction("cm.s", 1, "get")
+	if err != nil {
+		t.Fatal(err)
+	}
+	get.Instr("LEA", R_RAX, c.Vars["count"])
+	get.Instr("LEA", R_RAX, c.Vars["buf"])
+	get.Jump("CALL", "_init.hook")
+	get.Instr("RET")
+	var b bytes.Buffer
+	if err := c.WriteELF(&b); err != nil {
+		t.Fatal(err)
+	}
+	e, err := readELF(bytes.NewReader(b.Bytes()), "cm.o", "cm")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	o, err := NewOFile("init", "_init")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := o.AddVar("p", "*i64", uint64(0), false); err != nil {
+		t.Fatal(err)
+	}
+	o.Vars["p"].Relocs = []DataReloc{{Offset: 0, Symbol: "cm.count", Addend: 8}}
+	start, err := o.NewFunction("init.bs", 1, "start")
+	if err != nil {
+		t.Fatal(err)
+	}
+	start.Jump("CALL", "cm.get")
+	start.Instr("LEA", R_RAX, o.Vars["p"])
+	start.Instr("RET")
+	hook, err := o.NewFunction("init.bs", 4, "hook")
+	if err != nil {
+		t.Fatal(err)
+	}
+	hook.Instr("RET")
+	for _, f := range []*Function{get, start, hook} {
+		if err := f.Resolve(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	bin, err := link([]*OFile{o}, nil, []*ELFObject{e}, ENTRY_ADDR, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := make(map[string]uint64)
+	sects := make(map[string]*Section)
+	for _, s := range bin.Sections {
+		for _, sym := range s.symbols {
+			addr[sym.Name] = sym.Address
+			sects[sym.Name] = s
+		}
+	}
+	for _, name := range []string{"cm.get", "cm.count", "cm.self", "cm.buf"} {
+		if _, ok := addr[name]; !ok {
+			t.Fatalf("Expected the ELF symbol %s to be placed, got %v", name, addr)
+		}
+	}
+	if addr["_init.start"] != ENTRY_ADDR || sects["cm.get"].Name != ".text" || sects["cm.buf"].Name != ".bss" {
+		t.Errorf("Expected _init.start at the entry point, cm.get in .text and cm.buf in .bss, got %#x, %s and %s",
+			addr["_init.start"], sects["cm.get"].Name, sects["cm.buf"].Name)
+	}
+	// rel32 returns the target of the PC-relative field f relocates
+	// against sym.
+	rel32 := func(f *Function, sym string) uint64 {
+		fn := qualify(f.Pkgname, f.Name)
+		for _, r := range f.Relocations {
+			if r.Symbol == sym {
+				s := sects[fn]
+				at := addr[fn] + uint64(r.Offset)
+				return at + 4 + uint64(int32(binary.LittleEndian.Uint32(s.val[at-s.Offset:])))
+			}
+		}
+		t.Fatalf("%s has no relocation against %s", fn, sym)
+		return 0
+	}
+	word := func(name string) uint64 {
+		s := sects[name]
+		return binary.LittleEndian.Uint64(s.val[addr[name]-s.Offset:])
+	}
+	for _, tt := range []struct {
+		what      string
+		got, want uint64
+	}{
+		{"_init.start calls cm.get", rel32(start, "cm.get"), addr["cm.get"]},
+		{"cm.get loads cm.count", rel32(get, "cm.count"), addr["cm.count"]},
+		{"cm.get loads cm.buf", rel32(get, "cm.buf"), addr["cm.buf"]},
+		{"cm.get calls _init.hook", rel32(get, "_init.hook"), addr["_init.hook"]},
+		{"cm.self points to cm.count", word("cm.self"), addr["cm.count"]},
+		{"_init.p points past cm.count", word("_init.p"), addr["cm.count"] + 8},
+		{"cm.count keeps its value", word("cm.count"), 7},
+	} {
+		if tt.got != tt.want {
+			t.Errorf("Expected %s: want %#x, got %#x", tt.what, tt.want, tt.got)
+		}
+	}
+}
+
+func TestELFRelocApply(t *testing.T) {
+	for _, tt := range []struct {
+		typ    elf.R_X86_64
+		addend int64
+		want   []byte
+		fails  bool
+	}{
+		{elf.R_X86_64_64, 2, []byte{0x02, 0x20, 0, 0, 0, 0, 0, 0}, false},
+		{elf.R_X86_64_PC32, -4, []byte{0xfc, 0x0f, 0, 0}, false},
+		{elf.R_X86_64_PLT32, -4, []byte{0xfc, 0x0f, 0, 0}, false},
+		{elf.R_X86_64_32S, -0x2001, []byte{0xff, 0xff, 0xff, 0xff}, false},
+		{elf.R_X86_64_32S, 1 << 31, nil, true},
+		{elf.R_X86_64_PC32, -1 << 32, nil, true},
+	} {
+		bs := make([]byte, 8)
+		r := elfInputReloc{typ: tt.typ, addend: tt.addend}
+		err := r.apply(bs, 0x2000, 0x1000)
+		if (err != nil) != tt.fails {
+			t.Errorf("%v%+d: expected failure %v, got %v", tt.typ, tt.addend, tt.fails, err)
+			continue
+		}
+		if !tt.fails && !bytes.Equal(bs[:len(tt.want)], tt.want) {
+			t.Errorf("%v%+d: expected %x, got %x", tt.typ, tt.addend, tt.want, bs)
+		}
+	}
+}
+
+func TestReadELFRejects(t *testing.T) {
+	c, err := NewOFile("cm.o", "cm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := c.NewFunction("cm.s", 1, "f")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Jump("CALL", "other.g")
+	var b bytes.Buffer
+	if err := c.WriteELF(&b); err != nil {
+		t.Fatal(err)
+	}
+	bs := b.Bytes()
+	if _, err := readELF(bytes.NewReader(bs), "cm.o", ""); err == nil {
+		t.Error("Expected an ELF object without a package to be rejected")
+	}
+	// Make the call a GOT-relative relocation.
+	ef, err := elf.NewFile(bytes.NewReader(bs))
+	if err != nil {
+		t.Fatal(err)
+	}
+	info := ef.Section(".rela.text").Offset + 8
+	bs[info] = byte(elf.R_X86_64_GOTPCREL)
+	if _, err := readELF(bytes.NewReader(bs), "cm.o", "cm"); err == nil {
+		t.Error("Expected a GOTPCREL relocation to be rejected")
+	}
+}
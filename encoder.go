@@ -2,7 +2,6 @@ package gbasm
 
 import (
 	"bytes"
-	_ "embed"
 	"encoding/binary"
 	"errors"
 	"fmt"
@@ -10,6 +9,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
 )
 
 const (
@@ -82,12 +82,137 @@ func (i Indirect) String() string {
 type IndirectBaseIndexScale struct {
 	Base  Register
 	Index Register
-	Scale int // must be 1 2 4 or 8
+	Scale int   // must be 1 2 4 or 8
+	Off   int32 // displacement added to Base + Index*Scale
 }
 
 type Instruction struct {
 	Summary string
 	Forms   []IForm
+
+	// index maps the shape of an operand list to the forms that could
+	// possibly match it, in their original order. It is nil for
+	// Instructions built by ParseFile.
+	index map[shape][]int
+}
+
+// opClass is a coarse classification of an operand, fine enough to rule out
+// most forms without calling Op.Match.
+type opClass uint8
+
+const (
+	classNone opClass = iota // no operand in this position
+	classImm
+	classMem
+	classAlloc
+	classReg8
+	classReg16
+	classReg32
+	classReg64
+	classXMM
+	classOther
+)
+
+// shape is the key of Instruction.index: the operand count and the classes
+// of the first two operands.
+type shape struct {
+	n      int
+	c0, c1 opClass
+}
+
+func classOf(o interface{}) opClass {
+	switch t := o.(type) {
+	case uint8, int8, uint16, int16, uint32, int32, uint64, int64:
+		return classImm
+	case Indirect, IndirectBaseIndexScale, *Var:
+		return classMem
+	case *Ralloc:
+		return classAlloc
+	case Register:
+		switch t.Width() {
+		case 8:
+			return classReg8
+		case 16:
+			return classReg16
+		case 32:
+			return classReg32
+		case 64:
+			return classReg64
+		case 128:
+			return classXMM
+		}
+	}
+	return classOther
+}
+
+// classes returns every operand class Op.Match could accept for o.
+func (o *Op) classes() []opClass {
+	switch o.TN {
+	case "imm8", "imm16", "imm32", "imm64", "rel8", "rel32":
+		return []opClass{classImm}
+	case "r8":
+		return []opClass{classReg8, classAlloc}
+	case "r16":
+		return []opClass{classReg16, classAlloc}
+	case "r32":
+		return []opClass{classReg32, classAlloc}
+	case "r64":
+		return []opClass{classReg64, classAlloc}
+	case "xmm":
+		return []opClass{classXMM, classAlloc}
+	case "m128":
+		return []opClass{classMem}
+	case "m", "m8", "m16", "m32", "m64":
+		return []opClass{classMem, classAlloc}
+	case "moffs32", "moffs64":
+		return []opClass{classMem}
+	}
+	return nil
+}
+
+func (i *Instruction) buildIndex() {
+	i.index = make(map[shape][]int)
+	for fi := range i.Forms {
+		f := &i.Forms[fi]
+		var explicit []Op
+		for _, op := range f.ops {
+			if !op.Implicit {
+				explicit = append(explicit, op)
+			}
+		}
+		c0s, c1s := []opClass{classNone}, []opClass{classNone}
+		if len(explicit) > 0 {
+			c0s = explicit[0].classes()
+		}
+		if len(explicit) > 1 {
+			c1s = explicit[1].classes()
+		}
+		for _, c0 := range c0s {
+			for _, c1 := range c1s {
+				k := shape{n: f.opcount, c0: c0, c1: c1}
+				i.index[k] = append(i.index[k], fi)
+			}
+		}
+	}
+}
+
+// candidates returns the indices of the forms that could match os.
+func (i *Instruction) candidates(os []interface{}) []int {
+	if i.index == nil {
+		all := make([]int, len(i.Forms))
+		for fi := range all {
+			all[fi] = fi
+		}
+		return all
+	}
+	k := shape{n: len(os)}
+	if len(os) > 0 {
+		k.c0 = classOf(os[0])
+	}
+	if len(os) > 1 {
+		k.c1 = classOf(os[1])
+	}
+	return i.index[k]
 }
 
 var indent int
@@ -101,7 +226,8 @@ func (i *Instruction) Encode(w WriteLener, formname string, os ...interface{}) (
 	// }
 	//fmt.Printf("Instruction.Encode OS: %#v\n", os)
 forms:
-	for _, f := range i.Forms {
+	for _, fi := range i.candidates(os) {
+		f := &i.Forms[fi]
 		// if formname == "movq" {
 		// 	fmt.Printf("Encoding movq\n")
 		// }
@@ -408,12 +534,10 @@ type opcode struct {
 func getByte(i byte, os []interface{}) (byte, error) {
 	if int(i) >= len(os) {
 		panic(fmt.Sprintf("booo I: %d, OS: %#v, len(os): %d\n", i, os, len(os)))
-		return 0, fmt.Errorf("[getByte] Not enough args. Expected at least %d\n", i)
 	}
 	b, ok := os[i].(byte)
 	if !ok {
 		panic("BYTE")
-		return 0, fmt.Errorf("Expected op %d to be a byte, but found %v\n", i, reflect.TypeOf(os[i]))
 	}
 	return b, nil
 }
@@ -421,7 +545,6 @@ func getByte(i byte, os []interface{}) (byte, error) {
 func REX_X(i byte, os []interface{}) (Register, error) {
 	if int(i) >= len(os) {
 		panic(fmt.Sprintf("booo I: %d, OS: %#v, len(os): %d\n", i, os, len(os)))
-		return 0, fmt.Errorf("[getByte] Not enough args. Expected at least %d\n", i)
 	}
 	switch b := os[i].(type) {
 	case Register:
@@ -445,7 +568,6 @@ func REX_X(i byte, os []interface{}) (Register, error) {
 func getRegister(i byte, os []interface{}) (Register, error) {
 	if int(i) >= len(os) {
 		panic(fmt.Sprintf("booo I: %d, OS: %#v, len(os): %d\n", i, os, len(os)))
-		return 0, fmt.Errorf("[getByte] Not enough args. Expected at least %d\n", i)
 	}
 	switch b := os[i].(type) {
 	case Register:
@@ -505,7 +627,6 @@ func (x *modrm) Encode(w WriteLener, os ...interface{}) ([]Relocation, error) {
 	} else {
 		if int(x.mod) >= len(os) {
 			panic("SHOULD NOT HAPPEN. The panic is here to catch any potential instances of this inequality.")
-			return nil, fmt.Errorf("[getByte] Not enough args. Expected at least %d\n", x.mod)
 		}
 		o := os[x.mod]
 		switch ot := o.(type) {
@@ -611,17 +732,25 @@ func (x *modrm) Encode(w WriteLener, os ...interface{}) ([]Relocation, error) {
 				(xrm.byte() & 0b111)
 			return relocations, writeByte(w, b)
 		}
-		return relocations, nil
 	} else {
 		disp8 := indirectbis != nil &&
 			(indirectbis.Base == R_RBP || indirectbis.Base == R13)
 		if disp8 {
-			disp8 = true
-			// We could also use 0b10 here if we wanted a 32-bit displacement, but
-			// currently the assembler doesn't support displacements, so the
-			// smaller the better.
+			// A base of RBP or R13 always needs a displacement. A zero disp8
+			// is the smallest way to encode one.
 			xmod = 0b01
 		}
+		var disp32 bool
+		if indirectbis != nil && indirectbis.Off != 0 {
+			if indirectbis.Off >= -128 && indirectbis.Off <= 127 {
+				disp8 = true
+				xmod = 0b01
+			} else {
+				disp8 = false
+				disp32 = true
+				xmod = 0b10
+			}
+		}
 		var xrm byte = 0b100
 		b := ((xmod & 0b11) << 6) |
 			((xreg & 0b111) << 3) |
@@ -665,9 +794,12 @@ func (x *modrm) Encode(w WriteLener, os ...interface{}) ([]Relocation, error) {
 		}
 
 		if disp8 {
-			// The displacement is currently unused, but must be present for
-			// certain registers (RBP, R13).
-			binary.Write(w, binary.LittleEndian, byte(0x0))
+			// The displacement must be present for certain registers (RBP,
+			// R13) even when it is zero.
+			return relocations, writeByte(w, byte(int8(indirectbis.Off)))
+		}
+		if disp32 {
+			return relocations, binary.Write(w, binary.LittleEndian, indirectbis.Off)
 		}
 
 		return relocations, nil
@@ -708,6 +840,12 @@ func (o *Op) ConvertRalloc(a *Ralloc) interface{} {
 			panic(fmt.Sprintf("r8 OOF. Expected %s to be %d wide, but register %v was %d", a.sym, 8, r, r.Width()))
 		}
 		return r
+	case "xmm":
+		r := a.Register()
+		if !r.isXMM() {
+			panic(fmt.Sprintf("xmm OOF. Expected %s to be in an xmm register, but it is in %v", a.sym, r))
+		}
+		return r
 	case "m64", "m32", "m16", "m8", "m":
 		return a.Indirect()
 	}
@@ -809,7 +947,7 @@ func (o *Op) Match(op interface{}, largestOpBits int) (bool, int) {
 	//case "cl":
 	case "r8":
 		if ra, ok := op.(*Ralloc); ok {
-			return !ra.volatile && ra.RegSize() == 8, 8
+			return !ra.volatile && ra.class == ClassGP && ra.RegSize() == 8, 8
 		}
 		if r, ok := op.(Register); ok {
 			//return r == R_AL || r == R_AH || r == R_BL || r == R_BH || r == R_CL || r == R_CH || r == R_DL || r == R_DH
@@ -819,7 +957,7 @@ func (o *Op) Match(op interface{}, largestOpBits int) (bool, int) {
 	//case "ax":
 	case "r16":
 		if ra, ok := op.(*Ralloc); ok {
-			return !ra.volatile && ra.RegSize() == 16, 16
+			return !ra.volatile && ra.class == ClassGP && ra.RegSize() == 16, 16
 		}
 		if r, ok := op.(Register); ok {
 			//return r == R_AX || r == R_BX || r == R_CX || r == R_DX || r == R_SP || r == R_BP || r == R_SI || r == R_DI
@@ -829,7 +967,7 @@ func (o *Op) Match(op interface{}, largestOpBits int) (bool, int) {
 	//case "eax":
 	case "r32":
 		if ra, ok := op.(*Ralloc); ok {
-			return !ra.volatile && ra.RegSize() == 32, 32
+			return !ra.volatile && ra.class == ClassGP && ra.RegSize() == 32, 32
 		}
 		if r, ok := op.(Register); ok {
 			//return r == R_EAX || r == R_EBX || r == R_ECX || r == R_EDX || r == R_ESP || r == R_EBP || r == R_ESI || r == R_EDI
@@ -839,7 +977,7 @@ func (o *Op) Match(op interface{}, largestOpBits int) (bool, int) {
 	//case "rax":
 	case "r64":
 		if ra, ok := op.(*Ralloc); ok {
-			return !ra.volatile && ra.RegSize() == 64, 64
+			return !ra.volatile && ra.class == ClassGP && ra.RegSize() == 64, 64
 		}
 		if r, ok := op.(Register); ok {
 			//return r == R_RAX || r == R_RBX || r == R_RCX || r == R_RDX || r == R_RSP || r == R_RBP || r == R_RSI || r == R_RDI ||
@@ -848,7 +986,15 @@ func (o *Op) Match(op interface{}, largestOpBits int) (bool, int) {
 		}
 		// 	case "mm":
 		// 	case "xmm0":
-		// 	case "xmm":
+	case "xmm":
+		// SSE immediates are control bytes rather than sign-extended
+		// values, so xmm operands place no limit on their width.
+		if ra, ok := op.(*Ralloc); ok {
+			return !ra.volatile && ra.class == ClassXMM, 0
+		}
+		if r, ok := op.(Register); ok {
+			return r.isXMM(), 0
+		}
 		// 	case "xmm{k}":
 		// 	case "xmm{k}{z}":
 		// 	case "ymm":
@@ -983,6 +1129,22 @@ func (o *Op) Match(op interface{}, largestOpBits int) (bool, int) {
 	//case "m64{k}":
 	//case "m64{k}{z}":
 	case "m128":
+		// Stack slots are not 16-byte aligned, so allocations never match.
+		if mo, ok := op.(Indirect); ok {
+			if mo.Size > 0 && mo.Size != 128 {
+				return false, 0
+			}
+			if mo.Symbol != "" {
+				return true, 0
+			}
+			return mo.Reg.Width() == 64, 0
+		}
+		if mo, ok := op.(IndirectBaseIndexScale); ok {
+			return mo.Base.Width() == 64, 0
+		}
+		if _, ok := op.(*Var); ok {
+			return true, 0
+		}
 	//case "m128{k}{z}":
 	case "m256":
 	//case "m256{k}{z}":
@@ -1028,6 +1190,23 @@ type IForm struct {
 	enc     [][]Encoder
 }
 
+// String returns the form's name and operand types, e.g. "ADDQ r64, imm8".
+// Implicit operands are braced.
+func (f *IForm) String() string {
+	var ops []string
+	for _, op := range f.ops {
+		if op.Implicit {
+			ops = append(ops, "{"+op.TN+"}")
+		} else {
+			ops = append(ops, op.TN)
+		}
+	}
+	if len(ops) == 0 {
+		return f.name
+	}
+	return f.name + " " + strings.Join(ops, ", ")
+}
+
 func (f *IForm) Encode(w WriteLener, os ...interface{}) ([]Relocation, error) {
 	// fmt.Printf("IForm.Encode(%s):", f.name)
 	// defer fmt.Printf("RETURN IForm.Encode(%s)\n", f.name)
@@ -1059,6 +1238,9 @@ func (f *IForm) Encode(w WriteLener, os ...interface{}) ([]Relocation, error) {
 			os[i] = op.ConvertRalloc(ra)
 		}
 	}
+	if l, ok := w.(formListener); ok {
+		l.encodingForm(f, os)
+	}
 
 	// fmt.Printf("IForm.Encode(%s):", f.name)
 	// defer fmt.Printf("RETURN IForm.Encode(%s)\n", f.name)
@@ -1132,6 +1314,9 @@ type Asm struct {
 	ArchName  string
 	instrs    map[string]*Instruction
 	specforms map[string]*Instruction
+
+	decodeOnce sync.Once
+	decode     *decodeTable
 }
 
 func (a *Asm) Encode(w WriteLener, instr string, os ...interface{}) ([]Relocation, error) {
@@ -1434,6 +1619,10 @@ func parseForm(xform *XForm) (IForm, error) {
 	return f, nil
 }
 
+// ParseFile builds an Asm directly from an instruction set XML file such as
+// x86_64.xml. The result is not indexed, so Encode tries every form of an
+// instruction in order. LoadAsm should be preferred; ParseFile is the
+// reference the generated tables are checked against.
 func ParseFile(fname string) (*Asm, error) {
 	xis, err := DecodeFile(fname)
 	if err != nil {
@@ -1448,44 +1637,12 @@ func ParseFile(fname string) (*Asm, error) {
 	for _, xi := range xis.XInstructions {
 		instr := &Instruction{Summary: xi.Summary}
 		a.instrs[xi.Name] = instr
-		//log.Printf("INSTR: %s", xi.Name)
-
 		for _, xform := range xi.Forms {
 			f, err := parseForm(xform)
 			if err != nil {
 				//log.Printf("Failed to parse a form of %s: %s", xi.Name, err)
 				continue
 			}
-			instr.Forms = append(instr.Forms, f)
-		}
-	}
-	return a, nil
-}
-
-func parse(r io.Reader) (*Asm, error) {
-	xis, err := decode(r)
-	if err != nil {
-		return nil, err
-	}
-
-	a := &Asm{
-		ArchName:  xis.Name,
-		instrs:    make(map[string]*Instruction),
-		specforms: make(map[string]*Instruction),
-	}
-	for _, xi := range xis.XInstructions {
-		instr := &Instruction{Summary: xi.Summary}
-		a.instrs[xi.Name] = instr
-		//log.Printf("INSTR: %s", xi.Name)
-		for _, xform := range xi.Forms {
-			f, err := parseForm(xform)
-			// if xi.Name == "PUSH" {
-			// 	fmt.Printf("FORM: %#v\n", f)
-			// }
-			if err != nil {
-				//log.Printf("Failed to parse a form of %s: %s", xi.Name, err)
-				continue
-			}
 			a.specforms[f.name] = instr
 			instr.Forms = append(instr.Forms, f)
 		}
@@ -1499,13 +1656,45 @@ const (
 	AMD64 Arch = iota
 )
 
-//go:embed x86_64.xml
-var amd64 []byte
+//go:generate go test -run TestInstructionTable -regen
+
+var (
+	amd64Once      sync.Once
+	amd64Instrs    map[string]*Instruction
+	amd64Specforms map[string]*Instruction
+)
+
+// loadAMD64 indexes the generated amd64Table. The Instructions are shared by
+// every Asm LoadAsm returns; nothing modifies them after this point.
+func loadAMD64() {
+	amd64Instrs = make(map[string]*Instruction, len(amd64Table))
+	amd64Specforms = make(map[string]*Instruction)
+	for i := range amd64Table {
+		instr := &amd64Table[i].instr
+		instr.buildIndex()
+		amd64Instrs[amd64Table[i].name] = instr
+		for _, f := range instr.Forms {
+			amd64Specforms[f.name] = instr
+		}
+	}
+}
+
+// IsInstruction reports whether name, in any case, is the mnemonic of an
+// instruction a encodes.
+func (a *Asm) IsInstruction(name string) bool {
+	_, ok := a.instrs[strings.ToUpper(name)]
+	return ok
+}
 
 func LoadAsm(a Arch) (*Asm, error) {
 	switch a {
 	case AMD64:
-		return parse(bytes.NewBuffer(amd64))
+		amd64Once.Do(loadAMD64)
+		return &Asm{
+			ArchName:  amd64Arch,
+			instrs:    amd64Instrs,
+			specforms: amd64Specforms,
+		}, nil
 	default:
 		return nil, fmt.Errorf("No such achitecture")
 	}
@@ -0,0 +1,152 @@
+package gbasm
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+)
+
+// ComputeExportHash returns a hash of what o exports: everything an
+// importer compiles against, and nothing else. That is the name, type and
+// return aliases of each pub function, the name, type and constness of
+// each pub var and data block, and each pub struct, type alias, interface
+// and values type. Changing a function body, a private declaration or a source
+// position leaves the hash as it was, so a build can skip recompiling the
+// packages importing o when o's hash has not changed.
+func (o *OFile) ComputeExportHash() string {
+	h := sha256.New()
+	w := func(s string) {
+		writeString(h, s)
+	}
+	n := func(i int) {
+		writeSize(h, i)
+	}
+	ints := func(is [][]int) {
+		n(len(is))
+		for _, slot := range is {
+			n(len(slot))
+			for _, i := range slot {
+				n(i)
+			}
+		}
+	}
+	strs := func(ss []string) {
+		n(len(ss))
+		for _, s := range ss {
+			w(s)
+		}
+	}
+	fields := func(fs []FieldShape) {
+		n(len(fs))
+		for _, f := range fs {
+			w(f.Name)
+			w(f.Type)
+		}
+	}
+
+	w(o.Pkgname)
+	w("funcs")
+	for _, name := range sortedKeys(o.Funcs) {
+		if f := o.Funcs[name]; f.IsPub {
+			w(name)
+			w(f.Type)
+			ints(f.ReturnAliases)
+		}
+	}
+	for _, sec := range []struct {
+		tag string
+		vs  map[string]*Var
+	}{{"vars", o.Vars}, {"data", o.Data}} {
+		w(sec.tag)
+		for _, name := range sortedKeys(sec.vs) {
+			if v := sec.vs[name]; v.IsPub {
+				w(name)
+				w(v.VType)
+				w(fmt.Sprint(v.IsConst))
+			}
+		}
+	}
+	w("structs")
+	for _, name := range sortedKeys(o.Structs) {
+		if s := o.Structs[name]; s.IsPub {
+			w(name)
+			fields(s.Fields)
+			strs(s.MethodNames)
+		}
+	}
+	w("typealiases")
+	for _, name := range sortedKeys(o.TypeAliases) {
+		if a := o.TypeAliases[name]; a.IsPub {
+			w(name)
+			w(a.Underlying)
+			strs(a.MethodNames)
+		}
+	}
+	w("interfaces")
+	for _, name := range sortedKeys(o.Interfaces) {
+		if i := o.Interfaces[name]; i.IsPub {
+			w(name)
+			n(len(i.Methods))
+			for _, m := range i.Methods {
+				w(m.Name)
+				fields(m.Params)
+				w(m.Return)
+				ints(m.ReturnAliases)
+			}
+		}
+	}
+	w("values")
+	for _, name := range sortedKeys(o.Values) {
+		if v := o.Values[name]; v.IsPub {
+			w(name)
+			w(v.TagType)
+			n(len(v.Cases))
+			for _, c := range v.Cases {
+				w(c.Name)
+				w(fmt.Sprint(c.Tag))
+			}
+			n(len(v.Projections))
+			for _, p := range v.Projections {
+				w(p.TargetType)
+			}
+			strs(v.MethodNames)
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// writeImportHashes writes the export hashes o was compiled against, by
+// package.
+func writeImportHashes(w io.Writer, hashes map[string]string) error {
+	if err := writeSize(w, len(hashes)); err != nil {
+		return err
+	}
+	for _, pkg := range sortedKeys(hashes) {
+		if err := writeString(w, pkg); err != nil {
+			return err
+		}
+		if err := writeString(w, hashes[pkg]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readImportHashes(r io.Reader) (map[string]string, error) {
+	size, err := readSize(r)
+	if err != nil {
+		return nil, err
+	}
+	hashes := make(map[string]string)
+	for i := 0; i < size; i++ {
+		pkg, err := readString(r)
+		if err != nil {
+			return nil, err
+		}
+		if hashes[pkg], err = readString(r); err != nil {
+			return nil, err
+		}
+	}
+	return hashes, nil
+}
@@ -0,0 +1,87 @@
+package gbasm
+
+import (
+	"bytes"
+	"testing"
+)
+
+// exportHashTestFile returns a package api exporting a function, a var and
+// a struct, once edit has changed it, and its .bo.
+func exportHashTestFile(t *testing.T, edit func(o *OFile)) (*OFile, []byte) {
+	t.Helper()
+	return testObject(t, "api", func(o *OFile) {
+		f, err := o.NewFunction("api.bs", 1, "f")
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.IsPub = true
+		f.Type = "fn f(x i64) i64"
+		f.Instr("MOV", R_RAX, R_RDI)
+		f.Instr("RET")
+		if err := o.AddVar("count", "i64", uint64(0), true); err != nil {
+			t.Fatal(err)
+		}
+		if err := o.AddStruct("point", []FieldShape{{"x", "i64"}, {"y", "i64"}}, nil, true); err != nil {
+			t.Fatal(err)
+		}
+		edit(o)
+	})
+}
+
+func TestExportHash(t *testing.T) {
+	o, _ := exportHashTestFile(t, func(*OFile) {})
+	base := o.ComputeExportHash()
+	for _, tt := range []struct {
+		name    string
+		edit    func(o *OFile)
+		changes bool
+	}{
+		{"Body", func(o *OFile) { o.Funcs["f"].Instr("NOP") }, false},
+		{"Position", func(o *OFile) { o.Funcs["f"].SrcLine = 10 }, false},
+		{"VarValue", func(o *OFile) { o.Vars["count"].Val[0] = 1 }, false},
+		{"Private", func(o *OFile) {
+			o.NewFunction("api.bs", 5, "helper")
+			o.AddStruct("hidden", []FieldShape{{"a", "i64"}}, nil, false)
+		}, false},
+		{"FuncType", func(o *OFile) { o.Funcs["f"].Type = "fn f(x i64) i32" }, true},
+		{"ReturnAliases", func(o *OFile) { o.Funcs["f"].ReturnAliases = [][]int{{0}} }, true},
+		{"VarType", func(o *OFile) { o.Vars["count"].VType = "i32" }, true},
+		{"VarConst", func(o *OFile) { o.Vars["count"].IsConst = true }, true},
+		{"StructField", func(o *OFile) { o.Structs["point"].Fields[1].Type = "i32" }, true},
+		{"PubValues", func(o *OFile) {
+			o.AddValues("color", "i64", []ValuesCaseShape{{"RED", 0}}, nil, nil, true)
+		}, true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			o, _ := exportHashTestFile(t, tt.edit)
+			got := o.ComputeExportHash()
+			if (got != base) != tt.changes {
+				t.Errorf("Expected the hash to change: %v; got %s, was %s", tt.changes, got, base)
+			}
+		})
+	}
+}
+
+func TestExportHashRoundTrip(t *testing.T) {
+	o, bs := exportHashTestFile(t, func(o *OFile) {
+		if err := o.AddImportHash("io", "abc"); err != nil {
+			t.Fatal(err)
+		}
+		if err := o.AddImportHash("io", "abc"); err != nil {
+			t.Fatal(err)
+		}
+		if err := o.AddImportHash("io", "def"); err == nil {
+			t.Error("Expected a second hash for io to be rejected")
+		}
+	})
+	got, err := readOFile(bytes.NewReader(bs))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.ExportHash != o.ComputeExportHash() || got.ExportHash != got.ComputeExportHash() {
+		t.Errorf("Expected export hash %s, got %s", o.ComputeExportHash(), got.ExportHash)
+	}
+	if len(got.ImportHashes) != 1 || got.ImportHashes["io"] != "abc" {
+		t.Errorf("Expected import hashes {io: abc}, got %v", got.ImportHashes)
+	}
+}
@@ -0,0 +1,228 @@
+package gbasm
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// calleeSaved are the registers a function must preserve for its caller,
+// other than RBP and RSP, which the frame itself restores.
+var calleeSaved = []Register{R_RBX, R12, R13, R14, R15}
+
+// The longest a prologue or epilogue can be: PUSH RBP, MOV RBP, RSP and
+// SUB RSP, imm32, then a 7-byte MOV to a disp32 slot for each callee-saved
+// register; and those MOVs back, MOV RSP, RBP and POP RBP.
+const (
+	maxPrologue = 1 + 3 + 7 + 7*5
+	maxEpilogue = 7*5 + 3 + 1
+)
+
+// A framePart is a prologue or epilogue. Until Resolve knows which
+// registers the body uses, it is NOPs as long as the largest frame code it
+// could need; relax then shrinks it to the code that is needed.
+type framePart struct {
+	start    int // offset of the part in f.bs
+	len      int // length of the placeholder
+	epilogue bool
+	entry    int // index of the part's ListEntry, or -1
+	code     *frameWriter
+}
+
+// frame emits the placeholder for a prologue or epilogue.
+func (f *Function) frame(epilogue bool) {
+	f.seq++
+	f.flush()
+	f.notePlaced()
+	p := framePart{start: f.bs.Len(), len: maxPrologue, epilogue: epilogue, entry: -1}
+	form := "prologue"
+	if epilogue {
+		p.len = maxEpilogue
+		form = "epilogue"
+	}
+	if f.listing != nil {
+		p.entry = len(f.listing)
+		f.listing = append(f.listing, ListEntry{
+			Offset: p.start,
+			Len:    p.len,
+			Form:   form,
+			Source: f.listSource,
+		})
+	}
+	f.bs.Write(appendNops(nil, p.len))
+	f.frames = append(f.frames, p)
+}
+
+// stackForms are the instruction forms that move RSP without naming it.
+var stackForms = map[string]bool{"PUSHQ": true, "PUSHW": true, "POPQ": true, "POPW": true}
+
+// noteRegs records the registers an instruction encoded in f's body uses,
+// explicitly or implicitly, and whether it calls, so the prologue knows
+// which registers to save.
+func (f *Function) noteRegs(form *IForm, os []interface{}) {
+	if f.usedRegs == nil {
+		f.usedRegs = make(map[Register]bool)
+	}
+	note := func(r Register) {
+		f.usedRegs[r.fullReg()] = true
+	}
+	switch {
+	case form.name == "CALL" || form.name == "CALLQ":
+		f.calls = true
+	case stackForms[form.name]:
+		note(R_RSP)
+	}
+	for _, op := range form.ops {
+		if op.Implicit {
+			if r, err := ParseReg(op.TN); err == nil {
+				note(r)
+			}
+		}
+	}
+	for _, o := range os {
+		switch o := o.(type) {
+		case Register:
+			note(o)
+		case Indirect:
+			if o.Symbol == "" {
+				note(o.Reg)
+			}
+		case IndirectBaseIndexScale:
+			note(o.Base)
+			note(o.Index)
+		}
+	}
+}
+
+// savedRegs returns the callee-saved registers f's body uses, which its
+// prologue saves and its epilogue restores.
+func (f *Function) savedRegs() []Register {
+	var rs []Register
+	for _, r := range calleeSaved {
+		if f.usedRegs[r] {
+			rs = append(rs, r)
+		}
+	}
+	return rs
+}
+
+// framePointer reports whether f sets up RBP as its frame pointer. A leaf
+// function that names neither RBP nor RSP has no locals in memory and no
+// stack arguments, so it only pushes the registers it saves.
+func (f *Function) framePointer() bool {
+	return f.calls || f.usedRegs[R_RBP] || f.usedRegs[R_RSP]
+}
+
+// A frameWriter collects the code of a prologue or epilogue, with a
+// ListEntry for each instruction.
+type frameWriter struct {
+	bytes.Buffer
+	entries []ListEntry
+	source  string
+}
+
+func (w *frameWriter) encodingForm(form *IForm, os []interface{}) {
+	w.entries = append(w.entries, ListEntry{Offset: w.Len(), Form: form.String(), Source: w.source})
+}
+
+func (w *frameWriter) instr(a *Asm, instr string, ops ...interface{}) error {
+	if _, err := a.Encode(w, instr, ops...); err != nil {
+		return err
+	}
+	e := &w.entries[len(w.entries)-1]
+	e.Len = w.Len() - e.Offset
+	return nil
+}
+
+// frameCode encodes a prologue or epilogue for the body as it stands.
+//
+// With a frame pointer, the prologue pushes RBP and points it at the saved
+// RBP, so stack arguments start at [RBP + 16] and locals are below RBP.
+// The registers to save go in slots below the locals, and the stack is
+// then aligned so that RSP is a multiple of 16 at every call. Without one,
+// the prologue just pushes the registers to save and the epilogue pops
+// them.
+func (f *Function) frameCode(p *framePart) (*frameWriter, error) {
+	w := &frameWriter{}
+	if p.entry >= 0 {
+		w.source = f.listing[p.entry].Source
+	}
+	saved := f.savedRegs()
+	var err error
+	do := func(instr string, ops ...interface{}) {
+		if err == nil {
+			err = w.instr(f.a, instr, ops...)
+		}
+	}
+	if !f.framePointer() {
+		if p.epilogue {
+			for i := len(saved) - 1; i >= 0; i-- {
+				do("POP", saved[i])
+			}
+		} else {
+			for _, r := range saved {
+				do("PUSH", r)
+			}
+		}
+		return w, err
+	}
+
+	locals := (int(f.localoff) + 7) &^ 7
+	slot := func(i int) Indirect {
+		return Indirect{Reg: R_RBP, Off: -int32(locals + (i+1)*8), Size: 64}
+	}
+	if p.epilogue {
+		for i, r := range saved {
+			do("MOV", r, slot(i))
+		}
+		do("MOV", R_RSP, R_RBP)
+		do("POP", R_RBP)
+		return w, err
+	}
+	do("PUSH", R_RBP)
+	do("MOV", R_RBP, R_RSP)
+	size := (locals + 8*len(saved) + 15) &^ 15
+	switch {
+	case size == 0:
+	case size < 128:
+		do("SUB", R_RSP, int8(size))
+	default:
+		do("SUB", R_RSP, int32(size))
+	}
+	for i, r := range saved {
+		do("MOV", slot(i), r)
+	}
+	return w, err
+}
+
+// layoutFrame encodes f's prologues and epilogues, once its body is
+// complete.
+func (f *Function) layoutFrame() error {
+	for i := range f.frames {
+		p := &f.frames[i]
+		code, err := f.frameCode(p)
+		if err != nil {
+			return err
+		}
+		if code.Len() > p.len {
+			return fmt.Errorf("%s: frame code is %d bytes, longer than the %d reserved", f.Name, code.Len(), p.len)
+		}
+		p.code = code
+	}
+	return nil
+}
+
+// listFrame replaces the listing entry of each prologue and epilogue with
+// entries for the instructions relax put in its place.
+func (f *Function) listFrame() {
+	for i := len(f.frames) - 1; i >= 0; i-- {
+		p := &f.frames[i]
+		if p.entry < 0 {
+			continue
+		}
+		es := append([]ListEntry(nil), p.code.entries...)
+		for k := range es {
+			es[k].Offset += p.start
+		}
+		f.listing = append(f.listing[:p.entry], append(es, f.listing[p.entry+1:]...)...)
+	}
+}
@@ -0,0 +1,120 @@
+package gbasm
+
+import (
+	"strings"
+	"testing"
+)
+
+func disasmBody(t *testing.T, f *Function) []string {
+	t.Helper()
+	bs, err := f.Body()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := Disassemble(bs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out []string
+	for _, d := range ds {
+		out = append(out, d.String())
+	}
+	return out
+}
+
+func TestLeafFrame(t *testing.T) {
+	o, err := NewOFile("frame", "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := o.NewFunction("frame.bs", 1, "leaf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Prologue()
+	f.Instr("MOV", R12, int32(1))
+	f.Jump("JMP", "done")
+	f.Instr("MOV", R_RAX, R12)
+	f.Label("done")
+	f.Epilogue()
+	f.Instr("RET")
+
+	got := strings.Join(disasmBody(t, f), "; ")
+	// Only R12 is saved, with no frame pointer, and the label before the
+	// epilogue still marks its start.
+	want := "PUSH R12; MOV R12, 1; JMP 0xe; MOV RAX, R12; POP R12; RET"
+	if got != want {
+		t.Errorf("Expected %s, got %s", want, got)
+	}
+}
+
+func TestFramePointer(t *testing.T) {
+	o, err := NewOFile("frame", "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := o.NewFunction("frame.bs", 1, "caller")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Prologue()
+	a, err := f.StackArg("a", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Instr("MOV", R_RBX, a)
+	f.Jump("CALL", "g")
+	f.Instr("MOV", R_RAX, R_RBX)
+	f.Epilogue()
+	f.Instr("RET")
+
+	got := disasmBody(t, f)
+	want := []string{
+		"PUSH RBP",
+		"MOV RBP, RSP",
+		"SUB RSP, 16",
+		"MOV QWORD [RBP - 0x10], RBX",
+	}
+	if len(got) < len(want) || strings.Join(got[:len(want)], "; ") != strings.Join(want, "; ") {
+		t.Fatalf("Expected the prologue %v, got %v", want, got)
+	}
+	found := false
+	for _, s := range got {
+		if strings.Contains(s, "[RBP + 0x10]") {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Expected the stack argument at [RBP + 0x10], got %v", got)
+	}
+	tail := strings.Join(got[len(got)-4:], "; ")
+	if want := "MOV RBX, QWORD [RBP - 0x10]; MOV RSP, RBP; POP RBP; RET"; tail != want {
+		t.Errorf("Expected the epilogue %s, got %s", want, tail)
+	}
+}
+
+func TestStackArgXMM(t *testing.T) {
+	o, err := NewOFile("frame", "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := o.NewFunction("frame.bs", 1, "f")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// With f(i0..i5 i64, x0..x7 f64, i6 i64, x8 f64), i6 is the first
+	// stack argument and x8 the second.
+	if _, err := f.ArgXMM("x8", 8, 64); err == nil {
+		t.Error("Expected ArgXMM to reject an argument passed on the stack")
+	}
+	x, err := f.StackArgXMM("x8", 1, 64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x.offset != 24 || x.class != ClassXMM {
+		t.Errorf("Expected x8 at [RBP+24] in an xmm register, got offset %d class %v", x.offset, x.class)
+	}
+	if _, err := f.StackArgXMM("y", 2, 16); err == nil {
+		t.Error("Expected a 16-bit xmm argument to be rejected")
+	}
+}
@@ -2,13 +2,20 @@ package gbasm
 
 import (
 	"bytes"
-	"encoding/binary"
 	"fmt"
 	"strings"
 )
 
 var debug bool = false
 
+// RegClass selects the register file an allocation is cached in.
+type RegClass int
+
+const (
+	ClassGP  RegClass = iota // General-purpose registers
+	ClassXMM                 // SSE registers, holding a 32-bit float or a 64-bit double
+)
+
 type Ralloc struct {
 	sym      string   // Name of the allocation
 	size     int      // Size of the allocation in bits
@@ -20,6 +27,8 @@ type Ralloc struct {
 	offset   int32    // if local, the offset from RBP
 	rallocs  *Rallocs // reference to ralloc to maintain LRU
 	volatile bool     // if true, always access through memory; never cache in a register
+	class    RegClass // The register file the allocation is cached in
+	pinned   bool     // if true, stays in reg through labels, jumps and calls; see Pin
 }
 
 // RallocPartial refers to the low N bits of an allocation. It can be used
@@ -49,7 +58,7 @@ func (rp *RallocPartial) asOperand() (interface{}, error) {
 		return nil, fmt.Errorf("cannot take %d-bit partial of %d-bit allocation %s", rp.Bits, ra.size, ra.sym)
 	}
 	if ra.inreg {
-		partial, ok := ra.reg.partial(rp.Bits)
+		partial, ok := ra.reg.fullReg().partial(rp.Bits)
 		if !ok {
 			return nil, fmt.Errorf("cannot take %d-bit partial of register %v", rp.Bits, ra.reg)
 		}
@@ -66,7 +75,7 @@ func (rp *RallocPartial) asOperand() (interface{}, error) {
 // size of the data held in the register in bits. For ints/other regable types, this is the size of the data.
 // For non-regable types, this is 64-bits (size of a pointer.
 func (r *Ralloc) RegSize() int {
-	if r.inreg {
+	if r.inreg && r.class != ClassXMM {
 		return r.reg.Width()
 	}
 	if r.regable {
@@ -75,6 +84,18 @@ func (r *Ralloc) RegSize() int {
 	return 64
 }
 
+// mov returns the instruction that moves the allocation between registers of
+// its class and its stack slot.
+func (r *Ralloc) mov() string {
+	if r.class != ClassXMM {
+		return "MOV"
+	}
+	if r.size == 32 {
+		return "MOVSS"
+	}
+	return "MOVSD"
+}
+
 func (r *Ralloc) String() string {
 	return fmt.Sprintf("%s.%s", r.rallocs.f.Name, r.sym)
 }
@@ -121,7 +142,10 @@ func (r *Ralloc) UseRegister(reg Register) {
 	}
 	r.rallocs.rs.Use(reg)
 
-	if r.regable && r.size != reg.Width() {
+	if r.regable && (r.class == ClassXMM) != reg.isXMM() {
+		panic(fmt.Sprintf("Ralloc %s cannot use register %v: it belongs to the wrong register file\n", r.sym, reg))
+	}
+	if r.regable && r.class != ClassXMM && r.size != reg.Width() {
 		panic(fmt.Sprintf("Ralloc %s cannot use register %v. ralloc size: %v, regwidth: %v\n", r.sym, reg, r.size, reg.Width()))
 
 	}
@@ -129,7 +153,7 @@ func (r *Ralloc) UseRegister(reg Register) {
 	if r.inreg {
 		// we're already in a register. MOV the value to the new reg and
 		// free the current one.
-		r.rallocs.f.Instr("MOV", reg, r.reg)
+		r.emit("move", r.mov(), reg, r.reg)
 		r.rallocs.rs.Release(r.reg)
 		r.rallocs.removeLRU(r.reg)
 		delete(r.rallocs.regs, r.reg)
@@ -144,14 +168,14 @@ func (r *Ralloc) UseRegister(reg Register) {
 		// We're not in a register, but are in memory.
 		if !r.regable {
 			// we don't fit in a register. LEA.
-			r.rallocs.f.Instr("LEA", reg, Indirect{Reg: R_RBP, Off: r.offset}) // TODO: Fix size?, Size: r.RegSize()})
+			r.emit("address", "LEA", reg, Indirect{Reg: R_RBP, Off: r.offset}) // TODO: Fix size?, Size: r.RegSize()})
 			r.reg = reg
 			r.rallocs.regs[reg] = r
 			r.rallocs.updateLRU(reg)
 			r.inreg = true
 			return
 		}
-		r.rallocs.f.Instr("MOV", reg, Indirect{Reg: R_RBP, Off: r.offset, Size: r.RegSize()})
+		r.emit("load", r.mov(), reg, Indirect{Reg: R_RBP, Off: r.offset, Size: r.RegSize()})
 		r.reg = reg
 		r.rallocs.regs[reg] = r
 		r.rallocs.updateLRU(reg)
@@ -181,13 +205,12 @@ func (r *Ralloc) Register() Register {
 			reg, ok = r.rallocs.Evict(64)
 			if !ok {
 				panic("Failed to load register") // TODO: Better error handling
-				return 0
 			}
 		}
 		if debug {
 			fmt.Printf("\t[Ralloc.Register()]: Loading pointer to %s into %s\n", r.sym, reg)
 		}
-		r.rallocs.f.Instr("LEA", reg, Indirect{Reg: R_RBP, Off: r.offset}) // TODO: Fix size?, Size: r.RegSize()})
+		r.emit("address", "LEA", reg, Indirect{Reg: R_RBP, Off: r.offset}) // TODO: Fix size?, Size: r.RegSize()})
 		r.reg = reg
 		r.rallocs.regs[reg] = r
 		r.rallocs.updateLRU(reg)
@@ -195,15 +218,24 @@ func (r *Ralloc) Register() Register {
 		return reg
 		//panic(fmt.Sprintf("Cannot load variable %s into register.", r.sym)) // TODO: Better error handling
 	}
-	reg, ok := r.rallocs.rs.Get(r.size)
+	var reg Register
+	var ok bool
+	if r.class == ClassXMM {
+		reg, ok = r.rallocs.rs.GetXMM()
+	} else {
+		reg, ok = r.rallocs.rs.Get(r.size)
+	}
 	if !ok {
 		if debug {
 			fmt.Printf("\t[Ralloc.Register()]: No available registers for %s (size %d). Evicting some variable.\n", r.sym, r.size)
 		}
-		reg, ok = r.rallocs.Evict(r.size)
+		if r.class == ClassXMM {
+			reg, ok = r.rallocs.EvictXMM()
+		} else {
+			reg, ok = r.rallocs.Evict(r.size)
+		}
 		if !ok {
 			panic("Failed to load register") // TODO: Better error handling
-			return 0
 		}
 		if debug {
 			fmt.Printf("\t[Ralloc.Register()]: Evicted %s.\n", reg)
@@ -216,9 +248,9 @@ func (r *Ralloc) Register() Register {
 		}
 		//fmt.Printf("[RALLOC.Register] %s not in register. Allocated register %s\n", r.sym, reg)
 		if reg.Width() < 64 {
-			r.rallocs.f.Instr("XOR", reg.fullReg(), reg.fullReg())
+			r.emit("reload", "XOR", reg.fullReg(), reg.fullReg())
 		}
-		r.rallocs.f.Instr("MOV", reg, Indirect{Reg: R_RBP, Off: r.offset, Size: r.RegSize()})
+		r.emit("reload", r.mov(), reg, Indirect{Reg: R_RBP, Off: r.offset, Size: r.RegSize()})
 		//r.inmem = false
 	} //else {
 	//fmt.Printf("[RALLOC.Register] %s was not in memory. Active register marked as %v\n", r.sym, reg)
@@ -234,8 +266,12 @@ func (r *Ralloc) Evict() Register {
 	if !r.inreg {
 		panic("Already evicted")
 	}
+	if r.pinned && r.rallocs.f != nil {
+		r.rallocs.f.declareVar(r)
+	}
+	r.pinned = false
 	if r.regable {
-		r.rallocs.f.Instr("MOV", Indirect{Reg: R_RBP, Off: r.offset, Size: r.RegSize()}, r.reg)
+		r.emit("spill", r.mov(), Indirect{Reg: R_RBP, Off: r.offset, Size: r.RegSize()}, r.reg)
 		r.inreg = false
 		r.inmem = true
 		r.rallocs.rs.Release(r.reg)
@@ -252,6 +288,44 @@ func (r *Ralloc) Evict() Register {
 	}
 }
 
+// Pin moves the allocation into reg and keeps it there, through labels,
+// jumps and calls, until it is forgotten or something else evicts it. It is
+// meant for the registers PlanRegisters plans: reg should be callee-saved,
+// reserved with Function.Reserve, and named by nothing else while the
+// allocation is live. Another pinned allocation still in reg is dead by
+// then, and is dropped without being spilled.
+func (r *Ralloc) Pin(reg Register) error {
+	if r.volatile || !r.regable || r.class != ClassGP {
+		return fmt.Errorf("cannot pin %s to a register", r.sym)
+	}
+	reg, ok := reg.fullReg().partial(r.size)
+	if !ok {
+		return fmt.Errorf("cannot pin %d-bit %s to a register", r.size, r.sym)
+	}
+	for _, cr := range r.rallocs.rs.Conflicts(reg) {
+		if a, ok := r.rallocs.regs[cr]; ok && a != r && a.pinned {
+			a.drop()
+		}
+	}
+	r.UseRegister(reg)
+	r.pinned = true
+	// Its stack slot no longer holds its value, so it leaves the debug
+	// info until it is evicted back to it.
+	if r.rallocs.f != nil {
+		r.rallocs.f.endVar(r.sym)
+	}
+	return nil
+}
+
+// drop takes a dead allocation out of its register without spilling it.
+func (r *Ralloc) drop() {
+	r.rallocs.rs.Release(r.reg)
+	r.rallocs.removeLRU(r.reg)
+	delete(r.rallocs.regs, r.reg)
+	r.inreg = false
+	r.pinned = false
+}
+
 // func (r *Ralloc) MarkNotInreg() {
 // 	if !r.inreg {
 // 		return
@@ -317,19 +391,32 @@ func (ra *Rallocs) returnSpace(size int32, offset int32) {
 
 // NewLocal allocates a new local variable of size bits. Locals created with
 // this function may have 'Forget' called on them to relinquish their storage.
-func (ra *Rallocs) NewLocal(name string, size int) (*Ralloc, error) {
+// A local of class ClassXMM is cached in the SSE registers and must be 32 or
+// 64 bits wide.
+func (ra *Rallocs) NewLocal(name string, size int, class ...RegClass) (*Ralloc, error) {
 	//fmt.Printf("NewLocal %s(%d)\n", name, size)
 	if _, ok := ra.names[name]; ok {
 		return nil, fmt.Errorf("Ralloc %s already declared.", name)
 	}
+	c := ClassGP
+	if len(class) > 0 {
+		c = class[0]
+	}
+	if c == ClassXMM && size != 32 && size != 64 {
+		return nil, fmt.Errorf("Ralloc %s: xmm locals must be 32 or 64 bits, not %d.", name, size)
+	}
 	r := &Ralloc{
 		sym:     name,
 		size:    size,
 		regable: true,
 		offset:  ra.space(int32(size) / 8),
 		rallocs: ra,
+		class:   c,
 	}
 	ra.names[name] = r
+	if ra.f != nil {
+		ra.f.declareVar(r)
+	}
 	return r, nil
 }
 
@@ -358,6 +445,9 @@ func (ra *Rallocs) ForgetAll() {
 		}
 		delete(ra.names, name)
 		ra.returnSpace(int32(r.size)/8, r.offset)
+		if ra.f != nil {
+			ra.f.endVar(name)
+		}
 	}
 }
 
@@ -376,6 +466,9 @@ func (ra *Rallocs) Forget(name string) error {
 	delete(ra.names, name)
 	//fmt.Printf("Forgetting %s(%d) at offset 0x%x\n", name, r.size, r.offset)
 	ra.returnSpace(int32(r.size)/8, r.offset)
+	if ra.f != nil {
+		ra.f.endVar(name)
+	}
 	return nil
 }
 
@@ -393,6 +486,9 @@ func (ra *Rallocs) AllocBytes(name string, size int) (*Ralloc, error) {
 		inmem:   true,
 	}
 	ra.names[name] = r
+	if ra.f != nil {
+		ra.f.declareVar(r)
+	}
 	return r, nil
 }
 
@@ -422,23 +518,35 @@ func (ra *Rallocs) VolatileLocal(name string) error {
 }
 
 // Arg creates a new local variable for an argument passed in register r.
+// An argument passed in an SSE register is taken to be a double.
 //
 // AMD64 Calling Conventions:
 // %rdi, %rsi, %rdx, %rcx, %r8, %r9, stack
+// %xmm0-%xmm7, stack for floating point
 func (ra *Rallocs) Arg(name string, reg Register) (*Ralloc, error) {
+	if reg.isXMM() {
+		return ra.arg(name, reg, 64)
+	}
+	return ra.arg(name, reg, reg.Width())
+}
+
+func (ra *Rallocs) arg(name string, reg Register, size int) (*Ralloc, error) {
 	if _, ok := ra.names[name]; ok {
 		return nil, fmt.Errorf("Ralloc %s already declared.", name)
 	}
 	r := &Ralloc{
 		sym:     name,
-		size:    reg.Width(),
+		size:    size,
 		inreg:   true,
 		reg:     reg,
 		regable: true,
 		//offset:  -(ra.localoff + (int32(reg.width()) / 8)),
-		offset:  ra.space(int32(reg.Width()) / 8),
+		offset:  ra.space(int32(size) / 8),
 		rallocs: ra,
 	}
+	if reg.isXMM() {
+		r.class = ClassXMM
+	}
 	//fmt.Printf("Argument %s in register %s and offset 0x%x\n", name, reg, r.offset)
 	//ra.localoff += int32(reg.width()) / 8
 	ra.names[name] = r
@@ -453,16 +561,30 @@ func (ra *Rallocs) Arg(name string, reg Register) (*Ralloc, error) {
 // AMD64 Calling Conventions:
 // %rdi, %rsi, %rdx, %rcx, %r8, %r9, stack
 func (f *Function) StackArg(name string, stacki int) (*Ralloc, error) {
+	return f.stackArg(name, stacki, 64, ClassGP)
+}
+
+// StackArgXMM creates a new local variable of ClassXMM for a floating-point
+// argument passed on the stack at offset stacki. size is 32 or 64.
+func (f *Function) StackArgXMM(name string, stacki int, size int) (*Ralloc, error) {
+	if size != 32 && size != 64 {
+		return nil, fmt.Errorf("Ralloc %s: xmm arguments must be 32 or 64 bits, not %d.", name, size)
+	}
+	return f.stackArg(name, stacki, size, ClassXMM)
+}
+
+func (f *Function) stackArg(name string, stacki int, size int, class RegClass) (*Ralloc, error) {
 	if _, ok := f.names[name]; ok {
 		return nil, fmt.Errorf("Ralloc %s already declared.", name)
 	}
 	r := &Ralloc{
 		sym:     name,
-		size:    64,
+		size:    size,
 		inmem:   true,
 		regable: true,
-		offset:  int32((stacki+1)*8) + f.basePointerOff, // Skip over return pointer and base pointer.
+		offset:  int32((stacki + 2) * 8), // Skip over the saved base pointer and the return address.
 		rallocs: f.Rallocs,
+		class:   class,
 	}
 	//fmt.Printf("STACK Argument %s at offset 0x%x\n", name, r.offset)
 	f.localoff += 8
@@ -542,6 +664,10 @@ func argRegForSize(i, size int) Register {
 	return R_RDI // unreachable for valid i
 }
 
+// ArgI creates a new local variable for integer argument i, which arrives
+// in the i'th of %rdi, %rsi, %rdx, %rcx, %r8 and %r9 as the System V ABI
+// assigns them. size is 64 if omitted. Arguments beyond the registers are
+// taken to be the only ones passed on the stack.
 func (f *Function) ArgI(name string, i int, size ...int) (*Ralloc, error) {
 	sz := 64
 	if len(size) > 0 {
@@ -553,6 +679,21 @@ func (f *Function) ArgI(name string, i int, size ...int) (*Ralloc, error) {
 	return f.StackArg(name, i-6)
 }
 
+// ArgXMM creates a new local variable of ClassXMM for floating-point
+// argument i, which arrives in %xmm<i>. Floating-point arguments are
+// numbered separately from integer ones, as the System V ABI assigns them
+// registers. size is 32 or 64. Arguments beyond the registers share the
+// stack with integer ones, so i cannot say where they are; use StackArgXMM.
+func (f *Function) ArgXMM(name string, i int, size int) (*Ralloc, error) {
+	if size != 32 && size != 64 {
+		return nil, fmt.Errorf("Ralloc %s: xmm arguments must be 32 or 64 bits, not %d.", name, size)
+	}
+	if i < 0 || i >= len(float_arg_regs) {
+		return nil, fmt.Errorf("Ralloc %s: xmm argument %d is not passed in a register; use StackArgXMM with its stack slot.", name, i)
+	}
+	return f.arg(name, float_arg_regs[i], size)
+}
+
 // This causes the local variable 'name' to take over register 'reg', meaning 'name' will
 // immediately take on the value currently in 'reg'. Any other variable currently in 'reg' will be
 // evicted.
@@ -602,6 +743,9 @@ func (ra *Rallocs) removeLRU(r Register) {
 
 func (ra *Rallocs) Evict(size int) (Register, bool) {
 	for _, reg := range ra.lru {
+		if reg.isXMM() || ra.regs[reg].pinned {
+			continue
+		}
 		if reg.Width() >= size {
 			ra.regs[reg].Evict()
 			//return reg, true
@@ -612,6 +756,18 @@ func (ra *Rallocs) Evict(size int) (Register, bool) {
 	return 0, false
 }
 
+// EvictXMM spills the least-recently-used allocation held in an SSE register
+// and returns a free SSE register, marked in use.
+func (ra *Rallocs) EvictXMM() (Register, bool) {
+	for _, reg := range ra.lru {
+		if reg.isXMM() {
+			ra.regs[reg].Evict()
+			return ra.rs.GetXMM()
+		}
+	}
+	return 0, false
+}
+
 // EvictReg evicts whatever variable is in a register, if there is one. It does *NOT* mark the register in use
 // or perform any other bookkeeping. This is mostly useful for when one wants to temporarily use a specific register
 // for some calculation.
@@ -639,7 +795,9 @@ func (ra *Rallocs) EvictAll() {
 	lru := make([]Register, len(ra.lru))
 	copy(lru, ra.lru)
 	for _, reg := range lru {
-		ra.regs[reg].Evict()
+		if !ra.regs[reg].pinned {
+			ra.regs[reg].Evict()
+		}
 	}
 }
 
@@ -745,18 +903,52 @@ type Function struct {
 	// writeFunction/readFunction): appended strictly after the body so
 	// field-order parity between writer and reader is preserved.
 	ReturnAliases [][]int
-	bodyBs        []byte
+	// Align is the boundary the linker places the function on, in bytes.
+	// Zero and one mean no alignment. Like ReturnAliases it is serialized
+	// after the body.
+	Align int
+	// Lines maps the body to the source lines given with SourceLine, and
+	// Locals describes its local and bytes allocations, for debug info.
+	// Both are set by Resolve and serialized in the .bo's debug section.
+	Lines  []LineEntry
+	Locals []FrameVar
+	bodyBs []byte
 
 	// The following fields are used to resolve jumps and labels within a function.
 	// These are *NOT* written or read to/from object files.
 	// Jumps need to be resolved with resolve() before being written to an object file
 	// or the jumps will not be correct.
-	bs             bytes.Buffer
-	labels         map[string]int
-	jumps          []Relocation
-	errors         []error
-	localsLocation uint32
-	basePointerOff int32
+	bs      bytes.Buffer
+	labels  map[string]int
+	exports []string // labels to record in Symbols; see ExportLabel
+	jumps   []Relocation
+	pads    []alignPad
+	frames  []framePart
+	errors  []error
+	// With the peephole optimizer enabled, pending holds the instructions
+	// not yet encoded, and peepStats counts the rewrites of each rule.
+	peephole  bool
+	pending   []peepInstr
+	peepStats map[string]int
+	// usedRegs and calls are what the body does, as far as the prologue
+	// and epilogue are concerned: the registers its instructions use, and
+	// whether any of them is a call.
+	usedRegs map[Register]bool
+	calls    bool
+
+	// listing is non-nil when the function records a ListEntry per
+	// instruction. listSource and listNote fill in the entries.
+	listing    []ListEntry
+	listSource string
+	listNote   string
+
+	// seq counts the instructions given to f, so that the source
+	// positions and allocations recorded between them can be placed in
+	// the body once it is laid out. See placeDebugInfo.
+	seq      int
+	placed   []placedInstr
+	posMarks []posMark
+	varMarks []varMark
 
 	a  *Asm
 	rs *Registers
@@ -772,15 +964,14 @@ func (o *OFile) NewFunction(srcFile string, srcLine int, name string, args ...*V
 	}
 
 	f := &Function{
-		SrcFile:        srcFile,
-		SrcLine:        srcLine,
-		Name:           name,
-		Pkgname:        o.Pkgname,
-		Args:           args,
-		labels:         make(map[string]int),
-		a:              o.a,
-		rs:             NewRegisters(),
-		basePointerOff: (6 * 8), // Determined in Prologue. This is the amount of "stuff" pushed on top of any arguments before the base pointer is established.
+		SrcFile: srcFile,
+		SrcLine: srcLine,
+		Name:    name,
+		Pkgname: o.Pkgname,
+		Args:    args,
+		labels:  make(map[string]int),
+		a:       o.a,
+		rs:      NewRegisters(),
 	}
 	f.Rallocs = NewRallocs(f.rs, f)
 	o.Funcs[name] = f
@@ -799,6 +990,12 @@ func (f *Function) Release(r Register) {
 	f.rs.Release(r)
 }
 
+// Reserve keeps the register allocator from choosing r, or any part of it,
+// for a local. r can still be named, or given to a local with Pin.
+func (f *Function) Reserve(r Register) {
+	f.rs.Reserve(r)
+}
+
 // Get finds an unused register of size and marks it as in-use. When the register is no longer
 // needed it should be Released.
 func (f *Function) Get(size int) (Register, bool) {
@@ -806,49 +1003,25 @@ func (f *Function) Get(size int) (Register, bool) {
 }
 
 // Functions assume System V x86_64 calling convention.
+//
+// Prologue sets up the function's frame and saves the callee-saved
+// registers it uses. Which registers those are, and whether the function
+// needs RBP as a frame pointer at all, is only known once the body is
+// complete, so the code is laid out in Resolve; see frameCode.
 func (f *Function) Prologue() error {
-	// _, err := f.NewLocal("__retvalue", 64)
-	// if err != nil {
-	// 	return err
-	// }
 	f.rs.Use(R_RBP)
 	f.rs.Use(R_RSP)
-	// TODO: If we get smarter about encoding, we can determine which of these registers we *need* to save
-	// based on which ones the function uses instead of just saving all of them.
-	// However, for now, this is simple and gives us safe access to any register in a function.
-	f.Instr("PUSH", R_RBP)
-	f.Instr("PUSH", R_RBX)
-	f.Instr("PUSH", R12)
-	f.Instr("PUSH", R13)
-	f.Instr("PUSH", R14)
-	f.Instr("PUSH", R15)
-	f.Instr("MOV", R_RBP, R_RSP)
-	f.Instr("SUB", R_RSP, uint32(0))
-	f.localsLocation = uint32(f.bs.Len() - 4)
-	return nil // TODO: Fix errors
+	f.frame(false)
+	return nil
 }
 
+// Epilogue restores the registers the prologue saved and tears down the
+// frame. Like the prologue, its code is laid out in Resolve.
 func (f *Function) Epilogue() error {
 	f.rs.Release(R_RBP)
 	f.rs.Release(R_RSP)
-	bs := f.bs.Bytes()
-	bs = bs[f.localsLocation:]
-	bss := bytes.NewBuffer(bs)
-	bss.Truncate(0)
-	// align the stack to 16-bytes.
-	localoff := ((f.Rallocs.localoff + 0x10) & 0xFF_FF_FF_F0)
-	binary.Write(bss, binary.LittleEndian, localoff)
-	//binary.Write(bss, binary.LittleEndian, f.Rallocs.localoff)
-	// This un-does the "SUB" in the prologue, but is unnecessary since we
-	// immediately load RSP from RBP.
-	//f.f.Instr("ADD", R_RSP, uint32(f.Rallocs.localoff))
-	f.Instr("MOV", R_RSP, R_RBP)
-	f.Instr("POP", R15)
-	f.Instr("POP", R14)
-	f.Instr("POP", R13)
-	f.Instr("POP", R12)
-	f.Instr("POP", R_RBX)
-	return f.Instr("POP", R_RBP)
+	f.frame(true)
+	return nil
 }
 
 func (f *Function) Label(l string) error {
@@ -861,12 +1034,14 @@ func (f *Function) Label(l string) error {
 		}
 		return err
 	}
+	f.flush()
 	f.labels[l] = f.bs.Len()
 	return nil
 }
 
 // Instr should be one of the jump instructions like JMP, JNE, JGT, CALL etc.
 func (f *Function) Jump(instr string, label string) error {
+	f.seq++
 	if instr == "CALL" {
 		// For call, we only need to save the caller-saved registers according to
 		// System V Amd64 ABI
@@ -891,12 +1066,14 @@ func (f *Function) Jump(instr string, label string) error {
 	if instr == "CALL" {
 		//f.takeoverRegister("__retvalue", R_RAX)
 	}
-	_, err := f.a.Encode(&f.bs, instr, int32(0))
+	f.flush()
+	w, lw := f.writer()
+	_, err := f.a.Encode(w, instr, int32(0))
 	if err != nil {
 		f.errors = append(f.errors, err)
 		panic(err)
-		return err
 	}
+	lw.finish(nil)
 	f.jumps = append(f.jumps, Relocation{Offset: uint32(f.bs.Len() - 4), Symbol: label})
 	return nil
 }
@@ -1020,6 +1197,7 @@ func (s *splitWriteLener) Len() int {
 }
 
 func (f *Function) Instr(instr string, ops ...interface{}) error {
+	f.seq++
 	if debug {
 		fmt.Printf("\tINSTRUCTION [%#v] OPS [", instr)
 		for i := range ops {
@@ -1028,6 +1206,11 @@ func (f *Function) Instr(instr string, ops ...interface{}) error {
 		fmt.Printf("]\n")
 	}
 
+	var orig []interface{}
+	if f.listing != nil || f.peephole {
+		orig = append(orig, ops...)
+	}
+
 	// Resolve any RallocPartial operands to their concrete Register or Indirect form.
 	for i, op := range ops {
 		if rp, ok := op.(*RallocPartial); ok {
@@ -1070,7 +1253,16 @@ func (f *Function) Instr(instr string, ops ...interface{}) error {
 		}
 	}
 
-	rs, err := f.a.Encode(&f.bs, instr, ops...)
+	var rs []Relocation
+	var err error
+	var lw *listWriter
+	if f.peephole {
+		err = f.queue(instr, ops, orig)
+	} else {
+		var w WriteLener
+		w, lw = f.writer()
+		rs, err = f.a.Encode(w, instr, ops...)
+	}
 	if err != nil {
 		f.errors = append(f.errors, err)
 		var opdesc []string
@@ -1083,6 +1275,7 @@ func (f *Function) Instr(instr string, ops ...interface{}) error {
 		}
 		panic(fmt.Errorf("%s [%s]: %v", instr, strings.Join(opdesc, ", "), err))
 	}
+	lw.finish(orig)
 	f.Relocations = append(f.Relocations, rs...)
 	return err
 }
@@ -1091,6 +1284,14 @@ func (f *Function) Resolve() error {
 	if f.bodyBs != nil {
 		return nil
 	}
+	f.flush()
+	if err := f.layoutFrame(); err != nil {
+		f.errors = append(f.errors, err)
+		return err
+	}
+	f.relax()
+	f.listFrame()
+	f.placeDebugInfo()
 	bs := f.bs.Bytes()
 	for _, rel := range f.jumps {
 		if loff, ok := f.labels[rel.Symbol]; ok {
@@ -1100,6 +1301,7 @@ func (f *Function) Resolve() error {
 		}
 	}
 	f.jumps = make([]Relocation, 0)
+	f.exportLabels()
 	f.bodyBs = bs
 	// Qualify any bare symbol in our relocations with our package name, so
 	// the linker only ever sees fully-qualified cross-file references.
@@ -0,0 +1,128 @@
+package gbasm
+
+import (
+	"fmt"
+	"strings"
+)
+
+// LabelRef returns the symbol that names label in function fn, for a
+// DataReloc that needs the label's address, such as a jump table entry.
+// fn may be bare or package-qualified like any other relocation target.
+func LabelRef(fn, label string) string {
+	return fn + ":" + label
+}
+
+// splitLabelRef splits a symbol made by LabelRef. ok is false for a
+// symbol naming a function, var or data block.
+func splitLabelRef(sym string) (fn, label string, ok bool) {
+	i := strings.IndexByte(sym, ':')
+	if i < 0 {
+		return sym, "", false
+	}
+	return sym[:i], sym[i+1:], true
+}
+
+// isQualified reports whether sym already carries a package name. Only the
+// part before a label is considered, since labels may contain dots.
+func isQualified(sym string) bool {
+	fn, _, _ := splitLabelRef(sym)
+	return strings.ContainsRune(fn, '.')
+}
+
+// ExportLabel records label l in f.Symbols when f is resolved, so that the
+// linker can resolve data relocations against LabelRef(f.Name, l). The
+// label must already be declared.
+func (f *Function) ExportLabel(l string) error {
+	off, ok := f.labels[l]
+	if !ok {
+		return fmt.Errorf("function %s has no label %s", f.Name, l)
+	}
+	for _, e := range f.exports {
+		if e == l {
+			return nil
+		}
+	}
+	f.exports = append(f.exports, l)
+	if f.bodyBs != nil {
+		// Already resolved: labels are at their final offsets.
+		f.Symbols = append(f.Symbols, Symbol{Name: l, Offset: uint32(off)})
+	}
+	return nil
+}
+
+// AddTable declares name as a read-only table of the addresses of labels
+// in function fn, 8 bytes per entry. A RIP-relative operand takes no index
+// register, so code dispatches through it by loading its address first:
+// `lea rax table` then `jmp [rax+rcx*8]`. The labels need not be declared
+// yet; they are exported when the file is written.
+func (o *OFile) AddTable(name, fn string, labels []string, isPub bool) error {
+	if err := o.AddData(name, fmt.Sprintf("u64[%d]", len(labels)), make([]byte, 8*len(labels)), isPub); err != nil {
+		return err
+	}
+	t := o.Data[name]
+	t.Align = 8
+	for i, l := range labels {
+		t.Relocs = append(t.Relocs, DataReloc{Offset: uint32(8 * i), Symbol: LabelRef(fn, l)})
+	}
+	return nil
+}
+
+// A LabelRefError is a DataReloc against a label that ExportLabelRefs
+// cannot export. Reloc is its index in the Relocs of the var or data block
+// named Var, so that an assembler can say where it was written.
+type LabelRefError struct {
+	Kind   string // "data" or "var"
+	Var    string
+	Reloc  int
+	Symbol string
+	Err    error
+}
+
+func (e *LabelRefError) Error() string {
+	return fmt.Sprintf("%s %s: relocation against %s: %s", e.Kind, e.Var, e.Symbol, e.Err)
+}
+
+// ExportLabelRefs exports every label of o's functions that a DataReloc in
+// o refers to. It returns a *LabelRefError for each reference to a label or
+// function o does not define, unless the function is qualified with another
+// package's name.
+func (o *OFile) ExportLabelRefs() []error {
+	var errs []error
+	check := func(kind string, v *Var) {
+		for i, r := range v.Relocs {
+			fn, l, ok := splitLabelRef(r.Symbol)
+			if !ok {
+				continue
+			}
+			if pkg, bare, found := strings.Cut(fn, "."); found {
+				if pkg != o.Pkgname {
+					continue
+				}
+				fn = bare
+			}
+			f := o.Funcs[fn]
+			if f == nil {
+				errs = append(errs, &LabelRefError{kind, v.Name, i, r.Symbol, fmt.Errorf("no such function %s", fn)})
+				continue
+			}
+			if err := f.ExportLabel(l); err != nil {
+				errs = append(errs, &LabelRefError{kind, v.Name, i, r.Symbol, err})
+			}
+		}
+	}
+	for _, name := range sortedKeys(o.Data) {
+		check("data", o.Data[name])
+	}
+	for _, name := range sortedKeys(o.Vars) {
+		check("var", o.Vars[name])
+	}
+	return errs
+}
+
+// exportLabels adds the exported labels to f.Symbols. It is called by
+// Resolve once the labels are at their final offsets.
+func (f *Function) exportLabels() {
+	for _, l := range f.exports {
+		f.Symbols = append(f.Symbols, Symbol{Name: l, Offset: uint32(f.labels[l])})
+	}
+}
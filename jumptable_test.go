@@ -0,0 +1,75 @@
+package gbasm
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+func TestJumpTable(t *testing.T) {
+	o, err := NewOFile("table", "_init")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := o.NewFunction("table.bs", 1, "start")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := o.AddTable("cases", "start", []string{"a", "b"}, false); err != nil {
+		t.Fatal(err)
+	}
+	f.Instr("LEA", R_RAX, o.Data["cases"])
+	f.Instr("JMP", Indirect{Reg: R_RAX, Size: 64})
+	f.Label("a")
+	f.Jump("JMP", "b") // relaxed, which moves b
+	f.Label("b")
+	f.Instr("RET")
+
+	if errs := o.ExportLabelRefs(); len(errs) != 0 {
+		t.Fatal(errs)
+	}
+	if err := f.Resolve(); err != nil {
+		t.Fatal(err)
+	}
+	labels := make(map[string]uint32)
+	for _, s := range f.Symbols {
+		labels[s.Name] = s.Offset
+	}
+	if len(labels) != 2 || labels["b"]-labels["a"] != 2 {
+		t.Fatalf("Expected labels a and b, 2 bytes apart, got %v", f.Symbols)
+	}
+
+	const textoff = 0x30000
+	bin, err := Link([]*OFile{o}, textoff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var data *Section
+	for _, s := range bin.Sections {
+		for _, sym := range s.symbols {
+			if sym.Name == "_init.cases" {
+				data = s
+			}
+		}
+	}
+	if data == nil {
+		t.Fatal("The table was not placed")
+	}
+	for i, l := range []string{"a", "b"} {
+		got := binary.LittleEndian.Uint64(data.val[8*i:])
+		if want := uint64(textoff + labels[l]); got != want {
+			t.Errorf("Entry %d: expected %#x (label %s), got %#x", i, want, l, got)
+		}
+	}
+
+	if errs := o.ExportLabelRefs(); len(errs) != 0 {
+		t.Errorf("Exporting the labels again: %v", errs)
+	}
+	o.Data["cases"].Relocs[0].Symbol = LabelRef("start", "nosuch")
+	errs := o.ExportLabelRefs()
+	if len(errs) != 1 {
+		t.Fatalf("Expected an error for an undeclared label, got %v", errs)
+	}
+	if e, ok := errs[0].(*LabelRefError); !ok || e.Var != "cases" || e.Reloc != 0 {
+		t.Errorf("Expected a LabelRefError for entry 0 of cases, got %#v", errs[0])
+	}
+}
@@ -0,0 +1,300 @@
+package gbasm
+
+import (
+	"sort"
+)
+
+// A ScanOp is one step of a function's instruction stream, as
+// PlanRegisters sees it: the values it reads and writes, and where control
+// goes next. Values are the locals of the function, numbered from zero by
+// the caller. A local that is forgotten and declared again may be given a
+// new number.
+type ScanOp struct {
+	Uses []int // values read
+	Defs []int // values written without being read, including by their declaration
+
+	Label    string     // label declared at this step
+	Jump     string     // label jumped to; a label the stream does not declare leaves the function
+	Cond     bool       // with Jump, control may also fall through to the next step
+	Indirect bool       // jumps to any label of the function, as through a jump table
+	Call     bool       // calls a function
+	Exit     bool       // leaves the function, as RET does
+	Clobbers []Register // registers overwritten, as by an epilogue restoring them
+}
+
+// planRegs are the registers PlanRegisters hands out, in order of
+// preference. They are callee-saved, so a value keeps its register across
+// calls, and the prologue saves the ones the function uses.
+var planRegs = []Register{R_RBX, R12, R13, R14, R15}
+
+// maxLoopWeight caps the loop depth counted when weighing a use.
+const maxLoopWeight = 5
+
+// An interval is the span of steps over which a value is live, from its
+// first to its last mention, extended around loops it is live through.
+type interval struct {
+	v          int
+	start, end int
+	cost       int        // estimated loads and stores saved by keeping v in a register
+	forbid     []Register // registers clobbered while v is live
+	reg        Register
+	assigned   bool
+}
+
+func (iv *interval) allowed(r Register) bool {
+	for _, f := range iv.forbid {
+		if f == r {
+			return false
+		}
+	}
+	return true
+}
+
+// PlanRegisters assigns callee-saved registers to values of the stream ops
+// by linear scan over their live ranges, and returns the register, 64 bits
+// wide, planned for each value that gets one. Registers in avoid, which
+// the function names itself, are not handed out.
+//
+// Liveness is computed over the stream's control flow, following labels
+// and jumps. Only values live across a label, jump or call are considered,
+// since those are where the LRU allocator spills everything it holds. A
+// value's spill cost is the number of its uses and definitions, each
+// weighted by 8 for every loop it is in; when there are more values live
+// than registers, the cheapest ones are left to the LRU allocator.
+//
+// A value keeps its register for its whole interval, so two values share a
+// register only if their intervals do not overlap. A value live out of a
+// step that clobbers a register does not get that register.
+func PlanRegisters(ops []ScanOp, avoid []Register) map[int]Register {
+	nvals := 0
+	for _, op := range ops {
+		for _, v := range op.Uses {
+			nvals = max(nvals, v+1)
+		}
+		for _, v := range op.Defs {
+			nvals = max(nvals, v+1)
+		}
+	}
+	if nvals == 0 {
+		return nil
+	}
+
+	labels := make(map[string]int)
+	for i, op := range ops {
+		if op.Label != "" {
+			labels[op.Label] = i
+		}
+	}
+	var allLabels []int
+	for _, i := range labels {
+		allLabels = append(allLabels, i)
+	}
+	sort.Ints(allLabels)
+	succs := func(i int) []int {
+		op := ops[i]
+		var s []int
+		switch {
+		case op.Exit:
+		case op.Indirect:
+			s = allLabels
+		case op.Jump != "":
+			if t, ok := labels[op.Jump]; ok {
+				s = append(s, t)
+			}
+			if op.Cond && i+1 < len(ops) {
+				s = append(s, i+1)
+			}
+		case i+1 < len(ops):
+			s = append(s, i+1)
+		}
+		return s
+	}
+
+	// Backward dataflow to a fixed point:
+	// in[i] = uses[i] | (out[i] &^ defs[i]), out[i] = union of in[succ].
+	words := (nvals + 63) / 64
+	liveIn := make([]bitset, len(ops))
+	liveOut := make([]bitset, len(ops))
+	for i := range ops {
+		liveIn[i] = make(bitset, words)
+		liveOut[i] = make(bitset, words)
+	}
+	for changed := true; changed; {
+		changed = false
+		for i := len(ops) - 1; i >= 0; i-- {
+			for _, s := range succs(i) {
+				liveOut[i].or(liveIn[s])
+			}
+			in := make(bitset, words)
+			copy(in, liveOut[i])
+			for _, v := range ops[i].Defs {
+				in.clear(v)
+			}
+			for _, v := range ops[i].Uses {
+				in.set(v)
+			}
+			if !in.equal(liveIn[i]) {
+				liveIn[i] = in
+				changed = true
+			}
+		}
+	}
+
+	// A jump back to a label makes the steps between a loop.
+	depth := make([]int, len(ops))
+	for i, op := range ops {
+		if op.Jump == "" {
+			continue
+		}
+		if t, ok := labels[op.Jump]; ok && t <= i {
+			for k := t; k <= i; k++ {
+				depth[k]++
+			}
+		}
+	}
+
+	ivs := make([]*interval, nvals)
+	spans := make([]bool, nvals)
+	for i, op := range ops {
+		touch := func(v int) *interval {
+			iv := ivs[v]
+			if iv == nil {
+				iv = &interval{v: v, start: i}
+				ivs[v] = iv
+			}
+			iv.end = i
+			return iv
+		}
+		for v := 0; v < nvals; v++ {
+			if liveIn[i].has(v) || liveOut[i].has(v) {
+				touch(v)
+			}
+		}
+		w := 1 << (3 * min(depth[i], maxLoopWeight))
+		for _, v := range op.Uses {
+			touch(v).cost += w
+		}
+		for _, v := range op.Defs {
+			touch(v).cost += w
+		}
+		for v := 0; v < nvals; v++ {
+			if !liveOut[i].has(v) {
+				continue
+			}
+			if op.Call || op.Jump != "" || op.Indirect || op.Label != "" && liveIn[i].has(v) {
+				spans[v] = true
+			}
+			for _, r := range op.Clobbers {
+				ivs[v].forbid = append(ivs[v].forbid, r.fullReg())
+			}
+		}
+	}
+
+	var cands []*interval
+	for v, iv := range ivs {
+		if iv != nil && spans[v] {
+			cands = append(cands, iv)
+		}
+	}
+	sort.Slice(cands, func(i, j int) bool {
+		if cands[i].start != cands[j].start {
+			return cands[i].start < cands[j].start
+		}
+		return cands[i].v < cands[j].v
+	})
+
+	var regs []Register
+	for _, r := range planRegs {
+		avoided := false
+		for _, a := range avoid {
+			if a.fullReg() == r {
+				avoided = true
+			}
+		}
+		if !avoided {
+			regs = append(regs, r)
+		}
+	}
+
+	var active []*interval
+	for _, iv := range cands {
+		// Expire the intervals that ended before this one starts.
+		k := 0
+		for _, a := range active {
+			if a.end >= iv.start {
+				active[k] = a
+				k++
+			}
+		}
+		active = active[:k]
+
+		for _, r := range regs {
+			if !iv.allowed(r) {
+				continue
+			}
+			free := true
+			for _, a := range active {
+				if a.reg == r {
+					free = false
+					break
+				}
+			}
+			if free {
+				iv.reg, iv.assigned = r, true
+				break
+			}
+		}
+		if !iv.assigned {
+			// Take the register of the cheapest active interval, if this
+			// one is worth more.
+			var victim *interval
+			for _, a := range active {
+				if iv.allowed(a.reg) && (victim == nil || a.cost < victim.cost) {
+					victim = a
+				}
+			}
+			if victim == nil || victim.cost >= iv.cost {
+				continue
+			}
+			iv.reg, iv.assigned = victim.reg, true
+			victim.assigned = false
+			for i, a := range active {
+				if a == victim {
+					active = append(active[:i], active[i+1:]...)
+					break
+				}
+			}
+		}
+		active = append(active, iv)
+	}
+
+	plan := make(map[int]Register)
+	for _, iv := range cands {
+		if iv.assigned {
+			plan[iv.v] = iv.reg
+		}
+	}
+	return plan
+}
+
+// A bitset is a set of small non-negative integers.
+type bitset []uint64
+
+func (b bitset) set(i int)      { b[i/64] |= 1 << (i % 64) }
+func (b bitset) clear(i int)    { b[i/64] &^= 1 << (i % 64) }
+func (b bitset) has(i int) bool { return b[i/64]&(1<<(i%64)) != 0 }
+
+func (b bitset) or(c bitset) {
+	for i := range b {
+		b[i] |= c[i]
+	}
+}
+
+func (b bitset) equal(c bitset) bool {
+	for i := range b {
+		if b[i] != c[i] {
+			return false
+		}
+	}
+	return true
+}
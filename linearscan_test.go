@@ -0,0 +1,161 @@
+package gbasm
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPlanRegisters(t *testing.T) {
+	// 0: sum = 0          (value 0)
+	// 1: i = 0            (value 1)
+	// 2: label loop
+	// 3: t = i            (value 2, dead after the call)
+	// 4: call f(t)
+	// 5: sum += i
+	// 6: i++; jne loop
+	// 7: epilogue
+	// 8: ret sum
+	ops := []ScanOp{
+		{Defs: []int{0}},
+		{Defs: []int{1}},
+		{Label: "loop"},
+		{Defs: []int{2}, Uses: []int{1}},
+		{Uses: []int{2}, Call: true},
+		{Uses: []int{0, 1}},
+		{Uses: []int{1}, Jump: "loop", Cond: true},
+		{Clobbers: []Register{R_RBX, R12, R13, R14, R15}},
+		{Exit: true},
+	}
+	plan := PlanRegisters(ops, nil)
+	if plan[0] != R_RBX || plan[1] != R12 {
+		t.Errorf("Expected sum in RBX and i in R12, got %v", plan)
+	}
+	if r, ok := plan[2]; ok {
+		t.Errorf("Expected t, which crosses no call or label, to be left alone, got %v", r)
+	}
+
+	plan = PlanRegisters(ops, []Register{R_EBX})
+	if plan[0] != R12 || plan[1] != R13 {
+		t.Errorf("Expected RBX to be avoided, got %v", plan)
+	}
+
+	// Live out of the epilogue, value 0 cannot have any of its registers.
+	ops[8].Uses = []int{0}
+	if r, ok := PlanRegisters(ops, nil)[0]; ok {
+		t.Errorf("Expected sum, live across the epilogue, to get no register, got %v", r)
+	}
+}
+
+func TestPlanRegistersShare(t *testing.T) {
+	// Values 0 and 1 are live across calls one after the other, so they
+	// can share a register.
+	ops := []ScanOp{
+		{Defs: []int{0}},
+		{Call: true},
+		{Uses: []int{0}},
+		{Defs: []int{1}},
+		{Call: true},
+		{Uses: []int{1}},
+		{Exit: true},
+	}
+	plan := PlanRegisters(ops, nil)
+	if plan[0] != R_RBX || plan[1] != R_RBX {
+		t.Errorf("Expected both values in RBX, got %v", plan)
+	}
+}
+
+func TestPlanRegistersSpillCost(t *testing.T) {
+	// Six values live across a call, with five registers: the one used
+	// least, value 5, is left out.
+	var ops []ScanOp
+	for v := 0; v < 6; v++ {
+		ops = append(ops, ScanOp{Defs: []int{v}})
+	}
+	ops = append(ops, ScanOp{Call: true})
+	for n := 0; n < 3; n++ {
+		ops = append(ops, ScanOp{Uses: []int{0, 1, 2, 3, 4}})
+	}
+	ops = append(ops, ScanOp{Uses: []int{5}}, ScanOp{Exit: true})
+	plan := PlanRegisters(ops, nil)
+	if len(plan) != 5 {
+		t.Fatalf("Expected five values planned, got %v", plan)
+	}
+	if _, ok := plan[5]; ok {
+		t.Errorf("Expected the cheapest value to be left out, got %v", plan)
+	}
+
+	// Value 5 used in a loop outweighs the others.
+	ops = append(ops[:len(ops)-2], ScanOp{Label: "l"}, ScanOp{Uses: []int{5}}, ScanOp{Jump: "l", Cond: true}, ScanOp{Exit: true})
+	plan = PlanRegisters(ops, nil)
+	if _, ok := plan[5]; !ok || len(plan) != 5 {
+		t.Errorf("Expected the value used in the loop to be planned, got %v", plan)
+	}
+}
+
+func TestPinnedLocal(t *testing.T) {
+	o, err := NewOFile("pin", "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := o.NewFunction("pin.bs", 1, "f")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Reserve(R12)
+	f.Prologue()
+	n, err := f.NewLocal("n", 32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := n.Pin(R12); err != nil {
+		t.Fatal(err)
+	}
+	f.Instr("MOV", n, int32(1))
+	f.Label("loop")
+	f.Jump("CALL", "g")
+	f.Instr("ADD", n, int8(1))
+	f.Jump("JMP", "loop")
+
+	// The allocator leaves the reserved register alone.
+	for i := 0; i < 8; i++ {
+		l, err := f.NewLocal("t"+string(rune('a'+i)), 64)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r := l.Register(); r.fullReg() == R12 {
+			t.Errorf("Expected R12 to be reserved, but %s got it", l.sym)
+		}
+	}
+	f.Epilogue()
+	f.Instr("RET")
+
+	bs, err := f.Body()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := Disassemble(bs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range ds {
+		if s := d.String(); strings.Contains(s, "R12D") && strings.Contains(s, "[RBP") {
+			t.Errorf("Expected n never to be spilled, got %s", s)
+		}
+	}
+	if !n.inreg || n.reg != R12D {
+		t.Errorf("Expected n in R12D, got %v (in register: %v)", n.reg, n.inreg)
+	}
+
+	// Pinning another local to R12 drops n, which is dead by then.
+	m, _ := f.NewLocal("m", 64)
+	if err := m.Pin(R12); err != nil {
+		t.Fatal(err)
+	}
+	if n.inreg || n.inmem {
+		t.Errorf("Expected n to be dropped without a spill")
+	}
+	x, _ := f.NewLocal("x", 64, ClassXMM)
+	if err := x.Pin(R13); err == nil {
+		t.Errorf("Expected an xmm local not to be pinned")
+	}
+}
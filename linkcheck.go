@@ -0,0 +1,351 @@
+package gbasm
+
+import (
+	"debug/elf"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// A LinkError is a problem found with a symbol of a link: a reference to a
+// symbol nothing defines, a second definition of a symbol, or a reference
+// to a symbol of the wrong kind. File and Line are the site it was found
+// at, as precisely as the objects record it; Line is 0 when only the file
+// is known. Site is the function, var or ELF section there.
+type LinkError struct {
+	File string
+	Line int
+	Site string
+	Msg  string
+}
+
+func (e LinkError) Error() string {
+	var b strings.Builder
+	if e.File != "" {
+		b.WriteString(e.pos() + ": ")
+	}
+	if e.Site != "" {
+		b.WriteString(e.Site + ": ")
+	}
+	b.WriteString(e.Msg)
+	return b.String()
+}
+
+// pos returns File:Line, or File if Line is 0.
+func (e LinkError) pos() string {
+	if e.Line > 0 {
+		return fmt.Sprintf("%s:%d", e.File, e.Line)
+	}
+	return e.File
+}
+
+// LinkErrors are all the problems a link found, one per line, sorted by
+// site.
+type LinkErrors []LinkError
+
+func (es LinkErrors) Error() string {
+	lines := make([]string, len(es))
+	for i, e := range es {
+		lines[i] = e.Error()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// sorted returns es sorted by file, line, site and message, with repeats
+// removed.
+func (es LinkErrors) sorted() LinkErrors {
+	s := append(LinkErrors(nil), es...)
+	sort.Slice(s, func(i, j int) bool {
+		a, b := s[i], s[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		if a.Site != b.Site {
+			return a.Site < b.Site
+		}
+		return a.Msg < b.Msg
+	})
+	out := s[:0]
+	for i, e := range s {
+		if i == 0 || e != s[i-1] {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// refKind is how a reference uses the symbol it names.
+type refKind int
+
+const (
+	refPointer refKind = iota // an absolute pointer in a var, data block or ELF section
+	refBranch                 // the rel32 of a CALL, JMP or Jcc
+	refAddress                // the displacement of an LEA
+	refLoad                   // the displacement of any other memory operand
+)
+
+// codeRefKind returns the kind of the rel32 at off in the code bs. A
+// RIP-relative memory operand's ModRM byte always has the form 00xxx101,
+// so it is never E8 or E9, and never follows a 0F escape directly, which
+// tells it apart from a branch opcode.
+func codeRefKind(bs []byte, off uint64) refKind {
+	switch {
+	case off >= 1 && (bs[off-1] == 0xE8 || bs[off-1] == 0xE9):
+		return refBranch
+	case off >= 2 && bs[off-2] == 0x0F && bs[off-1]&0xF0 == 0x80:
+		return refBranch
+	case off >= 2 && bs[off-2] == 0x8D:
+		return refAddress
+	}
+	return refLoad
+}
+
+// linkSymbols are the symbols defined by the objects of a link, and the
+// archive members pulled into it.
+type linkSymbols struct {
+	funcs   map[string]*Function
+	data    map[string]*Var
+	vars    map[string]*Var
+	elfsyms map[string]*elfInputSymbol // the global symbols of the ELF objects
+	pkgs    map[string]string          // the file each package was loaded from
+	defs    map[string]LinkError       // where each symbol was defined
+	as      []*Archive
+	errs    LinkErrors
+}
+
+func newLinkSymbols(as []*Archive, es []*ELFObject) *linkSymbols {
+	t := &linkSymbols{
+		funcs:   make(map[string]*Function),
+		data:    make(map[string]*Var),
+		vars:    make(map[string]*Var),
+		elfsyms: make(map[string]*elfInputSymbol),
+		pkgs:    make(map[string]string),
+		defs:    make(map[string]LinkError),
+		as:      as,
+	}
+	for _, e := range es {
+		defs := e.defines()
+		for _, name := range sortedKeys(defs) {
+			if t.define(name, LinkError{File: e.Filename}) {
+				t.elfsyms[name] = defs[name]
+			}
+		}
+	}
+	return t
+}
+
+func (t *linkSymbols) fail(site LinkError, format string, args ...interface{}) {
+	site.Msg = fmt.Sprintf(format, args...)
+	t.errs = append(t.errs, site)
+}
+
+// define records that name is defined at site, and reports whether it was
+// not defined already.
+func (t *linkSymbols) define(name string, site LinkError) bool {
+	if prev, ok := t.defs[name]; ok {
+		t.fail(site, "duplicate definition of %s; previously defined at %s", name, prev.pos())
+		return false
+	}
+	t.defs[name] = site
+	return true
+}
+
+// addObject adds the symbols of o. A second object of a package already
+// loaded is reported and left out, rather than reporting each of its
+// symbols again.
+func (t *linkSymbols) addObject(o *OFile) {
+	site := LinkError{File: o.Filename}
+	if o.Pkgname == "" {
+		t.fail(site, "object file has no package name")
+		return
+	}
+	if prev, ok := t.pkgs[o.Pkgname]; ok {
+		t.fail(site, "duplicate package %s; previously loaded from %s", o.Pkgname, prev)
+		return
+	}
+	t.pkgs[o.Pkgname] = o.Filename
+	// All defined functions live under their qualified name (pkg.func).
+	// The compiler always emits fully-qualified call symbols, so the
+	// linker never needs to resolve a bare function name.
+	for _, fname := range sortedKeys(o.Funcs) {
+		f := o.Funcs[fname]
+		qname := qualify(o.Pkgname, fname)
+		if t.define(qname, LinkError{File: f.SrcFile, Line: f.SrcLine, Site: qname}) {
+			t.funcs[qname] = f
+		}
+	}
+	for _, dname := range sortedKeys(o.Data) {
+		v := o.Data[dname]
+		qname := qualify(o.Pkgname, dname)
+		qualifyDataRelocs(v, o.Pkgname)
+		if t.define(qname, LinkError{File: o.Filename, Site: qname}) {
+			t.data[qname] = v
+		}
+	}
+	for _, vname := range sortedKeys(o.Vars) {
+		v := o.Vars[vname]
+		qname := qualify(o.Pkgname, vname)
+		qualifyDataRelocs(v, o.Pkgname)
+		if t.define(qname, LinkError{File: o.Filename, Site: qname}) {
+			t.vars[qname] = v
+		}
+	}
+}
+
+// pull loads the archive member that defines sym, unless sym is already
+// defined or the member's package is already loaded. Symbols are pulled
+// as the walk from the entry point reaches them, so only the members
+// something reachable refers to are linked.
+func (t *linkSymbols) pull(sym string) {
+	if _, ok := t.defs[sym]; ok {
+		return
+	}
+	for _, a := range t.as {
+		m := a.Lookup(sym)
+		if m == nil {
+			continue
+		}
+		if _, ok := t.pkgs[m.Pkgname]; ok {
+			continue
+		}
+		o, err := m.Object()
+		if err != nil {
+			t.fail(LinkError{File: a.Filename}, "%s", err)
+			return
+		}
+		t.addObject(o)
+		return
+	}
+}
+
+// kind returns what sym names, or "" if nothing defines it.
+func (t *linkSymbols) kind(sym string) string {
+	switch {
+	case t.funcs[sym] != nil:
+		return "function"
+	case t.vars[sym] != nil:
+		return "var"
+	case t.data[sym] != nil:
+		return "data"
+	case linkSyms[sym]:
+		return "linker symbol"
+	}
+	if s := t.elfsyms[sym]; s != nil {
+		if s.sect != nil && s.sect.permission == F_EXEC {
+			return "function"
+		}
+		return "ELF symbol"
+	}
+	return ""
+}
+
+// check walks everything the link will place, from the entry point and
+// the ELF objects, and records every reference it cannot link: to a
+// symbol nothing defines, to a label its function does not export, a
+// branch to something other than code, or a load of a function's code as
+// data. Archive members are pulled as the walk reaches them, so once it
+// is done the symbols are all the link needs.
+func (t *linkSymbols) check(es []*ELFObject) {
+	seen := make(map[string]bool)
+	var work []*Function
+	var labels []LinkError // label references, with Msg holding the target
+	var ref func(site LinkError, sym string, kind refKind)
+	ref = func(site LinkError, sym string, kind refKind) {
+		t.pull(sym)
+		if fn, _, ok := splitLabelRef(sym); ok && t.elfsyms[sym] == nil {
+			t.pull(fn)
+			if t.funcs[fn] == nil {
+				t.fail(site, "undefined function %s referenced by %s", fn, sym)
+				return
+			}
+			site.Msg = sym
+			labels = append(labels, site)
+			ref(site, fn, refAddress)
+			return
+		}
+		k := t.kind(sym)
+		switch {
+		case k == "":
+			t.fail(site, "undefined symbol %s", sym)
+			return
+		case k == "function" && kind == refLoad:
+			t.fail(site, "loads function %s as data", sym)
+		case k != "function" && kind == refBranch:
+			t.fail(site, "branches to %s %s, which is not a function", k, sym)
+		}
+		if seen[sym] {
+			return
+		}
+		seen[sym] = true
+		if f := t.funcs[sym]; f != nil {
+			work = append(work, f)
+			return
+		}
+		v := t.vars[sym]
+		if v == nil {
+			v = t.data[sym]
+		}
+		if v == nil {
+			return
+		}
+		for _, dr := range v.Relocs {
+			ref(LinkError{File: t.defs[sym].File, Site: sym}, dr.Symbol, refPointer)
+		}
+	}
+
+	t.pull("_init.start")
+	if t.funcs["_init.start"] == nil {
+		t.fail(LinkError{}, "no function _init.start (the entry point must be defined in package _init)")
+	} else {
+		seen["_init.start"] = true
+		work = append(work, t.funcs["_init.start"])
+	}
+	for _, e := range es {
+		for _, sect := range e.sections {
+			for _, r := range sect.relocs {
+				s := e.symbols[r.sym]
+				if s.sect != nil || s.abs {
+					continue
+				}
+				kind := refPointer
+				if sect.permission == F_EXEC && (r.typ == elf.R_X86_64_PC32 || r.typ == elf.R_X86_64_PLT32) {
+					kind = codeRefKind(sect.val, r.offset)
+				}
+				ref(LinkError{File: e.Filename, Site: sect.name}, s.name, kind)
+			}
+		}
+	}
+	for len(work) > 0 {
+		f := work[0]
+		work = work[1:]
+		qname := qualify(f.Pkgname, f.Name)
+		bs, err := f.Body()
+		if err != nil {
+			t.fail(LinkError{File: f.SrcFile, Line: f.SrcLine, Site: qname}, "%s", err)
+			continue
+		}
+		for _, r := range f.Relocations {
+			file, line := f.sourcePos(r.Offset)
+			if _, _, ok := splitLabelRef(r.Symbol); ok && t.elfsyms[r.Symbol] == nil {
+				// Code only branches to the labels of its own function.
+				t.fail(LinkError{File: file, Line: line, Site: qname}, "undefined symbol %s", r.Symbol)
+				continue
+			}
+			ref(LinkError{File: file, Line: line, Site: qname}, r.Symbol, codeRefKind(bs, uint64(r.Offset)))
+		}
+	}
+	for _, l := range labels {
+		fn, label, _ := splitLabelRef(l.Msg)
+		f := t.funcs[fn]
+		exported := false
+		for _, s := range f.Symbols {
+			exported = exported || s.Name == label
+		}
+		if !exported {
+			t.fail(l, "function %s does not export label %s", fn, label)
+		}
+	}
+}
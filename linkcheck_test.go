@@ -0,0 +1,138 @@
+package gbasm
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestLinkErrors(t *testing.T) {
+	// The ELF object defines main.dup, which main.bo defines again.
+	c, err := NewOFile("cm.o", "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dup, err := c.NewFunction("cm.s", 1, "dup")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dup.Instr("RET")
+	var b bytes.Buffer
+	if err := c.WriteELF(&b); err != nil {
+		t.Fatal(err)
+	}
+	e, err := readELF(bytes.NewReader(b.Bytes()), "cm.o", "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	init, err := NewOFile("init.bo", "_init")
+	if err != nil {
+		t.Fatal(err)
+	}
+	start, err := init.NewFunction("init.bs", 1, "start")
+	if err != nil {
+		t.Fatal(err)
+	}
+	start.Jump("CALL", "main.main")
+	start.Instr("RET")
+
+	o, err := NewOFile("main.bo", "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range []string{"counter", "slot", "ptr", "tab"} {
+		if err := o.AddVar(v, "i64", uint64(0), false); err != nil {
+			t.Fatal(err)
+		}
+	}
+	o.Vars["ptr"].Relocs = []DataReloc{{Offset: 0, Symbol: "gone"}}
+	o.Vars["tab"].Relocs = []DataReloc{{Offset: 0, Symbol: LabelRef("main.helper", "nolabel")}}
+	m, err := o.NewFunction("main.bs", 3, "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.SourceLine("main.bs", 4)
+	m.Jump("CALL", "other.missing")
+	m.SourceLine("main.bs", 5)
+	m.Jump("CALL", "main.counter")
+	m.SourceLine("main.bs", 6)
+	m.Instr("LEA", R_RAX, o.Vars["ptr"])
+	m.Instr("LEA", R_RAX, o.Vars["tab"])
+	m.Instr("MOV", R_RAX, o.Vars["slot"])
+	m.Instr("RET")
+	helper, err := o.NewFunction("main.bs", 10, "helper")
+	if err != nil {
+		t.Fatal(err)
+	}
+	helper.Jump("CALL", "main.main")
+	helper.Instr("RET")
+	dup2, err := o.NewFunction("main.bs", 12, "dup")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dup2.Instr("RET")
+	for _, f := range []*Function{start, m, helper, dup2} {
+		if err := f.Resolve(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// The MOV loads main.helper rather than the var.
+	for i, r := range m.Relocations {
+		if r.Symbol == "main.slot" {
+			m.Relocations[i].Symbol = "main.helper"
+		}
+	}
+
+	again, err := NewOFile("main2.bo", "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := again.NewFunction("main2.bs", 1, "other"); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = link([]*OFile{init, o, again}, nil, []*ELFObject{e}, ENTRY_ADDR, false)
+	errs, ok := err.(LinkErrors)
+	if !ok {
+		t.Fatalf("Expected LinkErrors, got %v", err)
+	}
+	want := []string{
+		"main.bo: main.ptr: undefined symbol main.gone",
+		"main.bo: main.tab: function main.helper does not export label nolabel",
+		"main.bs:4: main.main: undefined symbol other.missing",
+		"main.bs:5: main.main: branches to var main.counter, which is not a function",
+		"main.bs:6: main.main: loads function main.helper as data",
+		"main.bs:12: main.dup: duplicate definition of main.dup; previously defined at cm.o",
+		"main2.bo: duplicate package main; previously loaded from main.bo",
+	}
+	if got := errs.Error(); got != strings.Join(want, "\n") {
+		t.Errorf("Expected errors:\n%s\ngot:\n%s", strings.Join(want, "\n"), got)
+	}
+
+	if _, err := link([]*OFile{o}, nil, nil, ENTRY_ADDR, false); err == nil ||
+		!strings.Contains(err.Error(), "no function _init.start") {
+		t.Errorf("Expected a link without an entry point to fail, got %v", err)
+	}
+}
+
+func TestCodeRefKind(t *testing.T) {
+	for _, tt := range []struct {
+		bs   []byte
+		want refKind
+	}{
+		{[]byte{0xE8}, refBranch},                 // CALL rel32
+		{[]byte{0xE9}, refBranch},                 // JMP rel32
+		{[]byte{0x0F, 0x85}, refBranch},           // JNE rel32
+		{[]byte{0x48, 0x8D, 0x05}, refAddress},    // LEA RAX, [RIP+rel32]
+		{[]byte{0x48, 0x8B, 0x05}, refLoad},       // MOV RAX, [RIP+rel32]
+		{[]byte{0x0F, 0x10, 0x05}, refLoad},       // MOVUPS XMM0, [RIP+rel32]
+		{[]byte{0xFF, 0x15}, refLoad},             // CALL [RIP+rel32]
+		{[]byte{0x48, 0xC7, 0x05}, refLoad},       // MOV qword [RIP+rel32], imm32
+		{[]byte{0x66, 0x0F, 0x2E, 0x05}, refLoad}, // UCOMISD XMM0, [RIP+rel32]
+	} {
+		if got := codeRefKind(tt.bs, uint64(len(tt.bs))); got != tt.want {
+			t.Errorf("% x: expected %v, got %v", tt.bs, tt.want, got)
+		}
+	}
+}
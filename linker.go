@@ -2,8 +2,10 @@ package gbasm
 
 import (
 	"bytes"
+	"debug/elf"
+	"encoding/binary"
 	"fmt"
-	"log"
+	"sort"
 	"strings"
 	//"github.com/knusbaum/gbasm/elf"
 )
@@ -60,6 +62,23 @@ func LinkedBinToElfSections(b LinkedBin) []Elf64_Section {
 			loadable: true,
 			syms:     SectSymsToElf64_Symbols(s.symbols),
 		}
+		if strings.HasPrefix(s.Name, ".debug_") || s.Name == ".dynstr" {
+			// Debug info is only read from the file, and nothing
+			// looks up a dynamic string at run time.
+			es.flags, es.addr, es.loadable = 0, 0, false
+		}
+		switch s.Name {
+		case ".bss":
+			es.s_type = SHT_NOBITS
+			es.nobits = Elf64_Xword(s.bss)
+		case ".rela.dyn":
+			es.s_type = SHT_RELA
+		case ".dynamic":
+			es.s_type = SHT_DYNAMIC
+			es.link = ".dynstr"
+		case ".dynstr":
+			es.s_type = SHT_STRTAB
+		}
 		switch s.permission {
 		case F_WRITE:
 			es.flags |= SHF_WRITE
@@ -71,13 +90,18 @@ func LinkedBinToElfSections(b LinkedBin) []Elf64_Section {
 	return ret
 }
 
-func LinkExe(exename string, p platform, os []*OFile) error {
+// LinkExe links os and es, and the members of as that they need, into an
+// executable. With pie, the executable is position-independent (see
+// LinkPIE). A link that fails returns LinkErrors, as Link does.
+func LinkExe(exename string, p platform, os []*OFile, as []*Archive, es []*ELFObject, pie bool) error {
 	switch p {
 	case MACHO:
 		panic("MACH NOT IMPLEMENTED.\n")
 	case ELF:
-		bin := Link(os, ENTRY_ADDR)
-
+		bin, err := link(os, as, es, ENTRY_ADDR, pie)
+		if err != nil {
+			return err
+		}
 		//return WriteELF(exename, bin)
 		WriteElf(exename, LinkedBinToElfSections(bin))
 		return nil
@@ -113,6 +137,9 @@ type Section struct {
 	val        []byte
 	permission int
 	symbols    []SectSym
+	// bss is the size of a section of zero bytes, such as .bss, which has
+	// no val and takes no space in the executable.
+	bss int
 }
 
 type LinkedBin struct {
@@ -131,51 +158,62 @@ func qualify(pkg, name string) string {
 // package-qualified, mirroring the qualification that function.go
 // applies to code-side Relocation Symbols. A target containing a
 // '.' is assumed to already be qualified (cross-package reference)
-// and left untouched.
+// and left untouched. A target naming a label (see LabelRef) is qualified
+// by its function.
 func qualifyDataRelocs(v *Var, pkgname string) {
 	for i := range v.Relocs {
 		s := v.Relocs[i].Symbol
-		if !strings.ContainsRune(s, '.') {
+		if !isQualified(s) {
 			v.Relocs[i].Symbol = qualify(pkgname, s)
 		}
 	}
 }
 
-func Link(os []*OFile, textoff uint64) LinkedBin {
-	funcs := make(map[string]*Function)
-	data := make(map[string]*Var)
-	vars := make(map[string]*Var)
+// Symbols in package _link are defined by the linker rather than by an
+// object file. _link.base is an 8-byte word holding its own link-time
+// address, so code can find how far the image was moved from where it was
+// linked. _link.rela and _link.erela bound the R_X86_64_RELATIVE
+// relocations of a position-independent link; they are equal otherwise.
+// _link.rodata and _link.erodata bound the .rodata section, which a
+// position-independent link maps writable when it holds pointers, so
+// _init.start can make it read-only once it has relocated them.
+var linkSyms = map[string]bool{
+	"_link.base":    true,
+	"_link.rela":    true,
+	"_link.erela":   true,
+	"_link.rodata":  true,
+	"_link.erodata": true,
+}
+
+// Link links os with the text section at textoff. If any symbol cannot be
+// linked, the error is the LinkErrors describing every problem found.
+func Link(os []*OFile, textoff uint64) (LinkedBin, error) {
+	return link(os, nil, nil, textoff, false)
+}
+
+// LinkPIE links os as a position-independent executable. The code only
+// addresses memory relative to RIP, so it runs wherever it is loaded, but
+// every pointer a var or data block is initialized with is an absolute
+// address. LinkPIE records each one as an R_X86_64_RELATIVE relocation in
+// a .rela.dyn section, with a .dynamic section describing them, and
+// _init.start applies them before anything reads a pointer.
+func LinkPIE(os []*OFile, textoff uint64) (LinkedBin, error) {
+	return link(os, nil, nil, textoff, true)
+}
+
+// link links os, the archive members they need and es, with the text
+// section at textoff. Before placing anything it resolves every reference
+// the link reaches, and returns LinkErrors for all that cannot be linked.
+func link(os []*OFile, as []*Archive, es []*ELFObject, textoff uint64, pie bool) (LinkedBin, error) {
+	t := newLinkSymbols(as, es)
 	for _, o := range os {
-		for fname, f := range o.Funcs {
-			// All defined functions live under their qualified name (pkg.func).
-			// The compiler always emits fully-qualified call symbols, so the
-			// linker never needs to resolve a bare function name.
-			if o.Pkgname == "" {
-				log.Fatalf("object file %s has no package name", o.Filename)
-			}
-			qname := qualify(o.Pkgname, fname)
-			if _, ok := funcs[qname]; ok {
-				log.Fatalf("Duplicate definitions of function %s", qname)
-			}
-			funcs[qname] = f
-		}
-		for dname, v := range o.Data {
-			qname := qualify(o.Pkgname, dname)
-			if _, ok := data[qname]; ok {
-				log.Fatalf("Duplicate definitions of data %s", qname)
-			}
-			qualifyDataRelocs(v, o.Pkgname)
-			data[qname] = v
-		}
-		for vname, v := range o.Vars {
-			qname := qualify(o.Pkgname, vname)
-			if _, ok := vars[qname]; ok {
-				log.Fatalf("Duplicate definitions of data %s", qname)
-			}
-			qualifyDataRelocs(v, o.Pkgname)
-			vars[qname] = v
-		}
+		t.addObject(o)
 	}
+	t.check(es)
+	if len(t.errs) > 0 {
+		return LinkedBin{}, t.errs.sorted()
+	}
+	funcs, data, vars, elfsyms := t.funcs, t.data, t.vars, t.elfsyms
 
 	needfnm := make(map[*Function]struct{})
 	needfn := make([]*Function, 1)
@@ -192,21 +230,22 @@ func Link(os []*OFile, textoff uint64) LinkedBin {
 	//needvar := make([]*Var, 0)
 	// The ELF entry point. The init runtime package _init exports `start`,
 	// which calls the user's main and exits.
-	main, ok := funcs["_init.start"]
-	if !ok {
-		log.Fatalf("No such function _init.start (the entry point must be defined in package _init)")
-	}
-	needfn[0] = main
+	needfn[0] = funcs["_init.start"]
 	relocations := make([]Relocation, 0)
 	funclocs := make(map[string]uint32)
+	labellocs := make(map[string]uint32) // exported labels, by LabelRef
 	varlocs := make(map[string]uint32)
+	bsslocs := make(map[string]uint32) // zero-filled vars
 	datalocs := make(map[string]uint32)
 
+	var debugFuncs []debugFunc
 	funcsyms := make([]SectSym, 0)
 	varsyms := make([]SectSym, 0)
+	bsssyms := make([]SectSym, 0)
 	datasyms := make([]SectSym, 0)
 
 	var fnbs, varbs, databs bytes.Buffer
+	var bsslen int
 
 	// addVar / addData place a var/data block into the appropriate
 	// section if not already present, and recursively follow any
@@ -215,6 +254,15 @@ func Link(os []*OFile, textoff uint64) LinkedBin {
 	// (function-pointer init) too; those go through addNeeded.
 	var addVar, addData func(string)
 	addNeededDataReloc := func(target string) {
+		if _, ok := elfsyms[target]; ok || linkSyms[target] {
+			return
+		}
+		if fn, _, ok := splitLabelRef(target); ok {
+			if _, placed := funclocs[fn]; !placed {
+				addNeeded(funcs[fn])
+			}
+			return
+		}
 		if _, ok := funcs[target]; ok {
 			if _, placed := funclocs[target]; !placed {
 				addNeeded(funcs[target])
@@ -225,17 +273,29 @@ func Link(os []*OFile, textoff uint64) LinkedBin {
 			addVar(target)
 			return
 		}
-		if _, ok := data[target]; ok {
-			addData(target)
-			return
-		}
-		log.Fatalf("No such symbol %s referenced by data relocation", target)
+		addData(target)
 	}
 	addVar = func(name string) {
 		if _, placed := varlocs[name]; placed {
 			return
 		}
+		if _, placed := bsslocs[name]; placed {
+			return
+		}
 		v := vars[name]
+		if v.ZeroFill > 0 {
+			bsslen += padLen(bsslen, v.Align)
+			bsslocs[name] = uint32(bsslen)
+			bsssyms = append(bsssyms, SectSym{
+				Name:    name,
+				Type:    SYM_OBJECT,
+				Address: uint64(bsslen),
+				Size:    v.ZeroFill,
+			})
+			bsslen += v.ZeroFill
+			return
+		}
+		varbs.Write(make([]byte, padLen(varbs.Len(), v.Align)))
 		loc := uint32(varbs.Len())
 		varbs.Write(v.Val)
 		varlocs[name] = loc
@@ -254,6 +314,7 @@ func Link(os []*OFile, textoff uint64) LinkedBin {
 			return
 		}
 		v := data[name]
+		databs.Write(make([]byte, padLen(databs.Len(), v.Align)))
 		loc := uint32(databs.Len())
 		databs.Write(v.Val)
 		datalocs[name] = loc
@@ -267,6 +328,67 @@ func Link(os []*OFile, textoff uint64) LinkedBin {
 			addNeededDataReloc(dr.Symbol)
 		}
 	}
+	// placeELF places the ELF sections with permission in bs, and adds
+	// their global symbols to syms.
+	placeELF := func(bs *bytes.Buffer, syms *[]SectSym, permission int) {
+		for _, e := range es {
+			for _, sect := range e.sections {
+				if sect.permission != permission || sect.nobits {
+					continue
+				}
+				if permission == F_EXEC {
+					bs.Write(appendNops(nil, padLen(bs.Len(), sect.align)))
+				} else {
+					bs.Write(make([]byte, padLen(bs.Len(), sect.align)))
+				}
+				sect.off = uint32(bs.Len())
+				bs.Write(sect.val)
+				typ := SYM_OBJECT
+				if permission == F_EXEC {
+					typ = SYM_FUNC
+				}
+				for _, s := range e.symbols {
+					if s.global && s.sect == sect {
+						*syms = append(*syms, SectSym{
+							Name:    s.name,
+							Type:    typ,
+							Address: uint64(sect.off) + s.value,
+							Size:    int(s.size),
+						})
+					}
+				}
+			}
+		}
+	}
+	// Everything the ELF objects refer to is needed. Their data is placed
+	// now, and their code after _init.start, which must come first.
+	for _, e := range es {
+		for _, sym := range e.undefined() {
+			addNeededDataReloc(sym)
+		}
+	}
+	placeELF(&varbs, &varsyms, F_WRITE)
+	placeELF(&databs, &datasyms, F_READ)
+	for _, e := range es {
+		for _, sect := range e.sections {
+			if !sect.nobits {
+				continue
+			}
+			bsslen += padLen(bsslen, sect.align)
+			sect.off = uint32(bsslen)
+			bsslen += int(sect.size)
+			for _, s := range e.symbols {
+				if s.global && s.sect == sect {
+					bsssyms = append(bsssyms, SectSym{
+						Name:    s.name,
+						Type:    SYM_OBJECT,
+						Address: uint64(sect.off) + s.value,
+						Size:    int(s.size),
+					})
+				}
+			}
+		}
+	}
 
 	for len(needfn) > 0 {
 		current := needfn[0]
@@ -274,8 +396,11 @@ func Link(os []*OFile, textoff uint64) LinkedBin {
 		//fmt.Printf("Adding function [%s]\n", current.Name)
 		fbs, err := current.Body()
 		if err != nil {
-			log.Fatalf("Failed to resolve function body: %s", err)
+			return LinkedBin{}, fmt.Errorf("Failed to resolve body of %s: %w", qualify(current.Pkgname, current.Name), err)
 		}
+		// Sections start on a page boundary, so aligning the offset
+		// aligns the address.
+		fnbs.Write(appendNops(nil, padLen(fnbs.Len(), current.Align)))
 		foffset := uint32(fnbs.Len())
 		// All relocations are qualified, so funclocs uses qualified names.
 		qname := qualify(current.Pkgname, current.Name)
@@ -286,6 +411,10 @@ func Link(os []*OFile, textoff uint64) LinkedBin {
 			Address: uint64(foffset),
 			Size:    len(fbs),
 		})
+		debugFuncs = append(debugFuncs, debugFunc{f: current, name: qname, addr: uint64(foffset), size: len(fbs)})
+		for _, s := range current.Symbols {
+			labellocs[LabelRef(qname, s.Name)] = foffset + s.Offset
+		}
 		for _, r := range current.Relocations {
 			if fn, ok := funcs[r.Symbol]; ok {
 				if _, ok := funclocs[r.Symbol]; !ok {
@@ -295,29 +424,91 @@ func Link(os []*OFile, textoff uint64) LinkedBin {
 				addVar(r.Symbol)
 			} else if _, ok := data[r.Symbol]; ok {
 				addData(r.Symbol)
-			} else {
-				log.Fatalf("No such symbol %s", r.Symbol)
 			}
 			r.Offset += foffset
 			relocations = append(relocations, r)
 		}
 		_, err = fnbs.Write(fbs)
 		if err != nil {
-			log.Fatalf("Failed to write body: %s", err)
+			return LinkedBin{}, fmt.Errorf("Failed to write body of %s: %w", qname, err)
 		}
 	}
+	placeELF(&fnbs, &funcsyms, F_EXEC)
+	databs.Write(make([]byte, padLen(databs.Len(), 8)))
+	baseloc := uint32(databs.Len())
+	databs.Write(make([]byte, 8))
+	datasyms = append(datasyms, SectSym{
+		Name:    "_link.base",
+		Type:    SYM_OBJECT,
+		Address: uint64(baseloc),
+		Size:    8,
+	})
+
 	text := fnbs.Bytes()
 	vardat := varbs.Bytes()
 	datadat := databs.Bytes()
 	varoff := (textoff + uint64(len(text)) + 0x1000) & 0xFFFFFFFFFFFFF000
-	dataoff := (varoff + uint64(len(vardat)) + 0x1000) & 0xFFFFFFFFFFFFF000
+	bssoff := (varoff + uint64(len(vardat)) + 0x1000) & 0xFFFFFFFFFFFFF000
+	dataoff := (bssoff + uint64(bsslen) + 0x1000) & 0xFFFFFFFFFFFFF000
+	binary.LittleEndian.PutUint64(datadat[baseloc:], dataoff+uint64(baseloc))
+
+	// Without -pie the relocation table is empty, and sits just past
+	// _link.base.
+	nrela := 0
+	if pie {
+		for name := range varlocs {
+			nrela += len(vars[name].Relocs)
+		}
+		for name := range datalocs {
+			nrela += len(data[name].Relocs)
+		}
+		for _, e := range es {
+			nrela += e.absRelocs()
+		}
+	}
+	relaoff := dataoff + uint64(len(datadat))
+	if pie {
+		relaoff = (dataoff + uint64(len(datadat)) + 0x1000) & 0xFFFFFFFFFFFFF000
+	}
+	linklocs := map[string]uint64{
+		"_link.base":    dataoff + uint64(baseloc),
+		"_link.rela":    relaoff,
+		"_link.erela":   relaoff + uint64(nrela*Elf64_RelaSize),
+		"_link.rodata":  dataoff,
+		"_link.erodata": dataoff + uint64(len(datadat)),
+	}
+
+	elfSectionVA := func(sect *elfInputSection) uint64 {
+		if sect.nobits {
+			return bssoff + uint64(sect.off)
+		}
+		switch sect.permission {
+		case F_EXEC:
+			return textoff + uint64(sect.off)
+		case F_WRITE:
+			return varoff + uint64(sect.off)
+		}
+		return dataoff + uint64(sect.off)
+	}
+	elfSymbolVA := func(s *elfInputSymbol) uint64 {
+		if s.abs {
+			return s.value
+		}
+		return elfSectionVA(s.sect) + s.value
+	}
 
 	for i := range funcsyms {
 		funcsyms[i].Address += textoff
 	}
+	for i := range debugFuncs {
+		debugFuncs[i].addr += textoff
+	}
 	for i := range varsyms {
 		varsyms[i].Address += varoff
 	}
+	for i := range bsssyms {
+		bsssyms[i].Address += bssoff
+	}
 	for i := range datasyms {
 		datasyms[i].Address += dataoff
 	}
@@ -330,49 +521,190 @@ func Link(os []*OFile, textoff uint64) LinkedBin {
 			value += uint32(varoff - textoff)
 			//log.Printf("APPLYING RELOCATION AT OFFSET 0x%02x to symbol %s at offset 0x%02x", r.offset, r.symbol, value)
 			r.Apply(text, int32(value))
+		} else if value, ok := bsslocs[r.Symbol]; ok {
+			value += uint32(bssoff - textoff)
+			r.Apply(text, int32(value))
 		} else if value, ok := datalocs[r.Symbol]; ok {
 			value += uint32(dataoff - textoff)
 			//log.Printf("APPLYING RELOCATION AT OFFSET 0x%02x to symbol %s at offset 0x%02x", r.offset, r.symbol, value)
 			r.Apply(text, int32(value))
+		} else if s, ok := elfsyms[r.Symbol]; ok {
+			r.Apply(text, int32(elfSymbolVA(s)-textoff))
+		} else if va, ok := linklocs[r.Symbol]; ok {
+			r.Apply(text, int32(va-textoff))
 		} else {
-			log.Fatalf("THIS SHOULD NEVER HAPPEN. WE CHECKED ABOVE.")
+			// check reported every reference nothing defines.
+			return LinkedBin{}, fmt.Errorf("Internal linker error: relocation to %s, which was not placed", r.Symbol)
 		}
 	}
 
 	// Data-section relocations: for each placed var (and data block),
 	// walk its Relocs and write the 8-byte absolute VA of each target
 	// into the appropriate pointer slot.
-	resolveTargetVA := func(target string) uint64 {
+	resolveTargetVA := func(target string) (uint64, error) {
 		if off, ok := funclocs[target]; ok {
-			return textoff + uint64(off)
+			return textoff + uint64(off), nil
+		}
+		if off, ok := labellocs[target]; ok {
+			return textoff + uint64(off), nil
+		}
+		if s, ok := elfsyms[target]; ok {
+			return elfSymbolVA(s), nil
+		}
+		if va, ok := linklocs[target]; ok {
+			return va, nil
 		}
 		if off, ok := varlocs[target]; ok {
-			return varoff + uint64(off)
+			return varoff + uint64(off), nil
+		}
+		if off, ok := bsslocs[target]; ok {
+			return bssoff + uint64(off), nil
 		}
 		if off, ok := datalocs[target]; ok {
-			return dataoff + uint64(off)
+			return dataoff + uint64(off), nil
 		}
-		log.Fatalf("Data relocation target %s was not placed (linker bug — addVar should have followed the reloc)", target)
-		return 0
+		// addVar and addData follow every reloc of what they place.
+		return 0, fmt.Errorf("Internal linker error: data relocation target %s was not placed", target)
 	}
-	for name, loc := range varlocs {
-		v := vars[name]
+	// A -pie link also records where each pointer was written and what
+	// it points to, so _init.start can add the load bias to it.
+	var rela []Elf64_Rela
+	relocate := func(secoff uint64, bs []byte, loc uint32, v *Var) error {
 		for _, dr := range v.Relocs {
-			dr.Apply(vardat[loc:], resolveTargetVA(dr.Symbol))
+			target, err := resolveTargetVA(dr.Symbol)
+			if err != nil {
+				return err
+			}
+			dr.Apply(bs[loc:], target)
+			if pie {
+				rela = append(rela, Elf64_Rela{
+					r_offset: Elf64_Addr(secoff + uint64(loc+dr.Offset)),
+					r_info:   R_X86_64_RELATIVE,
+					r_addend: Elf64_Sxword(int64(target) + dr.Addend),
+				})
+			}
+		}
+		return nil
+	}
+	for name, loc := range varlocs {
+		if err := relocate(varoff, vardat, loc, vars[name]); err != nil {
+			return LinkedBin{}, err
 		}
 	}
+	rodata := F_READ
 	for name, loc := range datalocs {
-		v := data[name]
-		for _, dr := range v.Relocs {
-			dr.Apply(datadat[loc:], resolveTargetVA(dr.Symbol))
+		if pie && len(data[name].Relocs) > 0 {
+			// _init.start writes the relocated pointers, then makes
+			// .rodata read-only again.
+			rodata = F_WRITE
+		}
+		if err := relocate(dataoff, datadat, loc, data[name]); err != nil {
+			return LinkedBin{}, err
 		}
 	}
+	// Only now are the addresses known, so a field too small for one is
+	// the last kind of error a link finds.
+	var errs LinkErrors
+	for _, e := range es {
+		for _, sect := range e.sections {
+			if sect.nobits {
+				continue
+			}
+			var bs []byte
+			switch sect.permission {
+			case F_EXEC:
+				bs = text[sect.off:]
+			case F_WRITE:
+				bs = vardat[sect.off:]
+			default:
+				bs = datadat[sect.off:]
+			}
+			for _, r := range sect.relocs {
+				s := &e.symbols[r.sym]
+				var target uint64
+				if s.sect != nil || s.abs {
+					target = elfSymbolVA(s)
+				} else if va, ok := linklocs[s.name]; ok {
+					target = va
+				} else {
+					va, err := resolveTargetVA(s.name)
+					if err != nil {
+						return LinkedBin{}, err
+					}
+					target = va
+				}
+				site := LinkError{File: e.Filename, Site: sect.name}
+				if pie && r.typ == elf.R_X86_64_32S && !s.abs {
+					site.Msg = fmt.Sprintf("R_X86_64_32S relocation at %#x cannot be linked position-independent; compile with -fpie", r.offset)
+					errs = append(errs, site)
+					continue
+				}
+				place := elfSectionVA(sect) + r.offset
+				if err := r.apply(bs, target, place); err != nil {
+					site.Msg = err.Error()
+					errs = append(errs, site)
+					continue
+				}
+				if !pie || r.typ != elf.R_X86_64_64 || s.abs {
+					continue
+				}
+				if sect.permission == F_READ {
+					// _init.start writes the relocated pointers, then
+					// makes .rodata read-only again.
+					rodata = F_WRITE
+				}
+				rela = append(rela, Elf64_Rela{
+					r_offset: Elf64_Addr(place),
+					r_info:   R_X86_64_RELATIVE,
+					r_addend: Elf64_Sxword(int64(target) + r.addend),
+				})
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return LinkedBin{}, errs.sorted()
+	}
 	//return text
-	return LinkedBin{
+	// Each section starts on its own page, so WriteElf can map each with
+	// only the access it needs: data blocks are immutable, and go in
+	// .rodata rather than with the vars in .data. Zero-filled vars go in
+	// .bss, which the kernel maps as zero pages.
+	bin := LinkedBin{
 		Sections: []*Section{
 			&Section{Name: ".text", Offset: textoff, permission: F_EXEC, symbols: funcsyms, val: text},
 			&Section{Name: ".data", Offset: varoff, permission: F_WRITE, symbols: varsyms, val: vardat},
-			&Section{Name: ".bss", Offset: dataoff, permission: F_READ, symbols: datasyms, val: datadat},
+			&Section{Name: ".bss", Offset: bssoff, permission: F_WRITE, symbols: bsssyms, bss: bsslen},
+			&Section{Name: ".rodata", Offset: dataoff, permission: rodata, symbols: datasyms, val: datadat},
 		},
 	}
+	if pie {
+		bin.Sections = append(bin.Sections, dynamicSections(relaoff, rela)...)
+	}
+	bin.Sections = append(bin.Sections, debugSections(debugFuncs)...)
+	return bin, nil
+}
+
+// dynamicSections returns the .rela.dyn section holding rela, at relaoff,
+// the .dynamic section describing it, on the following page, and the
+// .dynstr string table .dynamic links to. No entry names a string, so
+// .dynstr holds only the empty one.
+func dynamicSections(relaoff uint64, rela []Elf64_Rela) []*Section {
+	sort.Slice(rela, func(i, j int) bool { return rela[i].r_offset < rela[j].r_offset })
+	var relabs bytes.Buffer
+	binary.Write(&relabs, binary.LittleEndian, rela)
+	dynoff := (relaoff + uint64(relabs.Len()) + 0x1000) & 0xFFFFFFFFFFFFF000
+	var dynbs bytes.Buffer
+	binary.Write(&dynbs, binary.LittleEndian, []Elf64_Dyn{
+		{DT_RELA, Elf64_Xword(relaoff)},
+		{DT_RELASZ, Elf64_Xword(relabs.Len())},
+		{DT_RELAENT, Elf64_RelaSize},
+		{DT_RELACOUNT, Elf64_Xword(len(rela))},
+		{DT_FLAGS_1, DF_1_PIE},
+		{DT_NULL, 0},
+	})
+	return []*Section{
+		&Section{Name: ".rela.dyn", Offset: relaoff, permission: F_READ, val: relabs.Bytes()},
+		&Section{Name: ".dynamic", Offset: dynoff, permission: F_READ, val: dynbs.Bytes()},
+		&Section{Name: ".dynstr", val: []byte{0}},
+	}
 }
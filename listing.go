@@ -0,0 +1,114 @@
+package gbasm
+
+// A ListEntry describes one encoded instruction of a function, for
+// assembly listings.
+type ListEntry struct {
+	Offset int    // offset of the instruction in the function body
+	Len    int    // encoded length in bytes
+	Form   string // the instruction form the encoder selected
+	Source string // the source line, as last given to ListSource
+	// Allocs gives the location each allocation operand resolved to, e.g.
+	// "n=RBX" or "buf=QWORD [RBP - 0x18]".
+	Allocs []string
+	// Note says why the register allocator inserted the instruction, e.g.
+	// "spill n". It is empty for the instructions the source asked for.
+	Note string
+}
+
+// A formListener is told which form an instruction is being encoded with
+// once its operands are resolved, just before the bytes are written.
+type formListener interface {
+	encodingForm(form *IForm, os []interface{})
+}
+
+// listWriter encodes into a function's body, noting the registers the
+// instruction written through it uses and where it is placed and, if
+// listing is enabled, recording a ListEntry for it.
+type listWriter struct {
+	f        *Function
+	entry    int
+	resolved []interface{}
+}
+
+func (w *listWriter) Write(p []byte) (int, error) {
+	return w.f.bs.Write(p)
+}
+
+func (w *listWriter) Len() int {
+	return w.f.bs.Len()
+}
+
+func (w *listWriter) encodingForm(form *IForm, os []interface{}) {
+	w.f.noteRegs(form, os)
+	w.f.notePlaced()
+	if w.f.listing == nil {
+		return
+	}
+	w.entry = len(w.f.listing)
+	w.resolved = append([]interface{}(nil), os...)
+	w.f.listing = append(w.f.listing, ListEntry{
+		Offset: w.f.bs.Len(),
+		Form:   form.String(),
+		Source: w.f.listSource,
+		Note:   w.f.listNote,
+	})
+}
+
+// finish completes the entry for an instruction encoded with ops. Any
+// instructions the allocator inserted while resolving ops were recorded
+// before it.
+func (w *listWriter) finish(ops []interface{}) {
+	if w == nil || w.entry < 0 {
+		return
+	}
+	e := &w.f.listing[w.entry]
+	e.Len = w.f.bs.Len() - e.Offset
+	for i, op := range ops {
+		if i >= len(w.resolved) {
+			break
+		}
+		switch ra := op.(type) {
+		case *Ralloc:
+			e.Allocs = append(e.Allocs, ra.sym+"="+formatOperand(w.resolved[i]))
+		case *RallocPartial:
+			e.Allocs = append(e.Allocs, ra.Ra.sym+"="+formatOperand(w.resolved[i]))
+		}
+	}
+}
+
+// writer returns where f encodes its next instruction, and the listWriter
+// recording it.
+func (f *Function) writer() (WriteLener, *listWriter) {
+	w := &listWriter{f: f, entry: -1}
+	return w, w
+}
+
+// EnableListing makes f record a ListEntry for every instruction it
+// encodes from now on.
+func (f *Function) EnableListing() {
+	if f.listing == nil {
+		f.listing = []ListEntry{}
+	}
+}
+
+// ListSource sets the source line recorded with the instructions that
+// follow.
+func (f *Function) ListSource(src string) {
+	f.listSource = src
+}
+
+// Listing returns the entries recorded since EnableListing. Once the
+// function is resolved they are at their final offsets.
+func (f *Function) Listing() []ListEntry {
+	return f.listing
+}
+
+// emit encodes an instruction the allocator needs on behalf of r, noting
+// why in the listing.
+func (r *Ralloc) emit(why string, instr string, ops ...interface{}) {
+	f := r.rallocs.f
+	prev := f.listNote
+	f.listNote = why + " " + r.sym
+	defer func() { f.listNote = prev }()
+	f.Instr(instr, ops...)
+}
@@ -0,0 +1,81 @@
+package gbasm
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestListing(t *testing.T) {
+	o, err := NewOFile("listing", "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := o.NewFunction("listing.bs", 1, "f")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.EnableListing()
+
+	f.ListSource("prologue")
+	f.Prologue()
+	f.ListSource("local a 64")
+	a, err := f.NewLocal("a", 64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.ListSource("mov a 1")
+	f.Instr("MOV", a, int8(1))
+	f.ListSource("label top")
+	f.Label("top")
+	f.ListSource("add a 2")
+	f.Instr("ADD", a, int8(2))
+	f.ListSource("jne top")
+	f.Jump("JNE", "top")
+
+	bs, err := f.Body()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ls := f.Listing()
+
+	// The entries tile the body.
+	off := 0
+	for _, e := range ls {
+		if e.Offset != off {
+			t.Fatalf("Entry %+v: expected offset %d", e, off)
+		}
+		off += e.Len
+	}
+	if off != len(bs) {
+		t.Fatalf("Listing covers %d bytes of a %d-byte body", off, len(bs))
+	}
+
+	find := func(src, note string) ListEntry {
+		for _, e := range ls {
+			if e.Source == src && e.Note == note {
+				return e
+			}
+		}
+		t.Fatalf("No entry for %q with note %q in %+v", src, note, ls)
+		return ListEntry{}
+	}
+
+	mov := find("mov a 1", "")
+	if !strings.HasPrefix(mov.Form, "MOVQ r64, imm") || len(mov.Allocs) != 1 || !strings.HasPrefix(mov.Allocs[0], "a=R") {
+		t.Errorf("mov a 1: got %+v", mov)
+	}
+	// The label spills a before the loop, and the loop reloads it.
+	spill := find("label top", "spill a")
+	if spill.Form != "MOVQ m64, r64" {
+		t.Errorf("spill: got %+v", spill)
+	}
+	find("add a 2", "reload a")
+	find("jne top", "spill a")
+	for _, e := range ls {
+		if e.Source == "jne top" && e.Note == "" {
+			if e.Len != 2 || e.Form != "JNE rel8" {
+				t.Errorf("jne top: expected the relaxed rel8 form, got %+v", e)
+			}
+		}
+	}
+}
@@ -0,0 +1,576 @@
+package gbasm
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// The JSON form of a .bo is for tools that should not have to link this
+// package: API diffs, size reports and the like. WriteJSON lists every
+// declaration sorted by name, so two dumps of the same build are identical
+// and dumps of two builds diff cleanly. Byte blocks (function bodies, var
+// payloads, type descriptions) are hex strings. ReadJSON turns a dump back
+// into the OFile it came from, and writing that out gives the .bo the dump
+// was made from.
+//
+// The export hash and the typedesc and iface_desc records decoded into
+// the typedesc and ifaceDesc fields of a var are for reading only:
+// ReadJSON recomputes the hash and rebuilds a var from its bytes and
+// relocs.
+
+type jsonObject struct {
+	BOVersion    int               `json:"boVersion"`
+	Producer     string            `json:"producer"`
+	Package      string            `json:"package"`
+	ExeFormat    string            `json:"exeFormat"`
+	ExportHash   string            `json:"exportHash"`
+	ImportHashes map[string]string `json:"importHashes"`
+	Types        []jsonType        `json:"types"`
+	Data         []jsonVar         `json:"data"`
+	Vars         []jsonVar         `json:"vars"`
+	Funcs        []jsonFunc        `json:"funcs"`
+	Structs      []jsonStruct      `json:"structs"`
+	TypeAliases  []jsonTypeAlias   `json:"typeAliases"`
+	Interfaces   []jsonInterface   `json:"interfaces"`
+	Values       []jsonValues      `json:"values"`
+}
+
+type jsonType struct {
+	Name        string   `json:"name"`
+	Properties  []string `json:"properties"`
+	Description string   `json:"description"`
+}
+
+type jsonVar struct {
+	Name      string          `json:"name"`
+	Pub       bool            `json:"pub"`
+	Const     bool            `json:"const"`
+	Type      string          `json:"type"`
+	Kind      string          `json:"kind"`
+	Align     int             `json:"align"`
+	ZeroFill  int             `json:"zeroFill"`
+	Bytes     string          `json:"bytes"`
+	Relocs    []jsonDataReloc `json:"relocs"`
+	Typedesc  *jsonTypedesc   `json:"typedesc,omitempty"`
+	IfaceDesc *jsonIfaceDesc  `json:"ifaceDesc,omitempty"`
+	SrcFile   string          `json:"srcFile,omitempty"`
+	SrcLine   int             `json:"srcLine,omitempty"`
+}
+
+type jsonDataReloc struct {
+	Offset uint32 `json:"offset"`
+	Symbol string `json:"symbol"`
+	Addend int64  `json:"addend"`
+}
+
+type jsonTypedesc struct {
+	TypeName string               `json:"typeName"`
+	Size     uint64               `json:"size"`
+	CacheSym string               `json:"cacheSym"`
+	Methods  []jsonTypedescMethod `json:"methods"`
+}
+
+type jsonTypedescMethod struct {
+	Name      string   `json:"name"`
+	Sig       string   `json:"sig"`
+	NameHash  uint64   `json:"nameHash"`
+	SigHash   uint64   `json:"sigHash"`
+	RecvShape uint64   `json:"recvShape"`
+	FnSym     string   `json:"fnSym"`
+	SlotMasks []uint64 `json:"slotMasks"`
+}
+
+type jsonIfaceDesc struct {
+	IfaceName string                `json:"ifaceName"`
+	Methods   []jsonIfaceDescMethod `json:"methods"`
+}
+
+type jsonIfaceDescMethod struct {
+	Name      string   `json:"name"`
+	Sig       string   `json:"sig"`
+	NameHash  uint64   `json:"nameHash"`
+	SigHash   uint64   `json:"sigHash"`
+	DeclIdx   uint64   `json:"declIdx"`
+	SlotMasks []uint64 `json:"slotMasks"`
+}
+
+type jsonFunc struct {
+	Name          string           `json:"name"`
+	Pub           bool             `json:"pub"`
+	Type          string           `json:"type"`
+	SrcFile       string           `json:"srcFile"`
+	SrcLine       int              `json:"srcLine"`
+	Align         int              `json:"align"`
+	Args          []jsonVar        `json:"args"`
+	Symbols       []jsonSymbol     `json:"symbols"`
+	Relocations   []jsonRelocation `json:"relocations"`
+	ReturnAliases [][]int          `json:"returnAliases"`
+	Body          string           `json:"body"`
+	Lines         []jsonLine       `json:"lines"`
+	Locals        []jsonFrameVar   `json:"locals"`
+}
+
+type jsonLine struct {
+	Offset uint32 `json:"offset"`
+	File   string `json:"file"`
+	Line   int    `json:"line"`
+}
+
+type jsonFrameVar struct {
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	Offset int32  `json:"offset"`
+	Size   int    `json:"size"`
+	Start  uint32 `json:"start"`
+	End    uint32 `json:"end"`
+}
+
+type jsonSymbol struct {
+	Name   string `json:"name"`
+	Offset uint32 `json:"offset"`
+}
+
+type jsonRelocation struct {
+	Offset uint32 `json:"offset"`
+	Symbol string `json:"symbol"`
+	Addend int32  `json:"addend"`
+}
+
+type jsonField struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type jsonStruct struct {
+	Name    string      `json:"name"`
+	Pub     bool        `json:"pub"`
+	Fields  []jsonField `json:"fields"`
+	Methods []string    `json:"methods"`
+}
+
+type jsonTypeAlias struct {
+	Name       string   `json:"name"`
+	Pub        bool     `json:"pub"`
+	Underlying string   `json:"underlying"`
+	Methods    []string `json:"methods"`
+}
+
+type jsonInterface struct {
+	Name    string                `json:"name"`
+	Pub     bool                  `json:"pub"`
+	Methods []jsonInterfaceMethod `json:"methods"`
+}
+
+type jsonInterfaceMethod struct {
+	Name          string      `json:"name"`
+	Params        []jsonField `json:"params"`
+	Return        string      `json:"return"`
+	ReturnAliases [][]int     `json:"returnAliases"`
+}
+
+type jsonValues struct {
+	Name        string           `json:"name"`
+	Pub         bool             `json:"pub"`
+	TagType     string           `json:"tagType"`
+	Cases       []jsonValuesCase `json:"cases"`
+	Projections []string         `json:"projections"`
+	Methods     []string         `json:"methods"`
+}
+
+type jsonValuesCase struct {
+	Name string `json:"name"`
+	Tag  int64  `json:"tag"`
+}
+
+// WriteJSON writes o to w as JSON. o's functions must resolve.
+func (o *OFile) WriteJSON(w io.Writer) error {
+	j := jsonObject{
+		BOVersion:    BOVersion,
+		Producer:     o.Producer,
+		Package:      o.Pkgname,
+		ExeFormat:    o.ExeFormat,
+		ExportHash:   o.ComputeExportHash(),
+		ImportHashes: make(map[string]string),
+		Types:        []jsonType{},
+		Data:         []jsonVar{},
+		Vars:         []jsonVar{},
+		Funcs:        []jsonFunc{},
+		Structs:      []jsonStruct{},
+		TypeAliases:  []jsonTypeAlias{},
+		Interfaces:   []jsonInterface{},
+		Values:       []jsonValues{},
+	}
+	if j.Producer == "" {
+		j.Producer = Producer
+	}
+	for pkg, hash := range o.ImportHashes {
+		j.ImportHashes[pkg] = hash
+	}
+	for _, name := range sortedKeys(o.Types) {
+		t := o.Types[name]
+		j.Types = append(j.Types, jsonType{
+			Name:        t.Name,
+			Properties:  append([]string{}, t.Properties...),
+			Description: hex.EncodeToString(t.Description),
+		})
+	}
+	for _, name := range sortedKeys(o.Data) {
+		j.Data = append(j.Data, varToJSON(o.Data[name]))
+	}
+	for _, name := range sortedKeys(o.Vars) {
+		j.Vars = append(j.Vars, varToJSON(o.Vars[name]))
+	}
+	for _, name := range sortedKeys(o.Funcs) {
+		f := o.Funcs[name]
+		body, err := f.Body()
+		if err != nil {
+			return fmt.Errorf("Function %s: %w", name, err)
+		}
+		jf := jsonFunc{
+			Name:          f.Name,
+			Pub:           f.IsPub,
+			Type:          f.Type,
+			SrcFile:       f.SrcFile,
+			SrcLine:       f.SrcLine,
+			Align:         f.Align,
+			Args:          []jsonVar{},
+			Symbols:       []jsonSymbol{},
+			Relocations:   []jsonRelocation{},
+			ReturnAliases: intsToJSON(f.ReturnAliases),
+			Body:          hex.EncodeToString(body),
+			Lines:         []jsonLine{},
+			Locals:        []jsonFrameVar{},
+		}
+		for _, a := range f.Args {
+			jf.Args = append(jf.Args, varToJSON(a))
+		}
+		for _, s := range f.Symbols {
+			jf.Symbols = append(jf.Symbols, jsonSymbol{s.Name, s.Offset})
+		}
+		for _, r := range f.Relocations {
+			jf.Relocations = append(jf.Relocations, jsonRelocation{r.Offset, r.Symbol, r.Addend})
+		}
+		for _, l := range f.Lines {
+			jf.Lines = append(jf.Lines, jsonLine{l.Offset, l.File, l.Line})
+		}
+		for _, v := range f.Locals {
+			jf.Locals = append(jf.Locals, jsonFrameVar{v.Name, v.Type, v.Offset, v.Size, v.Start, v.End})
+		}
+		j.Funcs = append(j.Funcs, jf)
+	}
+	for _, name := range sortedKeys(o.Structs) {
+		s := o.Structs[name]
+		j.Structs = append(j.Structs, jsonStruct{
+			Name:    s.Name,
+			Pub:     s.IsPub,
+			Fields:  fieldsToJSON(s.Fields),
+			Methods: append([]string{}, s.MethodNames...),
+		})
+	}
+	for _, name := range sortedKeys(o.TypeAliases) {
+		a := o.TypeAliases[name]
+		j.TypeAliases = append(j.TypeAliases, jsonTypeAlias{
+			Name:       a.Name,
+			Pub:        a.IsPub,
+			Underlying: a.Underlying,
+			Methods:    append([]string{}, a.MethodNames...),
+		})
+	}
+	for _, name := range sortedKeys(o.Interfaces) {
+		ifc := o.Interfaces[name]
+		ji := jsonInterface{Name: ifc.Name, Pub: ifc.IsPub, Methods: []jsonInterfaceMethod{}}
+		for _, m := range ifc.Methods {
+			ji.Methods = append(ji.Methods, jsonInterfaceMethod{
+				Name:          m.Name,
+				Params:        fieldsToJSON(m.Params),
+				Return:        m.Return,
+				ReturnAliases: intsToJSON(m.ReturnAliases),
+			})
+		}
+		j.Interfaces = append(j.Interfaces, ji)
+	}
+	for _, name := range sortedKeys(o.Values) {
+		v := o.Values[name]
+		jv := jsonValues{
+			Name:        v.Name,
+			Pub:         v.IsPub,
+			TagType:     v.TagType,
+			Cases:       []jsonValuesCase{},
+			Projections: []string{},
+			Methods:     append([]string{}, v.MethodNames...),
+		}
+		for _, c := range v.Cases {
+			jv.Cases = append(jv.Cases, jsonValuesCase{c.Name, c.Tag})
+		}
+		for _, p := range v.Projections {
+			jv.Projections = append(jv.Projections, p.TargetType)
+		}
+		j.Values = append(j.Values, jv)
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "\t")
+	return enc.Encode(&j)
+}
+
+func varToJSON(v *Var) jsonVar {
+	j := jsonVar{
+		Name:     v.Name,
+		Pub:      v.IsPub,
+		Const:    v.IsConst,
+		Type:     v.VType,
+		Kind:     v.Kind,
+		Align:    v.Align,
+		ZeroFill: v.ZeroFill,
+		Bytes:    hex.EncodeToString(v.Val),
+		Relocs:   []jsonDataReloc{},
+		SrcFile:  v.SrcFile,
+		SrcLine:  v.SrcLine,
+	}
+	for _, r := range v.Relocs {
+		j.Relocs = append(j.Relocs, jsonDataReloc{r.Offset, r.Symbol, r.Addend})
+	}
+	switch v.Kind {
+	case KindTypedesc:
+		rec := DecodeTypedesc(v)
+		j.Typedesc = &jsonTypedesc{
+			TypeName: rec.TypeName,
+			Size:     rec.SizeBytes,
+			CacheSym: rec.CacheSym,
+			Methods:  []jsonTypedescMethod{},
+		}
+		for _, m := range rec.Methods {
+			j.Typedesc.Methods = append(j.Typedesc.Methods, jsonTypedescMethod{
+				m.Name, m.Sig, m.NameHash, m.SigHash, m.RecvShape, m.FnSym, append([]uint64{}, m.SlotMasks...),
+			})
+		}
+	case KindIfaceDesc:
+		rec := DecodeIfaceDesc(v)
+		j.IfaceDesc = &jsonIfaceDesc{IfaceName: rec.IfaceName, Methods: []jsonIfaceDescMethod{}}
+		for _, m := range rec.Methods {
+			j.IfaceDesc.Methods = append(j.IfaceDesc.Methods, jsonIfaceDescMethod{
+				m.Name, m.Sig, m.NameHash, m.SigHash, m.DeclIdx, append([]uint64{}, m.SlotMasks...),
+			})
+		}
+	}
+	return j
+}
+
+func fieldsToJSON(fs []FieldShape) []jsonField {
+	j := []jsonField{}
+	for _, f := range fs {
+		j = append(j, jsonField{f.Name, f.Type})
+	}
+	return j
+}
+
+func intsToJSON(is [][]int) [][]int {
+	j := [][]int{}
+	for _, slot := range is {
+		j = append(j, append([]int{}, slot...))
+	}
+	return j
+}
+
+// ReadJSON reads an OFile written by WriteJSON.
+func ReadJSON(r io.Reader) (*OFile, error) {
+	var j jsonObject
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&j); err != nil {
+		return nil, err
+	}
+	if j.BOVersion != BOVersion {
+		return nil, fmt.Errorf("%w: it is a dump of .bo version %d, and this toolchain writes version %d",
+			ErrIncompatibleObject, j.BOVersion, BOVersion)
+	}
+	o, err := NewOFile(j.Package+".bo", j.Package)
+	if err != nil {
+		return nil, err
+	}
+	o.Producer = j.Producer
+	o.ExeFormat = j.ExeFormat
+	for pkg, hash := range j.ImportHashes {
+		if err := o.AddImportHash(pkg, hash); err != nil {
+			return nil, err
+		}
+	}
+	for _, jt := range j.Types {
+		desc, err := hex.DecodeString(jt.Description)
+		if err != nil {
+			return nil, fmt.Errorf("Type %s: %w", jt.Name, err)
+		}
+		if err := o.Type(jt.Name, append([]string{}, jt.Properties...), desc); err != nil {
+			return nil, err
+		}
+	}
+	for _, sec := range []struct {
+		js []jsonVar
+		vs map[string]*Var
+	}{{j.Data, o.Data}, {j.Vars, o.Vars}} {
+		for _, jv := range sec.js {
+			v, err := varFromJSON(jv)
+			if err != nil {
+				return nil, err
+			}
+			if o.Data[v.Name] != nil || o.Vars[v.Name] != nil {
+				return nil, fmt.Errorf("Name %s already declared.", v.Name)
+			}
+			sec.vs[v.Name] = v
+		}
+	}
+	for _, jf := range j.Funcs {
+		if o.Funcs[jf.Name] != nil || o.Data[jf.Name] != nil || o.Vars[jf.Name] != nil {
+			return nil, fmt.Errorf("Name %s already declared.", jf.Name)
+		}
+		body, err := hex.DecodeString(jf.Body)
+		if err != nil {
+			return nil, fmt.Errorf("Function %s: %w", jf.Name, err)
+		}
+		f := &Function{
+			Name:          jf.Name,
+			Pkgname:       o.Pkgname,
+			IsPub:         jf.Pub,
+			Type:          jf.Type,
+			SrcFile:       jf.SrcFile,
+			SrcLine:       jf.SrcLine,
+			Align:         jf.Align,
+			Args:          []*Var{},
+			Symbols:       []Symbol{},
+			Relocations:   []Relocation{},
+			ReturnAliases: intsFromJSON(jf.ReturnAliases),
+			bodyBs:        body,
+		}
+		for _, ja := range jf.Args {
+			a, err := varFromJSON(ja)
+			if err != nil {
+				return nil, fmt.Errorf("Function %s: %w", jf.Name, err)
+			}
+			f.Args = append(f.Args, a)
+		}
+		for _, s := range jf.Symbols {
+			f.Symbols = append(f.Symbols, Symbol{s.Name, s.Offset})
+		}
+		for _, r := range jf.Relocations {
+			f.Relocations = append(f.Relocations, Relocation{r.Offset, r.Symbol, r.Addend})
+		}
+		for _, l := range jf.Lines {
+			f.Lines = append(f.Lines, LineEntry{l.Offset, l.File, l.Line})
+		}
+		for _, v := range jf.Locals {
+			f.Locals = append(f.Locals, FrameVar{v.Name, v.Type, v.Offset, v.Size, v.Start, v.End})
+		}
+		o.Funcs[f.Name] = f
+	}
+	// The shapes are set directly rather than through AddStruct and the
+	// like, which reject some combinations of names that a .bo can hold.
+	for _, js := range j.Structs {
+		if o.Structs[js.Name] != nil {
+			return nil, fmt.Errorf("Struct %s already declared.", js.Name)
+		}
+		o.Structs[js.Name] = &StructShape{
+			Name:        js.Name,
+			IsPub:       js.Pub,
+			Fields:      fieldsFromJSON(js.Fields),
+			MethodNames: append([]string{}, js.Methods...),
+		}
+	}
+	for _, ja := range j.TypeAliases {
+		if o.TypeAliases[ja.Name] != nil {
+			return nil, fmt.Errorf("TypeAlias %s already declared.", ja.Name)
+		}
+		o.TypeAliases[ja.Name] = &TypeAliasShape{
+			Name:        ja.Name,
+			IsPub:       ja.Pub,
+			Underlying:  ja.Underlying,
+			MethodNames: append([]string{}, ja.Methods...),
+		}
+	}
+	for _, ji := range j.Interfaces {
+		if o.Interfaces[ji.Name] != nil {
+			return nil, fmt.Errorf("Interface %s already declared.", ji.Name)
+		}
+		ifc := &InterfaceShape{Name: ji.Name, IsPub: ji.Pub, Methods: []InterfaceMethodShape{}}
+		for _, m := range ji.Methods {
+			ifc.Methods = append(ifc.Methods, InterfaceMethodShape{
+				Name:          m.Name,
+				Params:        fieldsFromJSON(m.Params),
+				Return:        m.Return,
+				ReturnAliases: intsFromJSON(m.ReturnAliases),
+			})
+		}
+		o.Interfaces[ji.Name] = ifc
+	}
+	for _, jv := range j.Values {
+		if o.Values[jv.Name] != nil {
+			return nil, fmt.Errorf("Values type %s already declared.", jv.Name)
+		}
+		v := &ValuesShape{
+			Name:        jv.Name,
+			IsPub:       jv.Pub,
+			TagType:     jv.TagType,
+			Cases:       []ValuesCaseShape{},
+			Projections: []ProjectionShape{},
+			MethodNames: append([]string{}, jv.Methods...),
+		}
+		for _, c := range jv.Cases {
+			v.Cases = append(v.Cases, ValuesCaseShape{c.Name, c.Tag})
+		}
+		for _, p := range jv.Projections {
+			v.Projections = append(v.Projections, ProjectionShape{p})
+		}
+		o.Values[jv.Name] = v
+	}
+	o.ExportHash = o.ComputeExportHash()
+	return o, nil
+}
+
+func varFromJSON(j jsonVar) (*Var, error) {
+	val, err := hex.DecodeString(j.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("Var %s: %w", j.Name, err)
+	}
+	if j.ZeroFill < 0 || (j.ZeroFill > 0 && len(val) > 0) {
+		return nil, fmt.Errorf("Var %s: zeroFill %d must be non-negative, and zero when the var has bytes", j.Name, j.ZeroFill)
+	}
+	v := &Var{
+		Name:     j.Name,
+		IsPub:    j.Pub,
+		IsConst:  j.Const,
+		VType:    j.Type,
+		Val:      val,
+		Relocs:   []DataReloc{},
+		Kind:     j.Kind,
+		Align:    j.Align,
+		ZeroFill: j.ZeroFill,
+		SrcFile:  j.SrcFile,
+		SrcLine:  j.SrcLine,
+	}
+	for _, r := range j.Relocs {
+		if int(r.Offset)+8 > len(val) {
+			return nil, fmt.Errorf("Var %s: reloc at offset %d would write past end of %d-byte payload", j.Name, r.Offset, len(val))
+		}
+		v.Relocs = append(v.Relocs, DataReloc{r.Offset, r.Symbol, r.Addend})
+	}
+	return v, nil
+}
+
+func fieldsFromJSON(j []jsonField) []FieldShape {
+	fs := []FieldShape{}
+	for _, f := range j {
+		fs = append(fs, FieldShape{f.Name, f.Type})
+	}
+	return fs
+}
+
+func intsFromJSON(j [][]int) [][]int {
+	if len(j) == 0 {
+		return nil
+	}
+	is := make([][]int, len(j))
+	for i, slot := range j {
+		is[i] = append([]int{}, slot...)
+	}
+	return is
+}
@@ -0,0 +1,88 @@
+package gbasm
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+	_, bo := testObject(t, "api", func(o *OFile) {
+		for _, name := range []string{"g", "f"} {
+			f, err := o.NewFunction("api.bs", 1, name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			f.IsPub = name == "f"
+			f.Type = "fn " + name + "() i64"
+			f.SourceLine("api.bos", 3)
+			f.Jump("CALL", "other.h")
+			f.SourceLine("api.bos", 4)
+			f.Instr("RET")
+		}
+		o.Funcs["f"].ReturnAliases = [][]int{{0, 1}}
+		if err := o.AddVar("table", "byte[16]", make([]byte, 16), true); err != nil {
+			t.Fatal(err)
+		}
+		o.Vars["table"].Relocs = []DataReloc{{Offset: 8, Symbol: "api.f", Addend: 4}}
+		o.Vars["table"].IsConst = true
+		o.Vars["table"].SrcFile, o.Vars["table"].SrcLine = "api.bos", 7
+		val, relocs := EncodeTypedesc(&TypedescRecord{
+			TypeName:  "api.point",
+			SizeBytes: 16,
+			CacheSym:  "__typedesc_cache_point",
+			Methods:   []TypedescMethod{{Name: "len", Sig: "fn() i64", FnSym: "api.point.len"}},
+		})
+		o.Data["__typedesc_point"] = &Var{Name: "__typedesc_point", VType: "byte[]", Val: val, Relocs: relocs, Kind: KindTypedesc}
+		if err := o.AddStruct("point", []FieldShape{{"x", "i64"}, {"y", "i64"}}, []string{"len"}, true); err != nil {
+			t.Fatal(err)
+		}
+		if err := o.AddValues("color", "i64", []ValuesCaseShape{{"RED", 0}}, []ProjectionShape{{"byte[]"}}, nil, true); err != nil {
+			t.Fatal(err)
+		}
+		if err := o.AddImportHash("other", "abc"); err != nil {
+			t.Fatal(err)
+		}
+	})
+	dump := func(bo []byte) []byte {
+		t.Helper()
+		o, err := readOFile(bytes.NewReader(bo))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var b bytes.Buffer
+		if err := o.WriteJSON(&b); err != nil {
+			t.Fatal(err)
+		}
+		return b.Bytes()
+	}
+	js := dump(bo)
+	if again := dump(bo); !bytes.Equal(js, again) {
+		t.Errorf("Expected the same dump twice, got\n%s\nand\n%s", js, again)
+	}
+
+	var j jsonObject
+	if err := json.Unmarshal(js, &j); err != nil {
+		t.Fatal(err)
+	}
+	if len(j.Funcs) != 2 || j.Funcs[0].Name != "f" || j.Funcs[1].Name != "g" {
+		t.Errorf("Expected functions f and g in order, got %+v", j.Funcs)
+	} else if len(j.Funcs[0].Lines) != 2 {
+		t.Errorf("Expected f to have two line entries, got %+v", j.Funcs[0].Lines)
+	}
+	if len(j.Data) != 1 || j.Data[0].Typedesc == nil || j.Data[0].Typedesc.Methods[0].FnSym != "api.point.len" {
+		t.Errorf("Expected a decoded typedesc, got %+v", j.Data)
+	}
+
+	o, err := ReadJSON(bytes.NewReader(js))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b bytes.Buffer
+	if err := writeOFile(&b, o); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(b.Bytes(), bo) {
+		t.Errorf("Expected the .bo rebuilt from JSON to be identical to the original")
+	}
+}
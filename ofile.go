@@ -22,6 +22,9 @@ type Var struct {
 	// IsPub marks this top-level var/data as importable from .bos source in
 	// other packages. The linker does not consult this bit.
 	IsPub bool
+	// IsConst marks a var its package declared immutable, so that
+	// importers reject writes to it. Data is always immutable.
+	IsConst bool
 	// VType is a string and must be parsed by the compiler/linker to ensure it matches some
 	// TypeDescr.
 	VType string
@@ -36,6 +39,25 @@ type Var struct {
 	// so bdump can pretty-print them. Empty for ordinary data/var blocks.
 	// The linker ignores it; placement is purely Data-vs-Vars-map driven.
 	Kind string
+	// Align is the boundary the linker places the var on, in bytes. Zero
+	// and one mean no alignment.
+	Align int
+	// ZeroFill, when non-zero, is the size of a var whose value is all
+	// zero bytes. Such a var has no Val or Relocs; the linker places it
+	// in .bss, which takes no space in the executable.
+	ZeroFill int
+	// SrcFile and SrcLine are where a var was declared, if known, for
+	// diagnostics in the packages that import it.
+	SrcFile string
+	SrcLine int
+}
+
+// Size is the number of bytes v occupies once linked.
+func (v *Var) Size() int {
+	if v.ZeroFill > 0 {
+		return v.ZeroFill
+	}
+	return len(v.Val)
 }
 
 // DataReloc is a per-Var pointer-slot fixup, applied by the linker
@@ -105,6 +127,17 @@ type OFile struct {
 	Data      map[string]*Var
 	Vars      map[string]*Var
 	Funcs     map[string]*Function
+	// Producer is the toolchain that wrote the file, as recorded in its
+	// header, for a file read by ReadOFile. Writing a file with Producer
+	// set keeps it, so a file rebuilt from its JSON dump is identical to
+	// the original; otherwise the header names this toolchain.
+	Producer string
+	// ExportHash is ComputeExportHash as of when the file was written, for
+	// a file read by ReadOFile.
+	ExportHash string
+	// ImportHashes are the export hashes of the packages this one was
+	// compiled against, by package.
+	ImportHashes map[string]string
 	// Structs are Boson-level struct definitions exported by this
 	// package. Each StructShape stores the field names paired with
 	// their rendered type strings (parseable by bosc on import).
@@ -324,6 +357,16 @@ func (o *OFile) AddVar(name, vtype string, val interface{}, isPub bool) error {
 	return nil
 }
 
+// AddZeroVar declares a mutable var of type vtype at package scope holding
+// size zero bytes.
+func (o *OFile) AddZeroVar(name, vtype string, size int, isPub bool) error {
+	if o.Vars[name] != nil || o.Data[name] != nil || o.Funcs[name] != nil {
+		return fmt.Errorf("Name %s already declared.", name)
+	}
+	o.Vars[name] = &Var{Name: name, IsPub: isPub, VType: vtype, ZeroFill: size}
+	return nil
+}
+
 // AddData declares a piece of immutable data of type vtype at package scope.
 func (o *OFile) AddData(name, vtype string, val interface{}, isPub bool) error {
 	if o.Vars[name] != nil || o.Data[name] != nil || o.Funcs[name] != nil {
@@ -335,7 +378,20 @@ func (o *OFile) AddData(name, vtype string, val interface{}, isPub bool) error {
 	}
 	var bs bytes.Buffer
 	binary.Write(&bs, binary.LittleEndian, val)
-	o.Data[name] = &Var{Name: name, IsPub: isPub, VType: vtype, Val: bs.Bytes()}
+	o.Data[name] = &Var{Name: name, IsPub: isPub, IsConst: true, VType: vtype, Val: bs.Bytes()}
+	return nil
+}
+
+// AddImportHash records that o was compiled against the export hash hash
+// of package pkg.
+func (o *OFile) AddImportHash(pkg, hash string) error {
+	if h, ok := o.ImportHashes[pkg]; ok && h != hash {
+		return fmt.Errorf("Package %s was imported with export hash %s and %s", pkg, h, hash)
+	}
+	if o.ImportHashes == nil {
+		o.ImportHashes = make(map[string]string)
+	}
+	o.ImportHashes[pkg] = hash
 	return nil
 }
 
@@ -357,10 +413,6 @@ func ReadOFile(filename string) (*OFile, error) {
 		return nil, err
 	}
 	o.Filename = filename
-	// Stamp every function with its owning package so the linker can namespace.
-	for _, fn := range o.Funcs {
-		fn.Pkgname = o.Pkgname
-	}
 	return o, nil
 }
 
@@ -0,0 +1,337 @@
+package gbasm
+
+// A peepInstr is an instruction waiting to be encoded while the peephole
+// optimizer is enabled. Its allocation operands have already been resolved
+// to registers and memory, so the allocator's loads and spills are in the
+// stream too, and rules see the machine instructions that will be encoded.
+type peepInstr struct {
+	instr string
+	form  *IForm        // the form ops were resolved for; nil once a rule rewrites the instruction
+	ops   []interface{} // the resolved operands
+	orig  []interface{} // the operands given to Instr, for the listing
+	// volatile is set when an operand is a volatile local, whose every
+	// access must happen. Rules leave such instructions alone.
+	volatile     bool
+	source, note string
+	seq          int // f.seq when the instruction was given
+}
+
+// A peepRule looks for a pattern at the start of run. If it matches, it
+// returns the instructions to replace the first n with; run continues to
+// the end of the straight-line code, for rules that need to look ahead.
+type peepRule struct {
+	name  string
+	match func(run []peepInstr) (repl []peepInstr, n int)
+}
+
+// peepRules are tried in order at each instruction. To add a rule, write a
+// match function and list it here; its name is what -v reports.
+var peepRules = []peepRule{
+	{"mov-self", movSelf},
+	{"mov-back", movBack},
+	{"store-reload", storeReload},
+	{"store-forward", storeForward},
+	{"add-zero", addZero},
+	{"cmp-zero", cmpZero},
+}
+
+// peepWindow is the longest pattern any rule matches. After a rewrite, the
+// optimizer backs up this far so patterns the rewrite completed are found.
+const peepWindow = 2
+
+// EnablePeephole makes f queue the instructions given to Instr and run the
+// peephole rules over them before encoding. The queue is encoded at each
+// label, jump, alignment, prologue and epilogue, so no rule looks across a
+// place control can enter or leave by.
+func (f *Function) EnablePeephole() {
+	f.peephole = true
+}
+
+// PeepholeStats returns how many times each peephole rule has rewritten
+// f's instructions.
+func (f *Function) PeepholeStats() map[string]int {
+	return f.peepStats
+}
+
+// PeepholeRules returns the names of the peephole rules, in the order they
+// are tried.
+func PeepholeRules() []string {
+	var names []string
+	for _, r := range peepRules {
+		names = append(names, r.name)
+	}
+	return names
+}
+
+// captureWriter stands in for f's body while an instruction's operands
+// are resolved, keeping the form and operands and dropping the bytes.
+type captureWriter struct {
+	n    int
+	form *IForm
+	ops  []interface{}
+}
+
+func (w *captureWriter) Write(p []byte) (int, error) {
+	w.n += len(p)
+	return len(p), nil
+}
+
+func (w *captureWriter) Len() int {
+	return w.n
+}
+
+func (w *captureWriter) encodingForm(form *IForm, os []interface{}) {
+	w.form = form
+	w.ops = append([]interface{}(nil), os...)
+}
+
+// queue resolves instr's operands and adds it to f's queue.
+func (f *Function) queue(instr string, ops, orig []interface{}) error {
+	var w captureWriter
+	if _, err := f.a.Encode(&w, instr, ops...); err != nil {
+		return err
+	}
+	p := peepInstr{
+		instr:  instr,
+		form:   w.form,
+		ops:    w.ops,
+		orig:   orig,
+		source: f.listSource,
+		note:   f.listNote,
+		seq:    f.seq,
+	}
+	for _, op := range orig {
+		switch ra := op.(type) {
+		case *Ralloc:
+			p.volatile = p.volatile || ra.volatile
+		case *RallocPartial:
+			p.volatile = p.volatile || ra.Ra.volatile
+		}
+	}
+	f.pending = append(f.pending, p)
+	return nil
+}
+
+// flush runs the peephole rules over the queued instructions and encodes
+// them.
+func (f *Function) flush() {
+	if len(f.pending) == 0 {
+		return
+	}
+	run := f.optimize(f.pending)
+	f.pending = nil
+	prevSource, prevNote, prevSeq := f.listSource, f.listNote, f.seq
+	defer func() { f.listSource, f.listNote, f.seq = prevSource, prevNote, prevSeq }()
+	for _, p := range run {
+		f.listSource, f.listNote, f.seq = p.source, p.note, p.seq
+		w, lw := f.writer()
+		var rs []Relocation
+		var err error
+		if p.form != nil {
+			rs, err = p.form.Encode(w, p.ops...)
+		} else {
+			rs, err = f.a.Encode(w, p.instr, p.ops...)
+		}
+		if err != nil {
+			f.errors = append(f.errors, err)
+			continue
+		}
+		lw.finish(p.orig)
+		f.Relocations = append(f.Relocations, rs...)
+	}
+}
+
+// optimize applies the peephole rules to run until none matches.
+func (f *Function) optimize(run []peepInstr) []peepInstr {
+	for i := 0; i < len(run); {
+		matched := false
+		for _, r := range peepRules {
+			repl, n := r.match(run[i:])
+			if n == 0 {
+				continue
+			}
+			run = append(run[:i], append(repl, run[i+n:]...)...)
+			if f.peepStats == nil {
+				f.peepStats = make(map[string]int)
+			}
+			f.peepStats[r.name]++
+			matched = true
+			break
+		}
+		if matched {
+			i = max(i-peepWindow+1, 0)
+		} else {
+			i++
+		}
+	}
+	return run
+}
+
+// movSelf drops a move of a register to itself. A 32-bit move is kept,
+// since it clears the upper half of the register.
+func movSelf(run []peepInstr) ([]peepInstr, int) {
+	p := run[0]
+	if p.instr != "MOV" || p.volatile || len(p.ops) != 2 {
+		return nil, 0
+	}
+	r, ok := p.ops[0].(Register)
+	if !ok || p.ops[1] != p.ops[0] || r.Width() == 32 {
+		return nil, 0
+	}
+	return nil, 1
+}
+
+// movBack drops the second of two moves between the same registers, as in
+// MOV R10, RAX; MOV RAX, R10.
+func movBack(run []peepInstr) ([]peepInstr, int) {
+	a, b, ok := movPair(run)
+	if !ok {
+		return nil, 0
+	}
+	_, reg0 := a.ops[0].(Register)
+	_, reg1 := a.ops[1].(Register)
+	if !reg0 || !reg1 || b.ops[0] != a.ops[1] || b.ops[1] != a.ops[0] || !keepsUpper(b.ops[0]) {
+		return nil, 0
+	}
+	return run[:1:1], 2
+}
+
+// storeReload drops the reload of a register just stored to a stack slot,
+// as in MOV [RBP - 8], RAX; MOV RAX, [RBP - 8].
+func storeReload(run []peepInstr) ([]peepInstr, int) {
+	a, b, ok := movPair(run)
+	if !ok || !stackSlot(a.ops[0]) {
+		return nil, 0
+	}
+	if _, ok := a.ops[1].(Register); !ok || b.ops[0] != a.ops[1] || b.ops[1] != a.ops[0] || !keepsUpper(b.ops[0]) {
+		return nil, 0
+	}
+	return run[:1:1], 2
+}
+
+// storeForward loads a register just stored to a stack slot from the
+// stored register instead: MOV [RBP - 8], R10; MOV RAX, [RBP - 8] becomes
+// MOV [RBP - 8], R10; MOV RAX, R10.
+func storeForward(run []peepInstr) ([]peepInstr, int) {
+	a, b, ok := movPair(run)
+	if !ok || !stackSlot(a.ops[0]) || b.ops[1] != a.ops[0] {
+		return nil, 0
+	}
+	src, ok := a.ops[1].(Register)
+	if !ok {
+		return nil, 0
+	}
+	dst, ok := b.ops[0].(Register)
+	if !ok || dst == src || dst.Width() != src.Width() {
+		return nil, 0
+	}
+	b.form = nil
+	b.ops = []interface{}{dst, src}
+	return []peepInstr{run[0], b}, 2
+}
+
+// addZero drops ADD or SUB of zero to a register, when no instruction
+// reads the flags it sets before they are set again.
+func addZero(run []peepInstr) ([]peepInstr, int) {
+	p := run[0]
+	if p.instr != "ADD" && p.instr != "SUB" || p.volatile || len(p.ops) != 2 {
+		return nil, 0
+	}
+	if !isZero(p.ops[1]) || !keepsUpper(p.ops[0]) || !flagsDead(run[1:]) {
+		return nil, 0
+	}
+	if _, ok := p.ops[0].(Register); !ok {
+		return nil, 0
+	}
+	return nil, 1
+}
+
+// cmpZero turns CMP r, 0 into the shorter TEST r, r, which sets the flags
+// conditional jumps read the same way.
+func cmpZero(run []peepInstr) ([]peepInstr, int) {
+	p := run[0]
+	if p.instr != "CMP" || p.volatile || len(p.ops) != 2 || !isZero(p.ops[1]) {
+		return nil, 0
+	}
+	r, ok := p.ops[0].(Register)
+	if !ok {
+		return nil, 0
+	}
+	p.instr, p.form = "TEST", nil
+	p.ops = []interface{}{r, r}
+	return []peepInstr{p}, 1
+}
+
+// movPair returns the first two instructions of run if both are
+// non-volatile two-operand MOVs.
+func movPair(run []peepInstr) (a, b peepInstr, ok bool) {
+	if len(run) < 2 {
+		return a, b, false
+	}
+	a, b = run[0], run[1]
+	for _, p := range []peepInstr{a, b} {
+		if p.instr != "MOV" || p.volatile || len(p.ops) != 2 {
+			return a, b, false
+		}
+	}
+	return a, b, true
+}
+
+// stackSlot reports whether op is memory addressed off RBP or RSP.
+func stackSlot(op interface{}) bool {
+	i, ok := op.(Indirect)
+	return ok && i.Symbol == "" && (i.Reg == R_RBP || i.Reg == R_RSP)
+}
+
+// keepsUpper reports whether writing op leaves the rest of its register
+// alone. Writing a 32-bit register clears the upper half, so an
+// instruction doing that is not a no-op even if the value is unchanged.
+func keepsUpper(op interface{}) bool {
+	r, ok := op.(Register)
+	return !ok || r.Width() != 32
+}
+
+func isZero(op interface{}) bool {
+	switch v := op.(type) {
+	case int8:
+		return v == 0
+	case int16:
+		return v == 0
+	case int32:
+		return v == 0
+	case int64:
+		return v == 0
+	case uint8:
+		return v == 0
+	case uint16:
+		return v == 0
+	case uint32:
+		return v == 0
+	case uint64:
+		return v == 0
+	}
+	return false
+}
+
+// setsFlags are instructions that set every status flag a conditional
+// reads, without reading any; noFlags are instructions that neither read
+// nor write them.
+var (
+	setsFlags = map[string]bool{"CMP": true, "TEST": true, "ADD": true, "SUB": true, "AND": true, "OR": true, "XOR": true, "NEG": true}
+	noFlags   = map[string]bool{"MOV": true, "MOVZX": true, "MOVSX": true, "MOVSXD": true, "LEA": true, "PUSH": true, "POP": true, "NOT": true, "XCHG": true}
+)
+
+// flagsDead reports whether run sets the flags before anything reads
+// them. Straight-line code that ends first may be followed by a
+// conditional, so the flags are live there.
+func flagsDead(run []peepInstr) bool {
+	for _, p := range run {
+		switch {
+		case setsFlags[p.instr]:
+			return true
+		case !noFlags[p.instr]:
+			return false
+		}
+	}
+	return false
+}
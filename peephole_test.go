@@ -0,0 +1,88 @@
+package gbasm
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPeephole(t *testing.T) {
+	o, err := NewOFile("peep", "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := o.NewFunction("peep.bs", 1, "f")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.EnablePeephole()
+	slot := Indirect{Reg: R_RBP, Off: -8, Size: 64}
+
+	f.Instr("MOV", R10, R_RAX)
+	f.Instr("MOV", R_RAX, R10)
+	f.Instr("MOV", slot, R_RAX)
+	f.Instr("MOV", R_RAX, slot)
+	f.Instr("MOV", slot, R_RAX)
+	f.Instr("MOV", R_RCX, slot)
+	f.Instr("ADD", R_RCX, int8(0))
+	f.Instr("CMP", R_RCX, int8(0))
+	f.Jump("JNE", "l")
+	// A label ends the straight-line code: the flags of this ADD may be
+	// read after it, and the MOVs either side of it are kept.
+	f.Instr("ADD", R_RCX, int8(0))
+	f.Instr("MOV", R10, R_RAX)
+	f.Label("l")
+	f.Instr("MOV", R_RAX, R10)
+	// A 32-bit move clears the upper half, so it is not a no-op.
+	f.Instr("MOV", R_EAX, R_EAX)
+	f.Instr("RET")
+
+	got := strings.Join(disasmBody(t, f), "; ")
+	want := "MOV R10, RAX; MOV QWORD [RBP - 0x8], RAX; MOV QWORD [RBP - 0x8], RAX; MOV RCX, RAX; TEST RCX, RCX; JNE 0x20; " +
+		"ADD RCX, 0; MOV R10, RAX; MOV RAX, R10; MOV EAX, EAX; RET"
+	if got != want {
+		t.Errorf("Expected\n\t%s\ngot\n\t%s", want, got)
+	}
+	stats := f.PeepholeStats()
+	for rule, n := range map[string]int{"mov-back": 1, "store-reload": 1, "store-forward": 1, "add-zero": 1, "cmp-zero": 1} {
+		if stats[rule] != n {
+			t.Errorf("Expected %s to fire %d times, got %v", rule, n, stats)
+		}
+	}
+}
+
+func TestPeepholeVolatile(t *testing.T) {
+	o, err := NewOFile("peep", "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := o.NewFunction("peep.bs", 1, "f")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.EnablePeephole()
+	f.Prologue()
+	v, err := f.NewLocal("v", 64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.VolatileLocal("v"); err != nil {
+		t.Fatal(err)
+	}
+	f.Instr("MOV", v, R_RAX)
+	f.Instr("MOV", R_RAX, v)
+	f.Epilogue()
+	f.Instr("RET")
+
+	n := 0
+	for _, s := range disasmBody(t, f) {
+		if strings.Contains(s, "RAX") && strings.Contains(s, "[RBP") {
+			n++
+		}
+	}
+	if n != 2 {
+		t.Errorf("Expected both accesses to the volatile local, got %d", n)
+	}
+	if len(f.PeepholeStats()) != 0 {
+		t.Errorf("Expected no rule to fire, got %v", f.PeepholeStats())
+	}
+}
@@ -0,0 +1,103 @@
+package gbasm
+
+import (
+	"debug/elf"
+	"encoding/binary"
+	"path/filepath"
+	"testing"
+)
+
+func TestLinkPIE(t *testing.T) {
+	o, err := NewOFile("pie", "_init")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := o.AddData("msg", "string", "hi", false); err != nil {
+		t.Fatal(err)
+	}
+	if err := o.AddVar("p", "*byte", uint64(0), false); err != nil {
+		t.Fatal(err)
+	}
+	o.Vars["p"].Relocs = []DataReloc{{Offset: 0, Symbol: "msg", Addend: 1}}
+	f, err := o.NewFunction("pie.bs", 1, "start")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Instr("LEA", R_RAX, &Var{Name: "_link.base"})
+	f.Instr("LEA", R_RAX, o.Vars["p"])
+	f.Instr("RET")
+	if err := f.Resolve(); err != nil {
+		t.Fatal(err)
+	}
+
+	exe := filepath.Join(t.TempDir(), "pie")
+	bin, err := LinkPIE([]*OFile{o}, ENTRY_ADDR)
+	if err != nil {
+		t.Fatal(err)
+	}
+	WriteElf(exe, LinkedBinToElfSections(bin))
+	ef, err := elf.Open(exe)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ef.Close()
+	if ef.Type != elf.ET_DYN {
+		t.Errorf("Expected ET_DYN, got %s", ef.Type)
+	}
+	dynamic := false
+	for _, p := range ef.Progs {
+		dynamic = dynamic || p.Type == elf.PT_DYNAMIC
+		if p.Type == elf.PT_LOAD && p.Memsz == 0 {
+			t.Errorf("Expected no empty PT_LOAD segments, got one at %#x", p.Vaddr)
+		}
+	}
+	if !dynamic {
+		t.Error("Expected a PT_DYNAMIC segment")
+	}
+	if d := ef.Section(".dynamic"); d == nil || int(d.Link) >= len(ef.Sections) || ef.Sections[d.Link].Name != ".dynstr" ||
+		ef.Sections[d.Link].Type != elf.SHT_STRTAB {
+		t.Errorf("Expected .dynamic to link to the string table .dynstr, got %+v", d)
+	}
+
+	syms, err := ef.Symbols()
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := make(map[string]uint64)
+	for _, s := range syms {
+		addr[s.Name] = s.Value
+	}
+	rela := ef.Section(".rela.dyn")
+	if rela == nil {
+		t.Fatal("Expected a .rela.dyn section")
+	}
+	bs, err := rela.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bs) != 24 {
+		t.Fatalf("Expected one relocation, got %d bytes", len(bs))
+	}
+	off, info, addend := binary.LittleEndian.Uint64(bs), binary.LittleEndian.Uint64(bs[8:]), binary.LittleEndian.Uint64(bs[16:])
+	if elf.R_X86_64(elf.R_TYPE64(info)) != elf.R_X86_64_RELATIVE {
+		t.Errorf("Expected R_X86_64_RELATIVE, got %d", elf.R_TYPE64(info))
+	}
+	if off != addr["_init.p"] || addend != addr["_init.msg"]+1 {
+		t.Errorf("Expected %#x = %#x, got %#x = %#x", addr["_init.p"], addr["_init.msg"]+1, off, addend)
+	}
+
+	tags := map[elf.DynTag]uint64{}
+	for _, tag := range []elf.DynTag{elf.DT_RELA, elf.DT_RELASZ, elf.DT_FLAGS_1} {
+		vs, err := ef.DynValue(tag)
+		if err != nil || len(vs) != 1 {
+			t.Fatalf("Expected one %s, got %v, %v", tag, vs, err)
+		}
+		tags[tag] = vs[0]
+	}
+	if tags[elf.DT_RELA] != rela.Addr || tags[elf.DT_RELASZ] != 24 || tags[elf.DT_FLAGS_1]&uint64(elf.DF_1_PIE) == 0 {
+		t.Errorf("Unexpected dynamic tags %v", tags)
+	}
+	if addr["_link.base"] == 0 {
+		t.Error("Expected a _link.base symbol")
+	}
+}
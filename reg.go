@@ -3,6 +3,7 @@ package gbasm
 import (
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 )
 
@@ -93,9 +94,36 @@ const (
 
 	R_DIL // 8-bit sub-register of RDI (requires REX prefix)
 	R_SIL // 8-bit sub-register of RSI (requires REX prefix)
+
+	// The SSE register file. XMM0-XMM15 are consecutive so that
+	// r-R_XMM0 is the register number.
+	R_XMM0
+	R_XMM1
+	R_XMM2
+	R_XMM3
+	R_XMM4
+	R_XMM5
+	R_XMM6
+	R_XMM7
+	R_XMM8
+	R_XMM9
+	R_XMM10
+	R_XMM11
+	R_XMM12
+	R_XMM13
+	R_XMM14
+	R_XMM15
 )
 
+// isXMM reports whether r is one of the 128-bit SSE registers.
+func (r Register) isXMM() bool {
+	return r >= R_XMM0 && r <= R_XMM15
+}
+
 func (r Register) String() string {
+	if r.isXMM() {
+		return fmt.Sprintf("XMM%d", r-R_XMM0)
+	}
 	switch r {
 	case R_AL:
 		return "AL"
@@ -405,6 +433,11 @@ func ParseReg(r string) (Register, error) {
 		return R_SIL, nil
 
 	default:
+		if n, ok := strings.CutPrefix(r, "XMM"); ok {
+			if i, err := strconv.Atoi(n); err == nil && i >= 0 && i <= 15 && strconv.Itoa(i) == n {
+				return R_XMM0 + Register(i), nil
+			}
+		}
 		return 0, fmt.Errorf("No such register: %s", r)
 	}
 }
@@ -413,6 +446,9 @@ func (r Register) needREX() bool {
 	if r == R_DIL || r == R_SIL {
 		return true
 	}
+	if r.isXMM() {
+		return r >= R_XMM8
+	}
 	switch r.fullReg() {
 	case R8:
 		return true
@@ -436,6 +472,9 @@ func (r Register) needREX() bool {
 }
 
 func (r Register) byte() byte {
+	if r.isXMM() {
+		return byte(r - R_XMM0)
+	}
 	switch r {
 	case R_AL:
 		fallthrough
@@ -538,6 +577,9 @@ func (r Register) byte() byte {
 }
 
 func (r Register) Width() int {
+	if r.isXMM() {
+		return 128
+	}
 	switch r {
 	case R_AL:
 		return 8
@@ -717,6 +759,9 @@ func (r Register) brother8() (Register, bool) {
 }
 
 func (r Register) fullReg() Register {
+	if r.isXMM() {
+		return r
+	}
 	switch r {
 	case R_AL:
 		fallthrough
@@ -855,6 +900,9 @@ func (r Register) subRegisters8() ([]Register, bool) {
 }
 
 func (r Register) partial(size int) (Register, bool) {
+	if r.isXMM() {
+		return r, size == 128
+	}
 	switch r {
 	case R_RAX:
 		if size == 8 {
@@ -5,8 +5,9 @@ import (
 )
 
 type rstate struct {
-	inuse bool
-	size  int
+	inuse    bool
+	size     int
+	reserved bool // not handed out by Get; see Reserve
 }
 
 type Regval struct {
@@ -57,6 +58,9 @@ func NewRegisters() *Registers {
 	rs.rs[R14] = &rstate{}
 	rs.rs[R15B] = &rstate{}
 	rs.rs[R15] = &rstate{}
+	for _, r := range regsXMM {
+		rs.rs[r] = &rstate{}
+	}
 	return rs
 }
 
@@ -90,8 +94,16 @@ var callee_saved = []Register{R_RBX, R12, R13, R14, R15, R_RBP, R_RSP}
 // All remaining registers
 var other_regs = []Register{R10, R11}
 
+// The System V float argument registers, in argument order.
+var float_arg_regs = []Register{R_XMM0, R_XMM1, R_XMM2, R_XMM3, R_XMM4, R_XMM5, R_XMM6, R_XMM7}
+
+// The SSE registers in allocation order. Like the general-purpose list, the
+// argument registers come last, least-used first. None of them survive a call.
+var regsXMM = []Register{R_XMM8, R_XMM9, R_XMM10, R_XMM11, R_XMM12, R_XMM13, R_XMM14, R_XMM15,
+	R_XMM7, R_XMM6, R_XMM5, R_XMM4, R_XMM3, R_XMM2, R_XMM1, R_XMM0}
+
 // All registers that need to be saved by a caller before calling.
-var caller_saved = []Register{R_RAX, R_RCX, R_RDX, R_RSI, R_RDI, R8, R9, R10, R11}
+var caller_saved = append([]Register{R_RAX, R_RCX, R_RDX, R_RSI, R_RDI, R8, R9, R10, R11}, regsXMM...)
 
 var regs64_prefer_callee_saved = append(append(callee_saved, other_regs...), func_arg_regs...)
 
@@ -106,6 +118,9 @@ func (rs *Registers) unused8() (Register, bool) {
 	for _, r := range regs8 {
 		if !rs.rs[r].inuse {
 			full := rs.rs[r.fullReg()]
+			if full.reserved {
+				continue
+			}
 			if !full.inuse || (full.inuse && full.size == 8) {
 				return r, true
 			}
@@ -129,7 +144,7 @@ func (rs *Registers) Get(size int) (Register, bool) {
 		return r, true
 	} else {
 		for _, r := range regs64 {
-			if !rs.rs[r].inuse {
+			if !rs.rs[r].inuse && !rs.rs[r].reserved {
 				if pr, ok := r.partial(size); ok {
 					rs.rs[r].inuse = true
 					rs.rs[r].size = size
@@ -141,6 +156,25 @@ func (rs *Registers) Get(size int) (Register, bool) {
 	}
 }
 
+// Reserve keeps Get from handing out r, or any part of it. r can still be
+// used by name.
+func (rs *Registers) Reserve(r Register) {
+	rs.rs[r.fullReg()].reserved = true
+}
+
+// GetXMM requests the use of an SSE register.
+// Registers should be Released when they are no longer needed.
+func (rs *Registers) GetXMM() (Register, bool) {
+	for _, r := range regsXMM {
+		if !rs.rs[r].inuse {
+			rs.rs[r].inuse = true
+			rs.rs[r].size = r.Width()
+			return r, true
+		}
+	}
+	return 0, false
+}
+
 // InUse returns whether or not a register is currently in use.
 // This includes parent/sub registers.
 // For instance, if rax is in use, this will return true for rax, eax, ax, al, ah.
@@ -0,0 +1,209 @@
+package gbasm
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+)
+
+// A relaxable jump is a JMP or Jcc to a label inside the same function.
+// Jump always emits the rel32 form; relax rewrites the ones whose target
+// ends up within reach of a rel8 to the 2-byte short form.
+type relaxJump struct {
+	start  int    // offset of the first opcode byte in f.bs
+	long   int    // length of the rel32 form (5 for JMP, 6 for Jcc)
+	short  byte   // opcode of the rel8 form
+	label  string // target label
+	isLong bool   // whether the jump currently needs the rel32 form
+}
+
+// relaxable returns the short-form opcode for the rel32 jump whose
+// displacement is at disp in bs, along with the offset its encoding starts
+// at. CALL has no short form.
+func relaxable(bs []byte, disp int) (start int, short byte, ok bool) {
+	if disp >= 1 && bs[disp-1] == 0xE9 {
+		return disp - 1, 0xEB, true
+	}
+	if disp >= 2 && bs[disp-2] == 0x0F && bs[disp-1]&0xF0 == 0x80 {
+		return disp - 2, 0x70 | (bs[disp-1] & 0x0F), true
+	}
+	return 0, 0, false
+}
+
+// A relaxItem is a stretch of f.bs whose length relax may change: a
+// relaxable jump, alignment padding, or a prologue or epilogue.
+type relaxItem struct {
+	start int
+	len   int // length in the unrelaxed code
+	jump  *relaxJump
+	pad   *alignPad
+	frame *framePart
+}
+
+// size returns the item's length if it starts at off in the relaxed layout.
+func (it *relaxItem) size(off int) int {
+	if it.pad != nil {
+		return padLen(off, it.pad.align)
+	}
+	if it.frame != nil {
+		return it.frame.code.Len()
+	}
+	if it.jump.isLong {
+		return it.jump.long
+	}
+	return 2
+}
+
+// relax shortens local jumps to rel8 where possible. It starts with every
+// candidate in the short form and lengthens the ones whose displacement
+// doesn't fit, recomputing the layout until nothing changes. A jump is
+// never shortened again once lengthened, so this terminates, and the last
+// pass checks every short jump against the final layout.
+//
+// Alignment padding is recomputed for each layout. Shrinking the code
+// before a pad can grow it, so a jump across padding may need to be
+// lengthened even though the code it spans got shorter.
+//
+// Prologues and epilogues shrink from their placeholders to the code
+// layoutFrame encoded for them.
+//
+// On return f.bs holds the relaxed code with every remaining jump still
+// unpatched, and f.labels, f.jumps, f.pads, f.frames, f.Relocations,
+// f.Symbols, the listing and f.placed have been moved to their new
+// offsets.
+func (f *Function) relax() {
+	bs := f.bs.Bytes()
+	var items, pads []*relaxItem
+	for i := range f.pads {
+		p := &f.pads[i]
+		pads = append(pads, &relaxItem{start: p.start, len: p.len, pad: p})
+	}
+	for i := range f.frames {
+		p := &f.frames[i]
+		items = append(items, &relaxItem{start: p.start, len: p.len, frame: p})
+	}
+	for _, rel := range f.jumps {
+		if _, ok := f.labels[rel.Symbol]; !ok {
+			continue
+		}
+		start, short, ok := relaxable(bs, int(rel.Offset))
+		if !ok {
+			continue
+		}
+		j := &relaxJump{
+			start: start,
+			long:  int(rel.Offset) + 4 - start,
+			short: short,
+			label: rel.Symbol,
+		}
+		items = append(items, &relaxItem{start: start, len: j.long, jump: j})
+	}
+	if len(items) == 0 {
+		return
+	}
+	// Padding sorts ahead of a jump at the same offset, which it was
+	// emitted before.
+	items = append(pads, items...)
+	sort.SliceStable(items, func(i, j int) bool { return items[i].start < items[j].start })
+
+	// newOffset maps an offset in the unrelaxed code to the relaxed layout.
+	// Any offset after an item's start moves by the difference between its
+	// unrelaxed and relaxed lengths. So does an offset at the start of
+	// padding: a label there marks the aligned code, whether it was
+	// declared before or after the padding was.
+	newOffset := func(off int) int {
+		saved := 0
+		for _, it := range items {
+			if it.start > off || it.start == off && it.pad == nil {
+				break
+			}
+			saved += it.len - it.size(it.start-saved)
+		}
+		return off - saved
+	}
+
+	for changed := true; changed; {
+		changed = false
+		for _, it := range items {
+			j := it.jump
+			if j == nil || j.isLong {
+				continue
+			}
+			end := newOffset(j.start) + 2
+			disp := newOffset(f.labels[j.label]) - end
+			if disp < -128 || disp > 127 {
+				j.isLong = true
+				changed = true
+			}
+		}
+	}
+
+	var out bytes.Buffer
+	prev := 0
+	shortAt := make(map[int]bool)
+	for _, it := range items {
+		if it.jump != nil && it.jump.isLong {
+			continue
+		}
+		out.Write(bs[prev:it.start])
+		if j := it.jump; j != nil {
+			out.WriteByte(j.short)
+			out.WriteByte(byte(int8(newOffset(f.labels[j.label]) - (newOffset(j.start) + 2))))
+			shortAt[j.start+j.long-4] = true
+		} else if p := it.frame; p != nil {
+			p.start = out.Len()
+			p.len = p.code.Len()
+			out.Write(p.code.Bytes())
+		} else {
+			it.pad.start = out.Len()
+			it.pad.len = it.size(it.pad.start)
+			out.Write(appendNops(nil, it.pad.len))
+		}
+		prev = it.start + it.len
+	}
+	out.Write(bs[prev:])
+
+	var jumps []Relocation
+	for _, rel := range f.jumps {
+		if shortAt[int(rel.Offset)] {
+			continue
+		}
+		rel.Offset = uint32(newOffset(int(rel.Offset)))
+		jumps = append(jumps, rel)
+	}
+	f.jumps = jumps
+	for l, off := range f.labels {
+		f.labels[l] = newOffset(off)
+	}
+	for i := range f.Relocations {
+		f.Relocations[i].Offset = uint32(newOffset(int(f.Relocations[i].Offset)))
+	}
+	for i := range f.Symbols {
+		f.Symbols[i].Offset = uint32(newOffset(int(f.Symbols[i].Offset)))
+	}
+	for i := range f.placed {
+		f.placed[i].off = newOffset(f.placed[i].off)
+	}
+	for i := range f.listing {
+		e := &f.listing[i]
+		if e.Len == 0 {
+			e.Offset = newOffset(e.Offset)
+			continue
+		}
+		// Map the last byte rather than the end, which may be where
+		// padding starts.
+		end := newOffset(e.Offset+e.Len-1) + 1
+		e.Offset = newOffset(e.Offset)
+		if e.Len != end-e.Offset {
+			e.Len = end - e.Offset
+			e.Form = strings.Replace(e.Form, "rel32", "rel8", 1)
+		}
+	}
+	for _, p := range f.pads {
+		if p.entry >= 0 {
+			f.listing[p.entry].Offset = p.start
+			f.listing[p.entry].Len = p.len
+		}
+	}
+	f.bs = out
+}
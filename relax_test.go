@@ -0,0 +1,92 @@
+package gbasm
+
+import (
+	"testing"
+)
+
+func TestRelaxJumps(t *testing.T) {
+	o, err := NewOFile("relax", "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := o.NewFunction("relax.bs", 1, "f")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pad := func(n int) {
+		// MOV RAX, RBX is 3 bytes.
+		for i := 0; i < n; i++ {
+			f.Instr("MOV", R_RAX, R_RBX)
+		}
+	}
+
+	f.Label("top")
+	f.Instr("ADD", R_RAX, int8(1))
+	f.Jump("JNE", "top") // backward, short
+	f.Jump("JMP", "mid") // only fits once the JE below is short too
+	f.Jump("JE", "near") // forward, short
+	f.Label("near")
+	pad(41)
+	f.Label("mid")
+	f.Jump("JE", "far") // forward by more than 127 bytes, stays long
+	f.Jump("CALL", "elsewhere")
+	pad(43)
+	f.Label("far")
+	f.Jump("JMP", "top") // backward by more than 128 bytes, stays long
+	f.Instr("RET")
+
+	bs, err := f.Body()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, err := Disassemble(bs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type jump struct {
+		mnemonic string
+		len      int
+		label    string
+	}
+	want := []jump{
+		{"JNE", 2, "top"},
+		{"JMP", 2, "mid"},
+		{"JE", 2, "near"},
+		{"JE", 6, "far"},
+		{"JMP", 5, "top"},
+	}
+	var got []jump
+	for _, d := range ds {
+		if d.Mnemonic == "CALL" || d.Mnemonic == "RET" || d.Mnemonic == "MOV" || d.Mnemonic == "ADD" {
+			continue
+		}
+		target, ok := d.Target()
+		if !ok {
+			t.Fatalf("%s has no target", d)
+		}
+		var label string
+		for l, off := range f.labels {
+			if off == target {
+				label = l
+			}
+		}
+		got = append(got, jump{d.Mnemonic, d.Len(), label})
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Expected jumps %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Jump %d: expected %v, got %v", i, want[i], got[i])
+		}
+	}
+
+	if len(f.Relocations) != 1 {
+		t.Fatalf("Expected a single relocation, got %v", f.Relocations)
+	}
+	rel := f.Relocations[0]
+	if rel.Symbol != "main.elsewhere" || bs[rel.Offset-1] != 0xE8 {
+		t.Errorf("Relocation %v does not point at the CALL displacement", rel)
+	}
+}
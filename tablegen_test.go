@@ -0,0 +1,195 @@
+package gbasm
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"testing"
+)
+
+var regen = flag.Bool("regen", false, "Regenerate x86_64_table.go from x86_64.xml.")
+
+const tableFile = "x86_64_table.go"
+
+// TestInstructionTable checks that the generated instruction table is in sync
+// with x86_64.xml. Run with -regen to rewrite it.
+func TestInstructionTable(t *testing.T) {
+	xis, err := DecodeFile("x86_64.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	src, err := renderTable(xis)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *regen {
+		if err := os.WriteFile(tableFile, src, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	current, err := os.ReadFile(tableFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(current, src) {
+		t.Fatalf("%s is out of date with x86_64.xml. Run go generate.", tableFile)
+	}
+}
+
+func renderTable(xis *XInstructionSet) ([]byte, error) {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by \"go test -run TestInstructionTable -regen\"; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package gbasm\n\n")
+	fmt.Fprintf(&b, "const amd64Arch = %q\n\n", xis.Name)
+	fmt.Fprintf(&b, "// amd64Table holds every instruction in x86_64.xml with the forms parseForm\n")
+	fmt.Fprintf(&b, "// accepts, in document order.\n")
+	fmt.Fprintf(&b, "var amd64Table = []struct {\n\tname  string\n\tinstr Instruction\n}{\n")
+	for _, xi := range xis.XInstructions {
+		fmt.Fprintf(&b, "{%q, Instruction{Summary: %q, Forms: []IForm{\n", xi.Name, xi.Summary)
+		for _, xform := range xi.Forms {
+			f, err := parseForm(xform)
+			if err != nil {
+				continue
+			}
+			fmt.Fprintf(&b, "{name: %q, opcount: %d, ops: []Op{", f.name, f.opcount)
+			for i, op := range f.ops {
+				if i > 0 {
+					b.WriteString(", ")
+				}
+				fmt.Fprintf(&b, "{TN: %q", op.TN)
+				if op.Output {
+					b.WriteString(", Output: true")
+				}
+				if op.Implicit {
+					b.WriteString(", Implicit: true")
+				}
+				b.WriteString("}")
+			}
+			b.WriteString("}, enc: [][]Encoder{\n")
+			for _, es := range f.enc {
+				b.WriteString("{")
+				for i, e := range es {
+					if i > 0 {
+						b.WriteString(", ")
+					}
+					s, err := renderEncoder(e)
+					if err != nil {
+						return nil, err
+					}
+					b.WriteString(s)
+				}
+				b.WriteString("},\n")
+			}
+			b.WriteString("}},\n")
+		}
+		b.WriteString("}}},\n")
+	}
+	b.WriteString("}\n")
+	return format.Source(b.Bytes())
+}
+
+func renderEncoder(e Encoder) (string, error) {
+	switch x := e.(type) {
+	case *prefix:
+		return fmt.Sprintf("&prefix{b: %#02x}", x.b), nil
+	case *rex:
+		return fmt.Sprintf("&rex{mandatory: %t, w: %d, r: %d, x: %d, b: %d}", x.mandatory, x.w, x.r, x.x, x.b), nil
+	case *opcode:
+		return fmt.Sprintf("&opcode{op: %#02x, hasAddend: %t, addend: %d}", x.op, x.hasAddend, x.addend), nil
+	case *modrm:
+		return fmt.Sprintf("&modrm{mod: %#02x, reg: %#02x, rm: %d}", x.mod, x.reg, x.rm), nil
+	case *immediate:
+		return fmt.Sprintf("&immediate{size: %d, value: %d}", x.size, x.value), nil
+	case *codeOffset:
+		return fmt.Sprintf("&codeOffset{size: %d, value: %d}", x.size, x.value), nil
+	case *dataOffset:
+		return fmt.Sprintf("&dataOffset{size: %d, value: %d}", x.size, x.value), nil
+	}
+	return "", fmt.Errorf("Cannot render encoder %#v", e)
+}
+
+// TestTableEncodesLikeXML encodes a corpus of operand combinations for every
+// instruction with both the generated, indexed table (LoadAsm) and the
+// unindexed XML reference (ParseFile) and requires byte-identical results.
+func TestTableEncodesLikeXML(t *testing.T) {
+	ref, err := ParseFile("x86_64.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tab, err := LoadAsm(AMD64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ref.instrs) != len(tab.instrs) || len(ref.specforms) != len(tab.specforms) {
+		t.Fatalf("Table has %d instructions and %d forms, XML has %d and %d",
+			len(tab.instrs), len(tab.specforms), len(ref.instrs), len(ref.specforms))
+	}
+
+	regs := []interface{}{R_SIL, R8B, R9W, R_EAX, R_RSP, R13, R_XMM9}
+	mems := []interface{}{
+		Indirect{Reg: R_RBP, Off: -8, Size: 64},
+		Indirect{Reg: R12, Size: 8},
+		Indirect{Reg: R_RAX},
+		Indirect{Symbol: "x", Off: 8, Size: 32},
+		IndirectBaseIndexScale{Base: R13, Index: R_RDX, Scale: 2, Off: 300},
+		&Var{Name: "v"},
+	}
+	imms := []interface{}{uint8(200), int8(-3), uint16(40000), int32(-70000), uint64(1 << 40)}
+
+	var all []interface{}
+	all = append(all, regs...)
+	all = append(all, mems...)
+	all = append(all, imms...)
+
+	var corpus [][]interface{}
+	corpus = append(corpus, nil)
+	for _, a := range all {
+		corpus = append(corpus, []interface{}{a})
+		for _, b := range all {
+			corpus = append(corpus, []interface{}{a, b})
+		}
+	}
+	for _, a := range regs {
+		for _, b := range append(regs[:len(regs):len(regs)], mems...) {
+			for _, c := range imms {
+				corpus = append(corpus, []interface{}{a, b, c})
+			}
+		}
+	}
+
+	encode := func(a *Asm, instr string, os []interface{}) (out string) {
+		defer func() {
+			if e := recover(); e != nil {
+				out = fmt.Sprintf("panic: %v", e)
+			}
+		}()
+		var bs bytes.Buffer
+		rels, err := a.Encode(&bs, instr, os...)
+		return fmt.Sprintf("% x %v %v", bs.Bytes(), rels, err)
+	}
+
+	var names []string
+	for n, i := range ref.instrs {
+		if len(i.Forms) > 0 {
+			names = append(names, n)
+		}
+	}
+	for n := range ref.specforms {
+		names = append(names, n)
+	}
+	var compared int
+	for _, n := range names {
+		for _, os := range corpus {
+			want := encode(ref, n, os)
+			got := encode(tab, n, os)
+			if got != want {
+				t.Errorf("%s %v:\n\ttable: %s\n\txml:   %s", n, os, got, want)
+			}
+			compared++
+		}
+	}
+	t.Logf("Compared %d encodings", compared)
+}
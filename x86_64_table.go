@@ -0,0 +1,7422 @@
+// Code generated by "go test -run TestInstructionTable -regen"; DO NOT EDIT.
+
+package gbasm
+
+const amd64Arch = "x86-64"
+
+// amd64Table holds every instruction in x86_64.xml with the forms parseForm
+// accepts, in document order.
+var amd64Table = []struct {
+	name  string
+	instr Instruction
+}{
+	{"ADC", Instruction{Summary: "Add with Carry", Forms: []IForm{
+		{name: "ADCB", opcount: 1, ops: []Op{{TN: "al", Output: true, Implicit: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&opcode{op: 0x14, hasAddend: false, addend: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ADCB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x80, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x82, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ADCB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "r8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x10, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x12, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "ADCB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "m8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x12, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "ADCW", opcount: 1, ops: []Op{{TN: "ax", Output: true, Implicit: true}, {TN: "imm16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &opcode{op: 0x15, hasAddend: false, addend: 0}, &immediate{size: 2, value: 1}},
+		}},
+		{name: "ADCW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x82, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ADCW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "imm16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x82, rm: 0}, &immediate{size: 2, value: 1}},
+		}},
+		{name: "ADCW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x11, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x13, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "ADCW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x13, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "ADCL", opcount: 1, ops: []Op{{TN: "eax", Output: true, Implicit: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&opcode{op: 0x15, hasAddend: false, addend: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "ADCL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x82, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ADCL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x82, rm: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "ADCL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x11, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x13, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "ADCL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x13, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "ADCQ", opcount: 1, ops: []Op{{TN: "rax", Output: true, Implicit: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x15, hasAddend: false, addend: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "ADCQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x82, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ADCQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x82, rm: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "ADCQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x11, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x13, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "ADCQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x13, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "ADCB", opcount: 2, ops: []Op{{TN: "m8", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x80, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x82, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ADCB", opcount: 2, ops: []Op{{TN: "m8", Output: true}, {TN: "r8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x10, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "ADCW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x82, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ADCW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "imm16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x82, rm: 0}, &immediate{size: 2, value: 1}},
+		}},
+		{name: "ADCW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x11, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "ADCL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x82, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ADCL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x82, rm: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "ADCL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x11, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "ADCQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x82, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ADCQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x82, rm: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "ADCQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x11, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+	}}},
+	{"ADCX", Instruction{Summary: "Unsigned Integer Addition of Two Operands with Carry Flag", Forms: []IForm{
+		{name: "ADCXL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xf6, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "ADCXL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xf6, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "ADCXQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xf6, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "ADCXQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xf6, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"ADD", Instruction{Summary: "Add", Forms: []IForm{
+		{name: "ADDB", opcount: 1, ops: []Op{{TN: "al", Output: true, Implicit: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&opcode{op: 0x04, hasAddend: false, addend: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ADDB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x80, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ADDB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "r8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x00, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x02, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "ADDB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "m8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x02, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "ADDW", opcount: 1, ops: []Op{{TN: "ax", Output: true, Implicit: true}, {TN: "imm16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &opcode{op: 0x05, hasAddend: false, addend: 0}, &immediate{size: 2, value: 1}},
+		}},
+		{name: "ADDW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ADDW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "imm16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}, &immediate{size: 2, value: 1}},
+		}},
+		{name: "ADDW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x01, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x03, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "ADDW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x03, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "ADDL", opcount: 1, ops: []Op{{TN: "eax", Output: true, Implicit: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&opcode{op: 0x05, hasAddend: false, addend: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "ADDL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ADDL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "ADDL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x01, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x03, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "ADDL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x03, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "ADDQ", opcount: 1, ops: []Op{{TN: "rax", Output: true, Implicit: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x05, hasAddend: false, addend: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "ADDQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ADDQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "ADDQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x01, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x03, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "ADDQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x03, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "ADDB", opcount: 2, ops: []Op{{TN: "m8", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x80, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ADDB", opcount: 2, ops: []Op{{TN: "m8", Output: true}, {TN: "r8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x00, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "ADDW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ADDW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "imm16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}, &immediate{size: 2, value: 1}},
+		}},
+		{name: "ADDW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x01, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "ADDL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ADDL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "ADDL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x01, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "ADDQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ADDQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "ADDQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x01, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+	}}},
+	{"ADDPD", Instruction{Summary: "Add Packed Double-Precision Floating-Point Values", Forms: []IForm{
+		{name: "ADDPD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x58, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "ADDPD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x58, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"ADDPS", Instruction{Summary: "Add Packed Single-Precision Floating-Point Values", Forms: []IForm{
+		{name: "ADDPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x58, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "ADDPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x58, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"ADDSD", Instruction{Summary: "Add Scalar Double-Precision Floating-Point Values", Forms: []IForm{
+		{name: "ADDSD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x58, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "ADDSD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x58, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"ADDSS", Instruction{Summary: "Add Scalar Single-Precision Floating-Point Values", Forms: []IForm{
+		{name: "ADDSS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x58, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "ADDSS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x58, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"ADDSUBPD", Instruction{Summary: "Packed Double-FP Add/Subtract", Forms: []IForm{
+		{name: "ADDSUBPD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xd0, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "ADDSUBPD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xd0, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"ADDSUBPS", Instruction{Summary: "Packed Single-FP Add/Subtract", Forms: []IForm{
+		{name: "ADDSUBPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xd0, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "ADDSUBPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xd0, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"ADOX", Instruction{Summary: "Unsigned Integer Addition of Two Operands with Overflow Flag", Forms: []IForm{
+		{name: "ADOXL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xf6, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "ADOXL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xf6, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "ADOXQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xf6, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "ADOXQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xf6, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"AESDEC", Instruction{Summary: "Perform One Round of an AES Decryption Flow", Forms: []IForm{
+		{name: "AESDEC", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xde, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "AESDEC", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xde, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"AESDECLAST", Instruction{Summary: "Perform Last Round of an AES Decryption Flow", Forms: []IForm{
+		{name: "AESDECLAST", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xdf, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "AESDECLAST", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xdf, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"AESENC", Instruction{Summary: "Perform One Round of an AES Encryption Flow", Forms: []IForm{
+		{name: "AESENC", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xdc, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "AESENC", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xdc, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"AESENCLAST", Instruction{Summary: "Perform Last Round of an AES Encryption Flow", Forms: []IForm{
+		{name: "AESENCLAST", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xdd, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "AESENCLAST", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xdd, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"AESIMC", Instruction{Summary: "Perform the AES InvMixColumn Transformation", Forms: []IForm{
+		{name: "AESIMC", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xdb, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "AESIMC", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xdb, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"AESKEYGENASSIST", Instruction{Summary: "AES Round Key Generation Assist", Forms: []IForm{
+		{name: "AESKEYGENASSIST", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0xdf, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "AESKEYGENASSIST", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0xdf, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+	}}},
+	{"AND", Instruction{Summary: "Logical AND", Forms: []IForm{
+		{name: "ANDB", opcount: 1, ops: []Op{{TN: "al", Output: true, Implicit: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&opcode{op: 0x24, hasAddend: false, addend: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ANDB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x80, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ANDB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "r8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x20, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x22, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "ANDB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "m8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x22, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "ANDW", opcount: 1, ops: []Op{{TN: "ax", Output: true, Implicit: true}, {TN: "imm16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &opcode{op: 0x25, hasAddend: false, addend: 0}, &immediate{size: 2, value: 1}},
+		}},
+		{name: "ANDW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ANDW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "imm16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}, &immediate{size: 2, value: 1}},
+		}},
+		{name: "ANDW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x21, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x23, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "ANDW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x23, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "ANDL", opcount: 1, ops: []Op{{TN: "eax", Output: true, Implicit: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&opcode{op: 0x25, hasAddend: false, addend: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "ANDL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ANDL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "ANDL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x21, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x23, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "ANDL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x23, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "ANDQ", opcount: 1, ops: []Op{{TN: "rax", Output: true, Implicit: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x25, hasAddend: false, addend: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "ANDQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ANDQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "ANDQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x21, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x23, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "ANDQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x23, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "ANDB", opcount: 2, ops: []Op{{TN: "m8", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x80, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x84, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ANDB", opcount: 2, ops: []Op{{TN: "m8", Output: true}, {TN: "r8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x20, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "ANDW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x84, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ANDW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "imm16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x84, rm: 0}, &immediate{size: 2, value: 1}},
+		}},
+		{name: "ANDW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x21, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "ANDL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x84, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ANDL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x84, rm: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "ANDL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x21, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "ANDQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x84, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ANDQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x84, rm: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "ANDQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x21, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+	}}},
+	{"ANDN", Instruction{Summary: "Logical AND NOT", Forms: []IForm{}}},
+	{"ANDNPD", Instruction{Summary: "Bitwise Logical AND NOT of Packed Double-Precision Floating-Point Values", Forms: []IForm{
+		{name: "ANDNPD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x55, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "ANDNPD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x55, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"ANDNPS", Instruction{Summary: "Bitwise Logical AND NOT of Packed Single-Precision Floating-Point Values", Forms: []IForm{
+		{name: "ANDNPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x55, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "ANDNPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x55, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"ANDPD", Instruction{Summary: "Bitwise Logical AND of Packed Double-Precision Floating-Point Values", Forms: []IForm{
+		{name: "ANDPD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x54, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "ANDPD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x54, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"ANDPS", Instruction{Summary: "Bitwise Logical AND of Packed Single-Precision Floating-Point Values", Forms: []IForm{
+		{name: "ANDPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x54, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "ANDPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x54, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"BEXTR", Instruction{Summary: "Bit Field Extract", Forms: []IForm{}}},
+	{"BLCFILL", Instruction{Summary: "Fill From Lowest Clear Bit", Forms: []IForm{}}},
+	{"BLCI", Instruction{Summary: "Isolate Lowest Clear Bit", Forms: []IForm{}}},
+	{"BLCIC", Instruction{Summary: "Isolate Lowest Set Bit and Complement", Forms: []IForm{}}},
+	{"BLCMSK", Instruction{Summary: "Mask From Lowest Clear Bit", Forms: []IForm{}}},
+	{"BLCS", Instruction{Summary: "Set Lowest Clear Bit", Forms: []IForm{}}},
+	{"BLENDPD", Instruction{Summary: "Blend Packed Double Precision Floating-Point Values", Forms: []IForm{
+		{name: "BLENDPD", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x0d, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "BLENDPD", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x0d, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+	}}},
+	{"BLENDPS", Instruction{Summary: " Blend Packed Single Precision Floating-Point Values", Forms: []IForm{
+		{name: "BLENDPS", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x0c, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "BLENDPS", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x0c, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+	}}},
+	{"BLENDVPD", Instruction{Summary: " Variable Blend Packed Double Precision Floating-Point Values", Forms: []IForm{
+		{name: "BLENDVPD", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}, {TN: "xmm0"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x15, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "BLENDVPD", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}, {TN: "xmm0"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x15, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"BLENDVPS", Instruction{Summary: " Variable Blend Packed Single Precision Floating-Point Values", Forms: []IForm{
+		{name: "BLENDVPS", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}, {TN: "xmm0"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x14, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "BLENDVPS", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}, {TN: "xmm0"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x14, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"BLSFILL", Instruction{Summary: "Fill From Lowest Set Bit", Forms: []IForm{}}},
+	{"BLSI", Instruction{Summary: "Isolate Lowest Set Bit", Forms: []IForm{}}},
+	{"BLSIC", Instruction{Summary: "Isolate Lowest Set Bit and Complement", Forms: []IForm{}}},
+	{"BLSMSK", Instruction{Summary: "Mask From Lowest Set Bit", Forms: []IForm{}}},
+	{"BLSR", Instruction{Summary: "Reset Lowest Set Bit", Forms: []IForm{}}},
+	{"BSF", Instruction{Summary: "Bit Scan Forward", Forms: []IForm{
+		{name: "BSFW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xbc, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "BSFW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xbc, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "BSFL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xbc, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "BSFL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xbc, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "BSFQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xbc, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "BSFQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xbc, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"BSR", Instruction{Summary: "Bit Scan Reverse", Forms: []IForm{
+		{name: "BSRW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xbd, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "BSRW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xbd, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "BSRL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xbd, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "BSRL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xbd, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "BSRQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xbd, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "BSRQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xbd, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"BSWAP", Instruction{Summary: "Byte Swap", Forms: []IForm{
+		{name: "BSWAPL", opcount: 1, ops: []Op{{TN: "r32", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xc8, hasAddend: true, addend: 0}},
+		}},
+		{name: "BSWAPQ", opcount: 1, ops: []Op{{TN: "r64", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xc8, hasAddend: true, addend: 0}},
+		}},
+	}}},
+	{"BT", Instruction{Summary: "Bit Test", Forms: []IForm{
+		{name: "BTW", opcount: 2, ops: []Op{{TN: "r16"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xba, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "BTW", opcount: 2, ops: []Op{{TN: "r16"}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xa3, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "BTL", opcount: 2, ops: []Op{{TN: "r32"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xba, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "BTL", opcount: 2, ops: []Op{{TN: "r32"}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xa3, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "BTQ", opcount: 2, ops: []Op{{TN: "r64"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xba, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "BTQ", opcount: 2, ops: []Op{{TN: "r64"}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xa3, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "BTW", opcount: 2, ops: []Op{{TN: "m16"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xba, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x84, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "BTW", opcount: 2, ops: []Op{{TN: "m16"}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xa3, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "BTL", opcount: 2, ops: []Op{{TN: "m32"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xba, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x84, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "BTL", opcount: 2, ops: []Op{{TN: "m32"}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xa3, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "BTQ", opcount: 2, ops: []Op{{TN: "m64"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xba, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x84, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "BTQ", opcount: 2, ops: []Op{{TN: "m64"}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xa3, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+	}}},
+	{"BTC", Instruction{Summary: "Bit Test and Complement", Forms: []IForm{
+		{name: "BTCW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xba, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x87, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "BTCW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xbb, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "BTCL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xba, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x87, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "BTCL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xbb, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "BTCQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xba, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x87, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "BTCQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xbb, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "BTCW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xba, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x87, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "BTCW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xbb, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "BTCL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xba, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x87, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "BTCL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xbb, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "BTCQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xba, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x87, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "BTCQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xbb, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+	}}},
+	{"BTR", Instruction{Summary: "Bit Test and Reset", Forms: []IForm{
+		{name: "BTRW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xba, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x86, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "BTRW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xb3, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "BTRL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xba, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x86, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "BTRL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xb3, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "BTRQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xba, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x86, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "BTRQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xb3, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "BTRW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xba, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x86, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "BTRW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xb3, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "BTRL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xba, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x86, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "BTRL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xb3, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "BTRQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xba, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x86, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "BTRQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xb3, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+	}}},
+	{"BTS", Instruction{Summary: "Bit Test and Set", Forms: []IForm{
+		{name: "BTSW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xba, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x85, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "BTSW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xab, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "BTSL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xba, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x85, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "BTSL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xab, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "BTSQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xba, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x85, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "BTSQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xab, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "BTSW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xba, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x85, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "BTSW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xab, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "BTSL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xba, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x85, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "BTSL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xab, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "BTSQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xba, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x85, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "BTSQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xab, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+	}}},
+	{"BZHI", Instruction{Summary: "Zero High Bits Starting with Specified Bit Position", Forms: []IForm{}}},
+	{"CALL", Instruction{Summary: "Call Procedure", Forms: []IForm{
+		{name: "CALL", opcount: 1, ops: []Op{{TN: "rel32"}}, enc: [][]Encoder{
+			{&opcode{op: 0xe8, hasAddend: false, addend: 0}, &codeOffset{size: 4, value: 0}},
+		}},
+		{name: "CALLQ", opcount: 1, ops: []Op{{TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xff, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x82, rm: 0}},
+		}},
+		{name: "CALLQ", opcount: 1, ops: []Op{{TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xff, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x82, rm: 0}},
+		}},
+	}}},
+	{"CBW", Instruction{Summary: "Convert Byte to Word", Forms: []IForm{
+		{name: "CBTW", opcount: 0, ops: []Op{{TN: "ax", Output: true, Implicit: true}, {TN: "al", Implicit: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &opcode{op: 0x98, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"CDQ", Instruction{Summary: "Convert Doubleword to Quadword", Forms: []IForm{
+		{name: "CLTD", opcount: 0, ops: []Op{{TN: "eax", Implicit: true}, {TN: "edx", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&opcode{op: 0x99, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"CDQE", Instruction{Summary: "Convert Doubleword to Quadword", Forms: []IForm{
+		{name: "CLTQ", opcount: 0, ops: []Op{{TN: "eax", Implicit: true}, {TN: "rax", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x98, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"CLC", Instruction{Summary: "Clear Carry Flag", Forms: []IForm{
+		{name: "CLC", opcount: 0, ops: []Op{}, enc: [][]Encoder{
+			{&opcode{op: 0xf8, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"CLD", Instruction{Summary: "Clear Direction Flag", Forms: []IForm{
+		{name: "CLD", opcount: 0, ops: []Op{}, enc: [][]Encoder{
+			{&opcode{op: 0xfc, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"CLFLUSH", Instruction{Summary: "Flush Cache Line", Forms: []IForm{
+		{name: "CLFLUSH", opcount: 1, ops: []Op{{TN: "m8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xae, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x87, rm: 0}},
+		}},
+	}}},
+	{"CLFLUSHOPT", Instruction{Summary: "Flush Cache Line Optimized", Forms: []IForm{
+		{name: "CLFLUSHOPT", opcount: 1, ops: []Op{{TN: "m8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xae, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x87, rm: 0}},
+		}},
+	}}},
+	{"CLWB", Instruction{Summary: "Cache Line Write Back", Forms: []IForm{
+		{name: "CLWB", opcount: 1, ops: []Op{{TN: "m8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xae, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x86, rm: 0}},
+		}},
+	}}},
+	{"CLZERO", Instruction{Summary: "Zero-out 64-bit Cache Line", Forms: []IForm{
+		{name: "CLZERO", opcount: 0, ops: []Op{{TN: "rax", Implicit: true}}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x01, hasAddend: false, addend: 0}, &opcode{op: 0xfc, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"CMC", Instruction{Summary: "Complement Carry Flag", Forms: []IForm{
+		{name: "CMC", opcount: 0, ops: []Op{}, enc: [][]Encoder{
+			{&opcode{op: 0xf5, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"CMOVA", Instruction{Summary: "Move if above (CF == 0 and ZF == 0)", Forms: []IForm{
+		{name: "CMOVA", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x47, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVA", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x47, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVA", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x47, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVA", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x47, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVA", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x47, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVA", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x47, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CMOVAE", Instruction{Summary: "Move if above or equal (CF == 0)", Forms: []IForm{
+		{name: "CMOVAE", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x43, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVAE", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x43, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVAE", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x43, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVAE", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x43, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVAE", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x43, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVAE", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x43, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CMOVB", Instruction{Summary: "Move if below (CF == 1)", Forms: []IForm{
+		{name: "CMOVB", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x42, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVB", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x42, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVB", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x42, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVB", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x42, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVB", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x42, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVB", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x42, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CMOVBE", Instruction{Summary: "Move if below or equal (CF == 1 or ZF == 1)", Forms: []IForm{
+		{name: "CMOVBE", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x46, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVBE", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x46, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVBE", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x46, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVBE", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x46, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVBE", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x46, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVBE", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x46, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CMOVC", Instruction{Summary: "Move if carry (CF == 1)", Forms: []IForm{
+		{name: "CMOVC", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x42, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVC", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x42, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVC", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x42, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVC", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x42, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVC", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x42, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVC", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x42, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CMOVE", Instruction{Summary: "Move if equal (ZF == 1)", Forms: []IForm{
+		{name: "CMOVE", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x44, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVE", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x44, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVE", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x44, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVE", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x44, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVE", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x44, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVE", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x44, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CMOVG", Instruction{Summary: "Move if greater (ZF == 0 and SF == OF)", Forms: []IForm{
+		{name: "CMOVG", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVG", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4f, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVG", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVG", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4f, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVG", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVG", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4f, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CMOVGE", Instruction{Summary: "Move if greater or equal (SF == OF)", Forms: []IForm{
+		{name: "CMOVGE", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4d, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVGE", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4d, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVGE", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4d, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVGE", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4d, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVGE", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4d, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVGE", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4d, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CMOVL", Instruction{Summary: "Move if less (SF != OF)", Forms: []IForm{
+		{name: "CMOVL", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4c, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVL", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4c, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4c, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4c, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVL", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4c, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVL", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4c, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CMOVLE", Instruction{Summary: "Move if less or equal (ZF == 1 or SF != OF)", Forms: []IForm{
+		{name: "CMOVLE", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4e, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVLE", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4e, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVLE", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4e, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVLE", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4e, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVLE", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4e, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVLE", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4e, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CMOVNA", Instruction{Summary: "Move if not above (CF == 1 or ZF == 1)", Forms: []IForm{
+		{name: "CMOVNA", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x46, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNA", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x46, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNA", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x46, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNA", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x46, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNA", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x46, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNA", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x46, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CMOVNAE", Instruction{Summary: "Move if not above or equal (CF == 1)", Forms: []IForm{
+		{name: "CMOVNAE", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x42, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNAE", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x42, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNAE", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x42, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNAE", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x42, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNAE", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x42, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNAE", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x42, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CMOVNB", Instruction{Summary: "Move if not below (CF == 0)", Forms: []IForm{
+		{name: "CMOVNB", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x43, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNB", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x43, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNB", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x43, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNB", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x43, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNB", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x43, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNB", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x43, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CMOVNBE", Instruction{Summary: "Move if not below or equal (CF == 0 and ZF == 0)", Forms: []IForm{
+		{name: "CMOVNBE", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x47, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNBE", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x47, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNBE", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x47, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNBE", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x47, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNBE", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x47, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNBE", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x47, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CMOVNC", Instruction{Summary: "Move if not carry (CF == 0)", Forms: []IForm{
+		{name: "CMOVNC", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x43, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNC", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x43, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNC", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x43, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNC", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x43, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNC", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x43, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNC", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x43, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CMOVNE", Instruction{Summary: "Move if not equal (ZF == 0)", Forms: []IForm{
+		{name: "CMOVNE", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x45, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNE", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x45, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNE", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x45, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNE", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x45, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNE", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x45, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNE", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x45, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CMOVNG", Instruction{Summary: "Move if not greater (ZF == 1 or SF != OF)", Forms: []IForm{
+		{name: "CMOVNG", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4e, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNG", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4e, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNG", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4e, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNG", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4e, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNG", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4e, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNG", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4e, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CMOVNGE", Instruction{Summary: "Move if not greater or equal (SF != OF)", Forms: []IForm{
+		{name: "CMOVNGE", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4c, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNGE", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4c, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNGE", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4c, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNGE", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4c, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNGE", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4c, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNGE", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4c, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CMOVNL", Instruction{Summary: "Move if not less (SF == OF)", Forms: []IForm{
+		{name: "CMOVNL", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4d, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNL", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4d, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4d, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4d, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNL", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4d, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNL", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4d, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CMOVNLE", Instruction{Summary: "Move if not less or equal (ZF == 0 and SF == OF)", Forms: []IForm{
+		{name: "CMOVNLE", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNLE", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4f, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNLE", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNLE", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4f, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNLE", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNLE", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4f, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CMOVNO", Instruction{Summary: "Move if not overflow (OF == 0)", Forms: []IForm{
+		{name: "CMOVNO", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x41, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNO", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x41, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNO", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x41, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNO", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x41, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNO", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x41, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNO", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x41, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CMOVNP", Instruction{Summary: "Move if not parity (PF == 0)", Forms: []IForm{
+		{name: "CMOVNP", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4b, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNP", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4b, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNP", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4b, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNP", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4b, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNP", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4b, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNP", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4b, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CMOVNS", Instruction{Summary: "Move if not sign (SF == 0)", Forms: []IForm{
+		{name: "CMOVNS", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x49, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNS", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x49, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNS", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x49, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNS", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x49, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNS", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x49, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNS", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x49, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CMOVNZ", Instruction{Summary: "Move if not zero (ZF == 0)", Forms: []IForm{
+		{name: "CMOVNZ", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x45, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNZ", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x45, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNZ", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x45, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNZ", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x45, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNZ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x45, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVNZ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x45, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CMOVO", Instruction{Summary: "Move if overflow (OF == 1)", Forms: []IForm{
+		{name: "CMOVO", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x40, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVO", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x40, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVO", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x40, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVO", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x40, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVO", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x40, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVO", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x40, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CMOVP", Instruction{Summary: "Move if parity (PF == 1)", Forms: []IForm{
+		{name: "CMOVP", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4a, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVP", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4a, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVP", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4a, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVP", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4a, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVP", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4a, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVP", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4a, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CMOVPE", Instruction{Summary: "Move if parity even (PF == 1)", Forms: []IForm{
+		{name: "CMOVPE", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4a, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVPE", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4a, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVPE", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4a, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVPE", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4a, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVPE", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4a, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVPE", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4a, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CMOVPO", Instruction{Summary: "Move if parity odd (PF == 0)", Forms: []IForm{
+		{name: "CMOVPO", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4b, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVPO", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4b, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVPO", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4b, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVPO", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4b, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVPO", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4b, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVPO", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x4b, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CMOVS", Instruction{Summary: "Move if sign (SF == 1)", Forms: []IForm{
+		{name: "CMOVS", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x48, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVS", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x48, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVS", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x48, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVS", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x48, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVS", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x48, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVS", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x48, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CMOVZ", Instruction{Summary: "Move if zero (ZF == 1)", Forms: []IForm{
+		{name: "CMOVZ", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x44, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVZ", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x44, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVZ", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x44, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVZ", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x44, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVZ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x44, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMOVZ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x44, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CMP", Instruction{Summary: "Compare Two Operands", Forms: []IForm{
+		{name: "CMPB", opcount: 1, ops: []Op{{TN: "al", Implicit: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&opcode{op: 0x3c, hasAddend: false, addend: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "CMPB", opcount: 2, ops: []Op{{TN: "r8"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x80, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x87, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "CMPB", opcount: 2, ops: []Op{{TN: "r8"}, {TN: "r8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMPB", opcount: 2, ops: []Op{{TN: "r8"}, {TN: "m8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMPW", opcount: 1, ops: []Op{{TN: "ax", Implicit: true}, {TN: "imm16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &opcode{op: 0x3d, hasAddend: false, addend: 0}, &immediate{size: 2, value: 1}},
+		}},
+		{name: "CMPW", opcount: 2, ops: []Op{{TN: "r16"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x87, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "CMPW", opcount: 2, ops: []Op{{TN: "r16"}, {TN: "imm16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x87, rm: 0}, &immediate{size: 2, value: 1}},
+		}},
+		{name: "CMPW", opcount: 2, ops: []Op{{TN: "r16"}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x39, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x3b, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMPW", opcount: 2, ops: []Op{{TN: "r16"}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x3b, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMPL", opcount: 1, ops: []Op{{TN: "eax", Implicit: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&opcode{op: 0x3d, hasAddend: false, addend: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "CMPL", opcount: 2, ops: []Op{{TN: "r32"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x87, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "CMPL", opcount: 2, ops: []Op{{TN: "r32"}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x87, rm: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "CMPL", opcount: 2, ops: []Op{{TN: "r32"}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x39, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x3b, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMPL", opcount: 2, ops: []Op{{TN: "r32"}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x3b, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMPQ", opcount: 1, ops: []Op{{TN: "rax", Implicit: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x3d, hasAddend: false, addend: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "CMPQ", opcount: 2, ops: []Op{{TN: "r64"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x87, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "CMPQ", opcount: 2, ops: []Op{{TN: "r64"}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x87, rm: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "CMPQ", opcount: 2, ops: []Op{{TN: "r64"}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x39, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x3b, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMPQ", opcount: 2, ops: []Op{{TN: "r64"}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x3b, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CMPB", opcount: 2, ops: []Op{{TN: "m8"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x80, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x87, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "CMPB", opcount: 2, ops: []Op{{TN: "m8"}, {TN: "r8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "CMPW", opcount: 2, ops: []Op{{TN: "m16"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x87, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "CMPW", opcount: 2, ops: []Op{{TN: "m16"}, {TN: "imm16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x87, rm: 0}, &immediate{size: 2, value: 1}},
+		}},
+		{name: "CMPW", opcount: 2, ops: []Op{{TN: "m16"}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x39, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "CMPL", opcount: 2, ops: []Op{{TN: "m32"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x87, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "CMPL", opcount: 2, ops: []Op{{TN: "m32"}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x87, rm: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "CMPL", opcount: 2, ops: []Op{{TN: "m32"}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x39, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "CMPQ", opcount: 2, ops: []Op{{TN: "m64"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x87, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "CMPQ", opcount: 2, ops: []Op{{TN: "m64"}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x87, rm: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "CMPQ", opcount: 2, ops: []Op{{TN: "m64"}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x39, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+	}}},
+	{"CMPPD", Instruction{Summary: "Compare Packed Double-Precision Floating-Point Values", Forms: []IForm{
+		{name: "CMPPD", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xc2, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "CMPPD", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xc2, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+	}}},
+	{"CMPPS", Instruction{Summary: "Compare Packed Single-Precision Floating-Point Values", Forms: []IForm{
+		{name: "CMPPS", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xc2, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "CMPPS", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xc2, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+	}}},
+	{"CMPSD", Instruction{Summary: "Compare Scalar Double-Precision Floating-Point Values", Forms: []IForm{
+		{name: "CMPSD", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xc2, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "CMPSD", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "m64"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xc2, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+	}}},
+	{"CMPSS", Instruction{Summary: "Compare Scalar Single-Precision Floating-Point Values", Forms: []IForm{
+		{name: "CMPSS", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xc2, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "CMPSS", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "m32"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xc2, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+	}}},
+	{"CMPXCHG", Instruction{Summary: "Compare and Exchange", Forms: []IForm{
+		{name: "CMPXCHGB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "r8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xb0, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "CMPXCHGW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xb1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "CMPXCHGL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xb1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "CMPXCHGQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xb1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "CMPXCHGB", opcount: 2, ops: []Op{{TN: "m8", Output: true}, {TN: "r8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xb0, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "CMPXCHGW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xb1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "CMPXCHGL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xb1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "CMPXCHGQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xb1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+	}}},
+	{"CMPXCHG16B", Instruction{Summary: "Compare and Exchange 16 Bytes", Forms: []IForm{
+		{name: "CMPXCHG16B", opcount: 1, ops: []Op{{TN: "m128"}, {TN: "rax", Output: true, Implicit: true}, {TN: "rbx", Implicit: true}, {TN: "rcx", Implicit: true}, {TN: "rdx", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xc7, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x81, rm: 0}},
+		}},
+	}}},
+	{"CMPXCHG8B", Instruction{Summary: "Compare and Exchange 8 Bytes", Forms: []IForm{
+		{name: "CMPXCHG8B", opcount: 1, ops: []Op{{TN: "m64"}, {TN: "eax", Output: true, Implicit: true}, {TN: "ebx", Implicit: true}, {TN: "ecx", Implicit: true}, {TN: "edx", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xc7, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x81, rm: 0}},
+		}},
+	}}},
+	{"COMISD", Instruction{Summary: "Compare Scalar Ordered Double-Precision Floating-Point Values and Set EFLAGS", Forms: []IForm{
+		{name: "COMISD", opcount: 2, ops: []Op{{TN: "xmm"}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "COMISD", opcount: 2, ops: []Op{{TN: "xmm"}, {TN: "m64"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2f, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"COMISS", Instruction{Summary: "Compare Scalar Ordered Single-Precision Floating-Point Values and Set EFLAGS", Forms: []IForm{
+		{name: "COMISS", opcount: 2, ops: []Op{{TN: "xmm"}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "COMISS", opcount: 2, ops: []Op{{TN: "xmm"}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2f, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CPUID", Instruction{Summary: "CPU Identification", Forms: []IForm{
+		{name: "CPUID", opcount: 0, ops: []Op{{TN: "eax", Output: true, Implicit: true}, {TN: "ebx", Output: true, Implicit: true}, {TN: "ecx", Output: true, Implicit: true}, {TN: "edx", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xa2, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"CQO", Instruction{Summary: "Convert Quadword to Octaword", Forms: []IForm{
+		{name: "CQTO", opcount: 0, ops: []Op{{TN: "rax", Implicit: true}, {TN: "rdx", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x99, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"CRC32", Instruction{Summary: "Accumulate CRC32 Value", Forms: []IForm{
+		{name: "CRC32B", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r8"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xf0, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CRC32W", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xf1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CRC32L", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xf1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CRC32B", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m8"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xf0, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CRC32W", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xf1, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CRC32L", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xf1, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CRC32B", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r8"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xf0, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CRC32Q", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xf1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CRC32B", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m8"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xf0, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CRC32Q", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xf1, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CVTDQ2PD", Instruction{Summary: "Convert Packed Dword Integers to Packed Double-Precision FP Values", Forms: []IForm{
+		{name: "CVTDQ2PD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xe6, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CVTDQ2PD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xe6, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CVTDQ2PS", Instruction{Summary: "Convert Packed Dword Integers to Packed Single-Precision FP Values", Forms: []IForm{
+		{name: "CVTDQ2PS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5b, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CVTDQ2PS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5b, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CVTPD2DQ", Instruction{Summary: "Convert Packed Double-Precision FP Values to Packed Dword Integers", Forms: []IForm{
+		{name: "CVTPD2DQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xe6, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CVTPD2DQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xe6, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CVTPD2PI", Instruction{Summary: "Convert Packed Double-Precision FP Values to Packed Dword Integers", Forms: []IForm{
+		{name: "CVTPD2PI", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2d, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CVTPD2PI", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2d, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CVTPD2PS", Instruction{Summary: "Convert Packed Double-Precision FP Values to Packed Single-Precision FP Values", Forms: []IForm{
+		{name: "CVTPD2PS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5a, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CVTPD2PS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5a, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CVTPI2PD", Instruction{Summary: "Convert Packed Dword Integers to Packed Double-Precision FP Values", Forms: []IForm{
+		{name: "CVTPI2PD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2a, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CVTPI2PD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2a, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CVTPI2PS", Instruction{Summary: "Convert Packed Dword Integers to Packed Single-Precision FP Values", Forms: []IForm{
+		{name: "CVTPI2PS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2a, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CVTPI2PS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2a, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CVTPS2DQ", Instruction{Summary: "Convert Packed Single-Precision FP Values to Packed Dword Integers", Forms: []IForm{
+		{name: "CVTPS2DQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5b, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CVTPS2DQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5b, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CVTPS2PD", Instruction{Summary: "Convert Packed Single-Precision FP Values to Packed Double-Precision FP Values", Forms: []IForm{
+		{name: "CVTPS2PD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5a, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CVTPS2PD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5a, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CVTPS2PI", Instruction{Summary: "Convert Packed Single-Precision FP Values to Packed Dword Integers", Forms: []IForm{
+		{name: "CVTPS2PI", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2d, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CVTPS2PI", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2d, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CVTSD2SI", Instruction{Summary: "Convert Scalar Double-Precision FP Value to Integer", Forms: []IForm{
+		{name: "CVTSD2SI", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2d, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CVTSD2SI", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2d, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CVTSD2SI", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2d, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CVTSD2SI", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2d, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CVTSD2SS", Instruction{Summary: "Convert Scalar Double-Precision FP Value to Scalar Single-Precision FP Value", Forms: []IForm{
+		{name: "CVTSD2SS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5a, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CVTSD2SS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5a, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CVTSI2SD", Instruction{Summary: "Convert Dword Integer to Scalar Double-Precision FP Value", Forms: []IForm{
+		{name: "CVTSI2SD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2a, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CVTSI2SD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2a, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CVTSI2SD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2a, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CVTSI2SD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2a, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CVTSI2SS", Instruction{Summary: "Convert Dword Integer to Scalar Single-Precision FP Value", Forms: []IForm{
+		{name: "CVTSI2SS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2a, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CVTSI2SS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2a, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CVTSI2SS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2a, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CVTSI2SS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2a, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CVTSS2SD", Instruction{Summary: "Convert Scalar Single-Precision FP Value to Scalar Double-Precision FP Value", Forms: []IForm{
+		{name: "CVTSS2SD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5a, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CVTSS2SD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5a, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CVTSS2SI", Instruction{Summary: "Convert Scalar Single-Precision FP Value to Dword Integer", Forms: []IForm{
+		{name: "CVTSS2SI", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2d, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CVTSS2SI", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2d, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CVTSS2SI", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2d, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CVTSS2SI", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2d, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CVTTPD2DQ", Instruction{Summary: "Convert with Truncation Packed Double-Precision FP Values to Packed Dword Integers", Forms: []IForm{
+		{name: "CVTTPD2DQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xe6, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CVTTPD2DQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xe6, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CVTTPD2PI", Instruction{Summary: "Convert with Truncation Packed Double-Precision FP Values to Packed Dword Integers", Forms: []IForm{
+		{name: "CVTTPD2PI", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2c, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CVTTPD2PI", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2c, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CVTTPS2DQ", Instruction{Summary: "Convert with Truncation Packed Single-Precision FP Values to Packed Dword Integers", Forms: []IForm{
+		{name: "CVTTPS2DQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5b, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CVTTPS2DQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5b, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CVTTPS2PI", Instruction{Summary: "Convert with Truncation Packed Single-Precision FP Values to Packed Dword Integers", Forms: []IForm{
+		{name: "CVTTPS2PI", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2c, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CVTTPS2PI", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2c, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CVTTSD2SI", Instruction{Summary: "Convert with Truncation Scalar Double-Precision FP Value to Signed Integer", Forms: []IForm{
+		{name: "CVTTSD2SI", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2c, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CVTTSD2SI", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2c, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CVTTSD2SI", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2c, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CVTTSD2SI", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2c, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CVTTSS2SI", Instruction{Summary: "Convert with Truncation Scalar Single-Precision FP Value to Dword Integer", Forms: []IForm{
+		{name: "CVTTSS2SI", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2c, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CVTTSS2SI", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2c, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "CVTTSS2SI", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2c, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "CVTTSS2SI", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2c, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"CWD", Instruction{Summary: "Convert Word to Doubleword", Forms: []IForm{
+		{name: "CWTD", opcount: 0, ops: []Op{{TN: "ax", Implicit: true}, {TN: "dx", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &opcode{op: 0x99, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"CWDE", Instruction{Summary: "Convert Word to Doubleword", Forms: []IForm{
+		{name: "CWTL", opcount: 0, ops: []Op{{TN: "ax", Implicit: true}, {TN: "eax", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&opcode{op: 0x98, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"DEC", Instruction{Summary: "Decrement by 1", Forms: []IForm{
+		{name: "DECB", opcount: 1, ops: []Op{{TN: "r8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xfe, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x81, rm: 0}},
+		}},
+		{name: "DECW", opcount: 1, ops: []Op{{TN: "r16", Output: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xff, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x81, rm: 0}},
+		}},
+		{name: "DECL", opcount: 1, ops: []Op{{TN: "r32", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xff, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x81, rm: 0}},
+		}},
+		{name: "DECQ", opcount: 1, ops: []Op{{TN: "r64", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xff, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x81, rm: 0}},
+		}},
+		{name: "DECB", opcount: 1, ops: []Op{{TN: "m8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xfe, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x81, rm: 0}},
+		}},
+		{name: "DECW", opcount: 1, ops: []Op{{TN: "m16", Output: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xff, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x81, rm: 0}},
+		}},
+		{name: "DECL", opcount: 1, ops: []Op{{TN: "m32", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xff, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x81, rm: 0}},
+		}},
+		{name: "DECQ", opcount: 1, ops: []Op{{TN: "m64", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xff, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x81, rm: 0}},
+		}},
+	}}},
+	{"DIV", Instruction{Summary: "Unsigned Divide", Forms: []IForm{
+		{name: "DIVB", opcount: 1, ops: []Op{{TN: "r8"}, {TN: "ax", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xf6, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x86, rm: 0}},
+		}},
+		{name: "DIVW", opcount: 1, ops: []Op{{TN: "r16"}, {TN: "ax", Output: true, Implicit: true}, {TN: "dx", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x86, rm: 0}},
+		}},
+		{name: "DIVL", opcount: 1, ops: []Op{{TN: "r32"}, {TN: "eax", Output: true, Implicit: true}, {TN: "edx", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x86, rm: 0}},
+		}},
+		{name: "DIVQ", opcount: 1, ops: []Op{{TN: "r64"}, {TN: "rax", Output: true, Implicit: true}, {TN: "rdx", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x86, rm: 0}},
+		}},
+		{name: "DIVB", opcount: 1, ops: []Op{{TN: "m8"}, {TN: "ax", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xf6, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x86, rm: 0}},
+		}},
+		{name: "DIVW", opcount: 1, ops: []Op{{TN: "m16"}, {TN: "ax", Output: true, Implicit: true}, {TN: "dx", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x86, rm: 0}},
+		}},
+		{name: "DIVL", opcount: 1, ops: []Op{{TN: "m32"}, {TN: "eax", Output: true, Implicit: true}, {TN: "edx", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x86, rm: 0}},
+		}},
+		{name: "DIVQ", opcount: 1, ops: []Op{{TN: "m64"}, {TN: "rax", Output: true, Implicit: true}, {TN: "rdx", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x86, rm: 0}},
+		}},
+	}}},
+	{"DIVPD", Instruction{Summary: "Divide Packed Double-Precision Floating-Point Values", Forms: []IForm{
+		{name: "DIVPD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5e, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "DIVPD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5e, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"DIVPS", Instruction{Summary: "Divide Packed Single-Precision Floating-Point Values", Forms: []IForm{
+		{name: "DIVPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5e, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "DIVPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5e, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"DIVSD", Instruction{Summary: "Divide Scalar Double-Precision Floating-Point Values", Forms: []IForm{
+		{name: "DIVSD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5e, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "DIVSD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5e, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"DIVSS", Instruction{Summary: "Divide Scalar Single-Precision Floating-Point Values", Forms: []IForm{
+		{name: "DIVSS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5e, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "DIVSS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5e, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"DPPD", Instruction{Summary: "Dot Product of Packed Double Precision Floating-Point Values", Forms: []IForm{
+		{name: "DPPD", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x41, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "DPPD", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x41, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+	}}},
+	{"DPPS", Instruction{Summary: "Dot Product of Packed Single Precision Floating-Point Values", Forms: []IForm{
+		{name: "DPPS", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x40, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "DPPS", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x40, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+	}}},
+	{"EMMS", Instruction{Summary: "Exit MMX State", Forms: []IForm{
+		{name: "EMMS", opcount: 0, ops: []Op{}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x77, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"EXTRACTPS", Instruction{Summary: "Extract Packed Single Precision Floating-Point Value", Forms: []IForm{
+		{name: "EXTRACTPS", opcount: 3, ops: []Op{{TN: "r32", Output: true}, {TN: "xmm"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x17, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "EXTRACTPS", opcount: 3, ops: []Op{{TN: "m32", Output: true}, {TN: "xmm"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x17, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}, &immediate{size: 1, value: 2}},
+		}},
+	}}},
+	{"EXTRQ", Instruction{Summary: "Extract Field", Forms: []IForm{
+		{name: "EXTRQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x79, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "EXTRQ", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "imm8"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x78, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}, &immediate{size: 1, value: 1}, &immediate{size: 1, value: 2}},
+		}},
+	}}},
+	{"FEMMS", Instruction{Summary: "Fast Exit Multimedia State", Forms: []IForm{
+		{name: "FEMMS", opcount: 0, ops: []Op{}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0e, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"HADDPD", Instruction{Summary: "Packed Double-FP Horizontal Add", Forms: []IForm{
+		{name: "HADDPD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x7c, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "HADDPD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x7c, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"HADDPS", Instruction{Summary: "Packed Single-FP Horizontal Add", Forms: []IForm{
+		{name: "HADDPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x7c, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "HADDPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x7c, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"HSUBPD", Instruction{Summary: "Packed Double-FP Horizontal Subtract", Forms: []IForm{
+		{name: "HSUBPD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x7d, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "HSUBPD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x7d, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"HSUBPS", Instruction{Summary: "Packed Single-FP Horizontal Subtract", Forms: []IForm{
+		{name: "HSUBPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x7d, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "HSUBPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x7d, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"IDIV", Instruction{Summary: "Signed Divide", Forms: []IForm{
+		{name: "IDIVB", opcount: 1, ops: []Op{{TN: "r8"}, {TN: "ax", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xf6, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x87, rm: 0}},
+		}},
+		{name: "IDIVW", opcount: 1, ops: []Op{{TN: "r16"}, {TN: "ax", Output: true, Implicit: true}, {TN: "dx", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x87, rm: 0}},
+		}},
+		{name: "IDIVL", opcount: 1, ops: []Op{{TN: "r32"}, {TN: "eax", Output: true, Implicit: true}, {TN: "edx", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x87, rm: 0}},
+		}},
+		{name: "IDIVQ", opcount: 1, ops: []Op{{TN: "r64"}, {TN: "rax", Output: true, Implicit: true}, {TN: "rdx", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x87, rm: 0}},
+		}},
+		{name: "IDIVB", opcount: 1, ops: []Op{{TN: "m8"}, {TN: "ax", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xf6, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x87, rm: 0}},
+		}},
+		{name: "IDIVW", opcount: 1, ops: []Op{{TN: "m16"}, {TN: "ax", Output: true, Implicit: true}, {TN: "dx", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x87, rm: 0}},
+		}},
+		{name: "IDIVL", opcount: 1, ops: []Op{{TN: "m32"}, {TN: "eax", Output: true, Implicit: true}, {TN: "edx", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x87, rm: 0}},
+		}},
+		{name: "IDIVQ", opcount: 1, ops: []Op{{TN: "m64"}, {TN: "rax", Output: true, Implicit: true}, {TN: "rdx", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x87, rm: 0}},
+		}},
+	}}},
+	{"IMUL", Instruction{Summary: "Signed Multiply", Forms: []IForm{
+		{name: "IMULB", opcount: 1, ops: []Op{{TN: "r8"}, {TN: "ax", Output: true, Implicit: true}, {TN: "al", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xf6, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x85, rm: 0}},
+		}},
+		{name: "IMULW", opcount: 1, ops: []Op{{TN: "r16"}, {TN: "ax", Output: true, Implicit: true}, {TN: "dx", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x85, rm: 0}},
+		}},
+		{name: "IMULL", opcount: 1, ops: []Op{{TN: "r32"}, {TN: "eax", Output: true, Implicit: true}, {TN: "edx", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x85, rm: 0}},
+		}},
+		{name: "IMULQ", opcount: 1, ops: []Op{{TN: "r64"}, {TN: "rax", Output: true, Implicit: true}, {TN: "rdx", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x85, rm: 0}},
+		}},
+		{name: "IMULB", opcount: 1, ops: []Op{{TN: "m8"}, {TN: "ax", Output: true, Implicit: true}, {TN: "al", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xf6, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x85, rm: 0}},
+		}},
+		{name: "IMULW", opcount: 1, ops: []Op{{TN: "m16"}, {TN: "ax", Output: true, Implicit: true}, {TN: "dx", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x85, rm: 0}},
+		}},
+		{name: "IMULL", opcount: 1, ops: []Op{{TN: "m32"}, {TN: "eax", Output: true, Implicit: true}, {TN: "edx", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x85, rm: 0}},
+		}},
+		{name: "IMULQ", opcount: 1, ops: []Op{{TN: "m64"}, {TN: "rax", Output: true, Implicit: true}, {TN: "rdx", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x85, rm: 0}},
+		}},
+		{name: "IMULW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xaf, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "IMULW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xaf, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "IMULL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xaf, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "IMULL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xaf, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "IMULQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xaf, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "IMULQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xaf, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "IMULW", opcount: 3, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x6b, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "IMULW", opcount: 3, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}, {TN: "imm16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x69, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 2, value: 2}},
+		}},
+		{name: "IMULW", opcount: 3, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x6b, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "IMULW", opcount: 3, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}, {TN: "imm16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x69, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &immediate{size: 2, value: 2}},
+		}},
+		{name: "IMULL", opcount: 3, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x6b, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "IMULL", opcount: 3, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x69, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 4, value: 2}},
+		}},
+		{name: "IMULL", opcount: 3, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x6b, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "IMULL", opcount: 3, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x69, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &immediate{size: 4, value: 2}},
+		}},
+		{name: "IMULQ", opcount: 3, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x6b, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "IMULQ", opcount: 3, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x69, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 4, value: 2}},
+		}},
+		{name: "IMULQ", opcount: 3, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x6b, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "IMULQ", opcount: 3, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x69, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &immediate{size: 4, value: 2}},
+		}},
+	}}},
+	{"INC", Instruction{Summary: "Increment by 1", Forms: []IForm{
+		{name: "INCB", opcount: 1, ops: []Op{{TN: "r8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xfe, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "INCW", opcount: 1, ops: []Op{{TN: "r16", Output: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xff, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "INCL", opcount: 1, ops: []Op{{TN: "r32", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xff, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "INCQ", opcount: 1, ops: []Op{{TN: "r64", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xff, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "INCB", opcount: 1, ops: []Op{{TN: "m8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xfe, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+		{name: "INCW", opcount: 1, ops: []Op{{TN: "m16", Output: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xff, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+		{name: "INCL", opcount: 1, ops: []Op{{TN: "m32", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xff, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+		{name: "INCQ", opcount: 1, ops: []Op{{TN: "m64", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xff, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+	}}},
+	{"INSERTPS", Instruction{Summary: "Insert Packed Single Precision Floating-Point Value", Forms: []IForm{
+		{name: "INSERTPS", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x21, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "INSERTPS", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "m32"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x21, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+	}}},
+	{"INSERTQ", Instruction{Summary: "Insert Field", Forms: []IForm{
+		{name: "INSERTQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x79, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "INSERTQ", opcount: 4, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}, {TN: "imm8"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x78, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}, &immediate{size: 1, value: 3}},
+		}},
+	}}},
+	{"INT", Instruction{Summary: "Call to Interrupt Procedure", Forms: []IForm{
+		{name: "INT", opcount: 1, ops: []Op{{TN: "3"}}, enc: [][]Encoder{
+			{&opcode{op: 0xcc, hasAddend: false, addend: 0}},
+		}},
+		{name: "INT", opcount: 1, ops: []Op{{TN: "imm8"}}, enc: [][]Encoder{
+			{&opcode{op: 0xcd, hasAddend: false, addend: 0}, &immediate{size: 1, value: 0}},
+		}},
+	}}},
+	{"JA", Instruction{Summary: "Jump if above (CF == 0 and ZF == 0)", Forms: []IForm{
+		{name: "JA", opcount: 1, ops: []Op{{TN: "rel8"}}, enc: [][]Encoder{
+			{&opcode{op: 0x77, hasAddend: false, addend: 0}, &codeOffset{size: 1, value: 0}},
+		}},
+		{name: "JA", opcount: 1, ops: []Op{{TN: "rel32"}}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x87, hasAddend: false, addend: 0}, &codeOffset{size: 4, value: 0}},
+		}},
+	}}},
+	{"JAE", Instruction{Summary: "Jump if above or equal (CF == 0)", Forms: []IForm{
+		{name: "JAE", opcount: 1, ops: []Op{{TN: "rel8"}}, enc: [][]Encoder{
+			{&opcode{op: 0x73, hasAddend: false, addend: 0}, &codeOffset{size: 1, value: 0}},
+		}},
+		{name: "JAE", opcount: 1, ops: []Op{{TN: "rel32"}}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &codeOffset{size: 4, value: 0}},
+		}},
+	}}},
+	{"JB", Instruction{Summary: "Jump if below (CF == 1)", Forms: []IForm{
+		{name: "JB", opcount: 1, ops: []Op{{TN: "rel8"}}, enc: [][]Encoder{
+			{&opcode{op: 0x72, hasAddend: false, addend: 0}, &codeOffset{size: 1, value: 0}},
+		}},
+		{name: "JB", opcount: 1, ops: []Op{{TN: "rel32"}}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x82, hasAddend: false, addend: 0}, &codeOffset{size: 4, value: 0}},
+		}},
+	}}},
+	{"JBE", Instruction{Summary: "Jump if below or equal (CF == 1 or ZF == 1)", Forms: []IForm{
+		{name: "JBE", opcount: 1, ops: []Op{{TN: "rel8"}}, enc: [][]Encoder{
+			{&opcode{op: 0x76, hasAddend: false, addend: 0}, &codeOffset{size: 1, value: 0}},
+		}},
+		{name: "JBE", opcount: 1, ops: []Op{{TN: "rel32"}}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x86, hasAddend: false, addend: 0}, &codeOffset{size: 4, value: 0}},
+		}},
+	}}},
+	{"JC", Instruction{Summary: "Jump if carry (CF == 1)", Forms: []IForm{
+		{name: "JC", opcount: 1, ops: []Op{{TN: "rel8"}}, enc: [][]Encoder{
+			{&opcode{op: 0x72, hasAddend: false, addend: 0}, &codeOffset{size: 1, value: 0}},
+		}},
+		{name: "JC", opcount: 1, ops: []Op{{TN: "rel32"}}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x82, hasAddend: false, addend: 0}, &codeOffset{size: 4, value: 0}},
+		}},
+	}}},
+	{"JE", Instruction{Summary: "Jump if equal (ZF == 1)", Forms: []IForm{
+		{name: "JE", opcount: 1, ops: []Op{{TN: "rel8"}}, enc: [][]Encoder{
+			{&opcode{op: 0x74, hasAddend: false, addend: 0}, &codeOffset{size: 1, value: 0}},
+		}},
+		{name: "JE", opcount: 1, ops: []Op{{TN: "rel32"}}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x84, hasAddend: false, addend: 0}, &codeOffset{size: 4, value: 0}},
+		}},
+	}}},
+	{"JECXZ", Instruction{Summary: "Jump if ECX register is 0", Forms: []IForm{
+		{name: "JECXZ", opcount: 1, ops: []Op{{TN: "rel8"}, {TN: "ecx", Implicit: true}}, enc: [][]Encoder{
+			{&opcode{op: 0xe3, hasAddend: false, addend: 0}, &codeOffset{size: 1, value: 0}},
+		}},
+	}}},
+	{"JG", Instruction{Summary: "Jump if greater (ZF == 0 and SF == OF)", Forms: []IForm{
+		{name: "JG", opcount: 1, ops: []Op{{TN: "rel8"}}, enc: [][]Encoder{
+			{&opcode{op: 0x7f, hasAddend: false, addend: 0}, &codeOffset{size: 1, value: 0}},
+		}},
+		{name: "JG", opcount: 1, ops: []Op{{TN: "rel32"}}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x8f, hasAddend: false, addend: 0}, &codeOffset{size: 4, value: 0}},
+		}},
+	}}},
+	{"JGE", Instruction{Summary: "Jump if greater or equal (SF == OF)", Forms: []IForm{
+		{name: "JGE", opcount: 1, ops: []Op{{TN: "rel8"}}, enc: [][]Encoder{
+			{&opcode{op: 0x7d, hasAddend: false, addend: 0}, &codeOffset{size: 1, value: 0}},
+		}},
+		{name: "JGE", opcount: 1, ops: []Op{{TN: "rel32"}}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x8d, hasAddend: false, addend: 0}, &codeOffset{size: 4, value: 0}},
+		}},
+	}}},
+	{"JL", Instruction{Summary: "Jump if less (SF != OF)", Forms: []IForm{
+		{name: "JL", opcount: 1, ops: []Op{{TN: "rel8"}}, enc: [][]Encoder{
+			{&opcode{op: 0x7c, hasAddend: false, addend: 0}, &codeOffset{size: 1, value: 0}},
+		}},
+		{name: "JL", opcount: 1, ops: []Op{{TN: "rel32"}}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x8c, hasAddend: false, addend: 0}, &codeOffset{size: 4, value: 0}},
+		}},
+	}}},
+	{"JLE", Instruction{Summary: "Jump if less or equal (ZF == 1 or SF != OF)", Forms: []IForm{
+		{name: "JLE", opcount: 1, ops: []Op{{TN: "rel8"}}, enc: [][]Encoder{
+			{&opcode{op: 0x7e, hasAddend: false, addend: 0}, &codeOffset{size: 1, value: 0}},
+		}},
+		{name: "JLE", opcount: 1, ops: []Op{{TN: "rel32"}}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x8e, hasAddend: false, addend: 0}, &codeOffset{size: 4, value: 0}},
+		}},
+	}}},
+	{"JMP", Instruction{Summary: "Jump Unconditionally", Forms: []IForm{
+		{name: "JMP", opcount: 1, ops: []Op{{TN: "rel8"}}, enc: [][]Encoder{
+			{&opcode{op: 0xeb, hasAddend: false, addend: 0}, &codeOffset{size: 1, value: 0}},
+		}},
+		{name: "JMP", opcount: 1, ops: []Op{{TN: "rel32"}}, enc: [][]Encoder{
+			{&opcode{op: 0xe9, hasAddend: false, addend: 0}, &codeOffset{size: 4, value: 0}},
+		}},
+		{name: "JMPQ", opcount: 1, ops: []Op{{TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xff, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}},
+		}},
+		{name: "JMPQ", opcount: 1, ops: []Op{{TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xff, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x84, rm: 0}},
+		}},
+	}}},
+	{"JNA", Instruction{Summary: "Jump if not above (CF == 1 or ZF == 1)", Forms: []IForm{
+		{name: "JNA", opcount: 1, ops: []Op{{TN: "rel8"}}, enc: [][]Encoder{
+			{&opcode{op: 0x76, hasAddend: false, addend: 0}, &codeOffset{size: 1, value: 0}},
+		}},
+		{name: "JNA", opcount: 1, ops: []Op{{TN: "rel32"}}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x86, hasAddend: false, addend: 0}, &codeOffset{size: 4, value: 0}},
+		}},
+	}}},
+	{"JNAE", Instruction{Summary: "Jump if not above or equal (CF == 1)", Forms: []IForm{
+		{name: "JNAE", opcount: 1, ops: []Op{{TN: "rel8"}}, enc: [][]Encoder{
+			{&opcode{op: 0x72, hasAddend: false, addend: 0}, &codeOffset{size: 1, value: 0}},
+		}},
+		{name: "JNAE", opcount: 1, ops: []Op{{TN: "rel32"}}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x82, hasAddend: false, addend: 0}, &codeOffset{size: 4, value: 0}},
+		}},
+	}}},
+	{"JNB", Instruction{Summary: "Jump if not below (CF == 0)", Forms: []IForm{
+		{name: "JNB", opcount: 1, ops: []Op{{TN: "rel8"}}, enc: [][]Encoder{
+			{&opcode{op: 0x73, hasAddend: false, addend: 0}, &codeOffset{size: 1, value: 0}},
+		}},
+		{name: "JNB", opcount: 1, ops: []Op{{TN: "rel32"}}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &codeOffset{size: 4, value: 0}},
+		}},
+	}}},
+	{"JNBE", Instruction{Summary: "Jump if not below or equal (CF == 0 and ZF == 0)", Forms: []IForm{
+		{name: "JNBE", opcount: 1, ops: []Op{{TN: "rel8"}}, enc: [][]Encoder{
+			{&opcode{op: 0x77, hasAddend: false, addend: 0}, &codeOffset{size: 1, value: 0}},
+		}},
+		{name: "JNBE", opcount: 1, ops: []Op{{TN: "rel32"}}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x87, hasAddend: false, addend: 0}, &codeOffset{size: 4, value: 0}},
+		}},
+	}}},
+	{"JNC", Instruction{Summary: "Jump if not carry (CF == 0)", Forms: []IForm{
+		{name: "JNC", opcount: 1, ops: []Op{{TN: "rel8"}}, enc: [][]Encoder{
+			{&opcode{op: 0x73, hasAddend: false, addend: 0}, &codeOffset{size: 1, value: 0}},
+		}},
+		{name: "JNC", opcount: 1, ops: []Op{{TN: "rel32"}}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &codeOffset{size: 4, value: 0}},
+		}},
+	}}},
+	{"JNE", Instruction{Summary: "Jump if not equal (ZF == 0)", Forms: []IForm{
+		{name: "JNE", opcount: 1, ops: []Op{{TN: "rel8"}}, enc: [][]Encoder{
+			{&opcode{op: 0x75, hasAddend: false, addend: 0}, &codeOffset{size: 1, value: 0}},
+		}},
+		{name: "JNE", opcount: 1, ops: []Op{{TN: "rel32"}}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x85, hasAddend: false, addend: 0}, &codeOffset{size: 4, value: 0}},
+		}},
+	}}},
+	{"JNG", Instruction{Summary: "Jump if not greater (ZF == 1 or SF != OF)", Forms: []IForm{
+		{name: "JNG", opcount: 1, ops: []Op{{TN: "rel8"}}, enc: [][]Encoder{
+			{&opcode{op: 0x7e, hasAddend: false, addend: 0}, &codeOffset{size: 1, value: 0}},
+		}},
+		{name: "JNG", opcount: 1, ops: []Op{{TN: "rel32"}}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x8e, hasAddend: false, addend: 0}, &codeOffset{size: 4, value: 0}},
+		}},
+	}}},
+	{"JNGE", Instruction{Summary: "Jump if not greater or equal (SF != OF)", Forms: []IForm{
+		{name: "JNGE", opcount: 1, ops: []Op{{TN: "rel8"}}, enc: [][]Encoder{
+			{&opcode{op: 0x7c, hasAddend: false, addend: 0}, &codeOffset{size: 1, value: 0}},
+		}},
+		{name: "JNGE", opcount: 1, ops: []Op{{TN: "rel32"}}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x8c, hasAddend: false, addend: 0}, &codeOffset{size: 4, value: 0}},
+		}},
+	}}},
+	{"JNL", Instruction{Summary: "Jump if not less (SF == OF)", Forms: []IForm{
+		{name: "JNL", opcount: 1, ops: []Op{{TN: "rel8"}}, enc: [][]Encoder{
+			{&opcode{op: 0x7d, hasAddend: false, addend: 0}, &codeOffset{size: 1, value: 0}},
+		}},
+		{name: "JNL", opcount: 1, ops: []Op{{TN: "rel32"}}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x8d, hasAddend: false, addend: 0}, &codeOffset{size: 4, value: 0}},
+		}},
+	}}},
+	{"JNLE", Instruction{Summary: "Jump if not less or equal (ZF == 0 and SF == OF)", Forms: []IForm{
+		{name: "JNLE", opcount: 1, ops: []Op{{TN: "rel8"}}, enc: [][]Encoder{
+			{&opcode{op: 0x7f, hasAddend: false, addend: 0}, &codeOffset{size: 1, value: 0}},
+		}},
+		{name: "JNLE", opcount: 1, ops: []Op{{TN: "rel32"}}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x8f, hasAddend: false, addend: 0}, &codeOffset{size: 4, value: 0}},
+		}},
+	}}},
+	{"JNO", Instruction{Summary: "Jump if not overflow (OF == 0)", Forms: []IForm{
+		{name: "JNO", opcount: 1, ops: []Op{{TN: "rel8"}}, enc: [][]Encoder{
+			{&opcode{op: 0x71, hasAddend: false, addend: 0}, &codeOffset{size: 1, value: 0}},
+		}},
+		{name: "JNO", opcount: 1, ops: []Op{{TN: "rel32"}}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &codeOffset{size: 4, value: 0}},
+		}},
+	}}},
+	{"JNP", Instruction{Summary: "Jump if not parity (PF == 0)", Forms: []IForm{
+		{name: "JNP", opcount: 1, ops: []Op{{TN: "rel8"}}, enc: [][]Encoder{
+			{&opcode{op: 0x7b, hasAddend: false, addend: 0}, &codeOffset{size: 1, value: 0}},
+		}},
+		{name: "JNP", opcount: 1, ops: []Op{{TN: "rel32"}}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x8b, hasAddend: false, addend: 0}, &codeOffset{size: 4, value: 0}},
+		}},
+	}}},
+	{"JNS", Instruction{Summary: "Jump if not sign (SF == 0)", Forms: []IForm{
+		{name: "JNS", opcount: 1, ops: []Op{{TN: "rel8"}}, enc: [][]Encoder{
+			{&opcode{op: 0x79, hasAddend: false, addend: 0}, &codeOffset{size: 1, value: 0}},
+		}},
+		{name: "JNS", opcount: 1, ops: []Op{{TN: "rel32"}}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x89, hasAddend: false, addend: 0}, &codeOffset{size: 4, value: 0}},
+		}},
+	}}},
+	{"JNZ", Instruction{Summary: "Jump if not zero (ZF == 0)", Forms: []IForm{
+		{name: "JNZ", opcount: 1, ops: []Op{{TN: "rel8"}}, enc: [][]Encoder{
+			{&opcode{op: 0x75, hasAddend: false, addend: 0}, &codeOffset{size: 1, value: 0}},
+		}},
+		{name: "JNZ", opcount: 1, ops: []Op{{TN: "rel32"}}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x85, hasAddend: false, addend: 0}, &codeOffset{size: 4, value: 0}},
+		}},
+	}}},
+	{"JO", Instruction{Summary: "Jump if overflow (OF == 1)", Forms: []IForm{
+		{name: "JO", opcount: 1, ops: []Op{{TN: "rel8"}}, enc: [][]Encoder{
+			{&opcode{op: 0x70, hasAddend: false, addend: 0}, &codeOffset{size: 1, value: 0}},
+		}},
+		{name: "JO", opcount: 1, ops: []Op{{TN: "rel32"}}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x80, hasAddend: false, addend: 0}, &codeOffset{size: 4, value: 0}},
+		}},
+	}}},
+	{"JP", Instruction{Summary: "Jump if parity (PF == 1)", Forms: []IForm{
+		{name: "JP", opcount: 1, ops: []Op{{TN: "rel8"}}, enc: [][]Encoder{
+			{&opcode{op: 0x7a, hasAddend: false, addend: 0}, &codeOffset{size: 1, value: 0}},
+		}},
+		{name: "JP", opcount: 1, ops: []Op{{TN: "rel32"}}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x8a, hasAddend: false, addend: 0}, &codeOffset{size: 4, value: 0}},
+		}},
+	}}},
+	{"JPE", Instruction{Summary: "Jump if parity even (PF == 1)", Forms: []IForm{
+		{name: "JPE", opcount: 1, ops: []Op{{TN: "rel8"}}, enc: [][]Encoder{
+			{&opcode{op: 0x7a, hasAddend: false, addend: 0}, &codeOffset{size: 1, value: 0}},
+		}},
+		{name: "JPE", opcount: 1, ops: []Op{{TN: "rel32"}}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x8a, hasAddend: false, addend: 0}, &codeOffset{size: 4, value: 0}},
+		}},
+	}}},
+	{"JPO", Instruction{Summary: "Jump if parity odd (PF == 0)", Forms: []IForm{
+		{name: "JPO", opcount: 1, ops: []Op{{TN: "rel8"}}, enc: [][]Encoder{
+			{&opcode{op: 0x7b, hasAddend: false, addend: 0}, &codeOffset{size: 1, value: 0}},
+		}},
+		{name: "JPO", opcount: 1, ops: []Op{{TN: "rel32"}}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x8b, hasAddend: false, addend: 0}, &codeOffset{size: 4, value: 0}},
+		}},
+	}}},
+	{"JRCXZ", Instruction{Summary: "Jump if RCX register is 0", Forms: []IForm{
+		{name: "JRCXZ", opcount: 1, ops: []Op{{TN: "rel8"}, {TN: "rcx", Implicit: true}}, enc: [][]Encoder{
+			{&opcode{op: 0xe3, hasAddend: false, addend: 0}, &codeOffset{size: 1, value: 0}},
+		}},
+	}}},
+	{"JS", Instruction{Summary: "Jump if sign (SF == 1)", Forms: []IForm{
+		{name: "JS", opcount: 1, ops: []Op{{TN: "rel8"}}, enc: [][]Encoder{
+			{&opcode{op: 0x78, hasAddend: false, addend: 0}, &codeOffset{size: 1, value: 0}},
+		}},
+		{name: "JS", opcount: 1, ops: []Op{{TN: "rel32"}}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x88, hasAddend: false, addend: 0}, &codeOffset{size: 4, value: 0}},
+		}},
+	}}},
+	{"JZ", Instruction{Summary: "Jump if zero (ZF == 1)", Forms: []IForm{
+		{name: "JZ", opcount: 1, ops: []Op{{TN: "rel8"}}, enc: [][]Encoder{
+			{&opcode{op: 0x74, hasAddend: false, addend: 0}, &codeOffset{size: 1, value: 0}},
+		}},
+		{name: "JZ", opcount: 1, ops: []Op{{TN: "rel32"}}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x84, hasAddend: false, addend: 0}, &codeOffset{size: 4, value: 0}},
+		}},
+	}}},
+	{"KADDB", Instruction{Summary: "ADD Two 8-bit Masks", Forms: []IForm{}}},
+	{"KADDD", Instruction{Summary: "ADD Two 32-bit Masks", Forms: []IForm{}}},
+	{"KADDQ", Instruction{Summary: "ADD Two 64-bit Masks", Forms: []IForm{}}},
+	{"KADDW", Instruction{Summary: "ADD Two 16-bit Masks", Forms: []IForm{}}},
+	{"KANDB", Instruction{Summary: "Bitwise Logical AND 8-bit Masks", Forms: []IForm{}}},
+	{"KANDD", Instruction{Summary: "Bitwise Logical AND 32-bit Masks", Forms: []IForm{}}},
+	{"KANDNB", Instruction{Summary: "Bitwise Logical AND NOT 8-bit Masks", Forms: []IForm{}}},
+	{"KANDND", Instruction{Summary: "Bitwise Logical AND NOT 32-bit Masks", Forms: []IForm{}}},
+	{"KANDNQ", Instruction{Summary: "Bitwise Logical AND NOT 64-bit Masks", Forms: []IForm{}}},
+	{"KANDNW", Instruction{Summary: "Bitwise Logical AND NOT 16-bit Masks", Forms: []IForm{}}},
+	{"KANDQ", Instruction{Summary: "Bitwise Logical AND 64-bit Masks", Forms: []IForm{}}},
+	{"KANDW", Instruction{Summary: "Bitwise Logical AND 16-bit Masks", Forms: []IForm{}}},
+	{"KMOVB", Instruction{Summary: "Move 8-bit Mask", Forms: []IForm{}}},
+	{"KMOVD", Instruction{Summary: "Move 32-bit Mask", Forms: []IForm{}}},
+	{"KMOVQ", Instruction{Summary: "Move 64-bit Mask", Forms: []IForm{}}},
+	{"KMOVW", Instruction{Summary: "Move 16-bit Mask", Forms: []IForm{}}},
+	{"KNOTB", Instruction{Summary: "NOT 8-bit Mask Register", Forms: []IForm{}}},
+	{"KNOTD", Instruction{Summary: "NOT 32-bit Mask Register", Forms: []IForm{}}},
+	{"KNOTQ", Instruction{Summary: "NOT 64-bit Mask Register", Forms: []IForm{}}},
+	{"KNOTW", Instruction{Summary: "NOT 16-bit Mask Register", Forms: []IForm{}}},
+	{"KORB", Instruction{Summary: "Bitwise Logical OR 8-bit Masks", Forms: []IForm{}}},
+	{"KORD", Instruction{Summary: "Bitwise Logical OR 32-bit Masks", Forms: []IForm{}}},
+	{"KORQ", Instruction{Summary: "Bitwise Logical OR 64-bit Masks", Forms: []IForm{}}},
+	{"KORTESTB", Instruction{Summary: "OR 8-bit Masks and Set Flags", Forms: []IForm{}}},
+	{"KORTESTD", Instruction{Summary: "OR 32-bit Masks and Set Flags", Forms: []IForm{}}},
+	{"KORTESTQ", Instruction{Summary: "OR 64-bit Masks and Set Flags", Forms: []IForm{}}},
+	{"KORTESTW", Instruction{Summary: "OR 16-bit Masks and Set Flags", Forms: []IForm{}}},
+	{"KORW", Instruction{Summary: "Bitwise Logical OR 16-bit Masks", Forms: []IForm{}}},
+	{"KSHIFTLB", Instruction{Summary: "Shift Left 8-bit Masks", Forms: []IForm{}}},
+	{"KSHIFTLD", Instruction{Summary: "Shift Left 32-bit Masks", Forms: []IForm{}}},
+	{"KSHIFTLQ", Instruction{Summary: "Shift Left 64-bit Masks", Forms: []IForm{}}},
+	{"KSHIFTLW", Instruction{Summary: "Shift Left 16-bit Masks", Forms: []IForm{}}},
+	{"KSHIFTRB", Instruction{Summary: "Shift Right 8-bit Masks", Forms: []IForm{}}},
+	{"KSHIFTRD", Instruction{Summary: "Shift Right 32-bit Masks", Forms: []IForm{}}},
+	{"KSHIFTRQ", Instruction{Summary: "Shift Right 64-bit Masks", Forms: []IForm{}}},
+	{"KSHIFTRW", Instruction{Summary: "Shift Right 16-bit Masks", Forms: []IForm{}}},
+	{"KTESTB", Instruction{Summary: "Bit Test 8-bit Masks and Set Flags", Forms: []IForm{}}},
+	{"KTESTD", Instruction{Summary: "Bit Test 32-bit Masks and Set Flags", Forms: []IForm{}}},
+	{"KTESTQ", Instruction{Summary: "Bit Test 64-bit Masks and Set Flags", Forms: []IForm{}}},
+	{"KTESTW", Instruction{Summary: "Bit Test 16-bit Masks and Set Flags", Forms: []IForm{}}},
+	{"KUNPCKBW", Instruction{Summary: "Unpack and Interleave 8-bit Masks", Forms: []IForm{}}},
+	{"KUNPCKDQ", Instruction{Summary: "Unpack and Interleave 32-bit Masks", Forms: []IForm{}}},
+	{"KUNPCKWD", Instruction{Summary: "Unpack and Interleave 16-bit Masks", Forms: []IForm{}}},
+	{"KXNORB", Instruction{Summary: "Bitwise Logical XNOR 8-bit Masks", Forms: []IForm{}}},
+	{"KXNORD", Instruction{Summary: "Bitwise Logical XNOR 32-bit Masks", Forms: []IForm{}}},
+	{"KXNORQ", Instruction{Summary: "Bitwise Logical XNOR 64-bit Masks", Forms: []IForm{}}},
+	{"KXNORW", Instruction{Summary: "Bitwise Logical XNOR 16-bit Masks", Forms: []IForm{}}},
+	{"KXORB", Instruction{Summary: "Bitwise Logical XOR 8-bit Masks", Forms: []IForm{}}},
+	{"KXORD", Instruction{Summary: "Bitwise Logical XOR 32-bit Masks", Forms: []IForm{}}},
+	{"KXORQ", Instruction{Summary: "Bitwise Logical XOR 64-bit Masks", Forms: []IForm{}}},
+	{"KXORW", Instruction{Summary: "Bitwise Logical XOR 16-bit Masks", Forms: []IForm{}}},
+	{"LDDQU", Instruction{Summary: "Load Unaligned Integer 128 Bits", Forms: []IForm{
+		{name: "LDDQU", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xf0, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"LDMXCSR", Instruction{Summary: "Load MXCSR Register", Forms: []IForm{
+		{name: "LDMXCSR", opcount: 1, ops: []Op{{TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xae, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x82, rm: 0}},
+		}},
+	}}},
+	{"LEA", Instruction{Summary: "Load Effective Address", Forms: []IForm{
+		{name: "LEAW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x8d, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "LEAL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x8d, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "LEAQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x8d, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"LFENCE", Instruction{Summary: "Load Fence", Forms: []IForm{
+		{name: "LFENCE", opcount: 0, ops: []Op{}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xae, hasAddend: false, addend: 0}, &opcode{op: 0xe8, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"LZCNT", Instruction{Summary: "Count the Number of Leading Zero Bits", Forms: []IForm{
+		{name: "LZCNTW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xbd, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "LZCNTW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xbd, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "LZCNTL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xbd, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "LZCNTL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xbd, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "LZCNTQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xbd, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "LZCNTQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xbd, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"MASKMOVDQU", Instruction{Summary: "Store Selected Bytes of Double Quadword", Forms: []IForm{
+		{name: "MASKMOVDQU", opcount: 2, ops: []Op{{TN: "xmm"}, {TN: "xmm"}, {TN: "rdi", Implicit: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"MASKMOVQ", Instruction{Summary: "Store Selected Bytes of Quadword", Forms: []IForm{
+		{name: "MASKMOVQ", opcount: 2, ops: []Op{{TN: "mm"}, {TN: "mm"}, {TN: "rdi", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"MAXPD", Instruction{Summary: "Return Maximum Packed Double-Precision Floating-Point Values", Forms: []IForm{
+		{name: "MAXPD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "MAXPD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5f, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"MAXPS", Instruction{Summary: "Return Maximum Packed Single-Precision Floating-Point Values", Forms: []IForm{
+		{name: "MAXPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "MAXPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5f, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"MAXSD", Instruction{Summary: "Return Maximum Scalar Double-Precision Floating-Point Value", Forms: []IForm{
+		{name: "MAXSD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "MAXSD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5f, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"MAXSS", Instruction{Summary: "Return Maximum Scalar Single-Precision Floating-Point Value", Forms: []IForm{
+		{name: "MAXSS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "MAXSS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5f, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"MFENCE", Instruction{Summary: "Memory Fence", Forms: []IForm{
+		{name: "MFENCE", opcount: 0, ops: []Op{}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xae, hasAddend: false, addend: 0}, &opcode{op: 0xf0, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"MINPD", Instruction{Summary: "Return Minimum Packed Double-Precision Floating-Point Values", Forms: []IForm{
+		{name: "MINPD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5d, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "MINPD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5d, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"MINPS", Instruction{Summary: "Return Minimum Packed Single-Precision Floating-Point Values", Forms: []IForm{
+		{name: "MINPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5d, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "MINPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5d, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"MINSD", Instruction{Summary: "Return Minimum Scalar Double-Precision Floating-Point Value", Forms: []IForm{
+		{name: "MINSD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5d, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "MINSD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5d, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"MINSS", Instruction{Summary: "Return Minimum Scalar Single-Precision Floating-Point Value", Forms: []IForm{
+		{name: "MINSS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5d, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "MINSS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5d, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"MONITOR", Instruction{Summary: "Monitor a Linear Address Range", Forms: []IForm{
+		{name: "MONITOR", opcount: 0, ops: []Op{{TN: "rax", Implicit: true}, {TN: "ecx", Implicit: true}, {TN: "edx", Implicit: true}}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x01, hasAddend: false, addend: 0}, &opcode{op: 0xc8, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"MONITORX", Instruction{Summary: "Monitor a Linear Address Range with Timeout", Forms: []IForm{
+		{name: "MONITORX", opcount: 0, ops: []Op{{TN: "rax", Implicit: true}, {TN: "ecx", Implicit: true}, {TN: "edx", Implicit: true}}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x01, hasAddend: false, addend: 0}, &opcode{op: 0xfa, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"MOV", Instruction{Summary: "Move", Forms: []IForm{
+		{name: "MOVB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc6, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}, &immediate{size: 1, value: 1}},
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xb0, hasAddend: true, addend: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "MOVB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "r8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x88, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x8a, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "m8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x8a, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "imm16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc7, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}, &immediate{size: 2, value: 1}},
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xb8, hasAddend: true, addend: 0}, &immediate{size: 2, value: 1}},
+		}},
+		{name: "MOVW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x89, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x8b, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x8b, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVABS", opcount: 1, ops: []Op{{TN: "eax", Output: true, Implicit: true}, {TN: "moffs32"}}, enc: [][]Encoder{
+			{&opcode{op: 0xa1, hasAddend: false, addend: 0}, &dataOffset{size: 4, value: 1}},
+		}},
+		{name: "MOVL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc7, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}, &immediate{size: 4, value: 1}},
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xb8, hasAddend: true, addend: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "MOVL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x89, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x8b, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x8b, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVABS", opcount: 1, ops: []Op{{TN: "rax", Output: true, Implicit: true}, {TN: "moffs64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xa1, hasAddend: false, addend: 0}, &dataOffset{size: 8, value: 1}},
+		}},
+		{name: "MOVQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xc7, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "MOVQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "imm64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xb8, hasAddend: true, addend: 0}, &immediate{size: 8, value: 1}},
+		}},
+		{name: "MOVQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x89, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x8b, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x8b, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVB", opcount: 2, ops: []Op{{TN: "m8", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc6, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "MOVB", opcount: 2, ops: []Op{{TN: "m8", Output: true}, {TN: "r8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x88, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "MOVW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "imm16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc7, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}, &immediate{size: 2, value: 1}},
+		}},
+		{name: "MOVW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x89, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "MOVL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc7, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "MOVL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x89, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "MOVQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xc7, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "MOVQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x89, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "MOVABS", opcount: 1, ops: []Op{{TN: "moffs32"}, {TN: "eax", Implicit: true}}, enc: [][]Encoder{
+			{&opcode{op: 0xa3, hasAddend: false, addend: 0}, &dataOffset{size: 4, value: 0}},
+		}},
+		{name: "MOVABS", opcount: 1, ops: []Op{{TN: "moffs64"}, {TN: "rax", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xa3, hasAddend: false, addend: 0}, &dataOffset{size: 8, value: 0}},
+		}},
+	}}},
+	{"MOVAPD", Instruction{Summary: "Move Aligned Packed Double-Precision Floating-Point Values", Forms: []IForm{
+		{name: "MOVAPD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x28, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x29, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "MOVAPD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x28, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVAPD", opcount: 2, ops: []Op{{TN: "m128", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x29, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+	}}},
+	{"MOVAPS", Instruction{Summary: "Move Aligned Packed Single-Precision Floating-Point Values", Forms: []IForm{
+		{name: "MOVAPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x28, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x29, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "MOVAPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x28, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVAPS", opcount: 2, ops: []Op{{TN: "m128", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x29, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+	}}},
+	{"MOVBE", Instruction{Summary: "Move Data After Swapping Bytes", Forms: []IForm{
+		{name: "MOVBEW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xf0, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVBEL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xf0, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVBEQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xf0, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVBEW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xf1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "MOVBEL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xf1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "MOVBEQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xf1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+	}}},
+	{"MOVD", Instruction{Summary: "Move Doubleword", Forms: []IForm{
+		{name: "MOVD", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x7e, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "MOVD", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x7e, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "MOVD", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x6e, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVD", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x6e, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x6e, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x6e, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVD", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x7e, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "MOVD", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x7e, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+	}}},
+	{"MOVDDUP", Instruction{Summary: "Move One Double-FP and Duplicate", Forms: []IForm{
+		{name: "MOVDDUP", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x12, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVDDUP", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x12, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"MOVDQ2Q", Instruction{Summary: "Move Quadword from XMM to MMX Technology Register", Forms: []IForm{
+		{name: "MOVDQ2Q", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xd6, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"MOVDQA", Instruction{Summary: "Move Aligned Double Quadword", Forms: []IForm{
+		{name: "MOVDQA", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x6f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x7f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "MOVDQA", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x6f, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVDQA", opcount: 2, ops: []Op{{TN: "m128", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x7f, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+	}}},
+	{"MOVDQU", Instruction{Summary: "Move Unaligned Double Quadword", Forms: []IForm{
+		{name: "MOVDQU", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x6f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x7f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "MOVDQU", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x6f, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVDQU", opcount: 2, ops: []Op{{TN: "m128", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x7f, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+	}}},
+	{"MOVHLPS", Instruction{Summary: "Move Packed Single-Precision Floating-Point Values High to Low", Forms: []IForm{
+		{name: "MOVHLPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x12, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"MOVHPD", Instruction{Summary: "Move High Packed Double-Precision Floating-Point Value", Forms: []IForm{
+		{name: "MOVHPD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x16, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVHPD", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x17, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+	}}},
+	{"MOVHPS", Instruction{Summary: "Move High Packed Single-Precision Floating-Point Values", Forms: []IForm{
+		{name: "MOVHPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x16, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVHPS", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x17, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+	}}},
+	{"MOVLHPS", Instruction{Summary: "Move Packed Single-Precision Floating-Point Values Low to High", Forms: []IForm{
+		{name: "MOVLHPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x16, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"MOVLPD", Instruction{Summary: "Move Low Packed Double-Precision Floating-Point Value", Forms: []IForm{
+		{name: "MOVLPD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x12, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVLPD", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x13, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+	}}},
+	{"MOVLPS", Instruction{Summary: "Move Low Packed Single-Precision Floating-Point Values", Forms: []IForm{
+		{name: "MOVLPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x12, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVLPS", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x13, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+	}}},
+	{"MOVMSKPD", Instruction{Summary: "Extract Packed Double-Precision Floating-Point Sign Mask", Forms: []IForm{
+		{name: "MOVMSKPD", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x50, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"MOVMSKPS", Instruction{Summary: "Extract Packed Single-Precision Floating-Point Sign Mask", Forms: []IForm{
+		{name: "MOVMSKPS", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x50, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"MOVNTDQ", Instruction{Summary: "Store Double Quadword Using Non-Temporal Hint", Forms: []IForm{
+		{name: "MOVNTDQ", opcount: 2, ops: []Op{{TN: "m128", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xe7, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+	}}},
+	{"MOVNTDQA", Instruction{Summary: "Load Double Quadword Non-Temporal Aligned Hint", Forms: []IForm{
+		{name: "MOVNTDQA", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x2a, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"MOVNTI", Instruction{Summary: "Store Doubleword Using Non-Temporal Hint", Forms: []IForm{
+		{name: "MOVNTIL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xc3, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "MOVNTIQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xc3, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+	}}},
+	{"MOVNTPD", Instruction{Summary: "Store Packed Double-Precision Floating-Point Values Using Non-Temporal Hint", Forms: []IForm{
+		{name: "MOVNTPD", opcount: 2, ops: []Op{{TN: "m128", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2b, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+	}}},
+	{"MOVNTPS", Instruction{Summary: "Store Packed Single-Precision Floating-Point Values Using Non-Temporal Hint", Forms: []IForm{
+		{name: "MOVNTPS", opcount: 2, ops: []Op{{TN: "m128", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2b, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+	}}},
+	{"MOVNTQ", Instruction{Summary: "Store of Quadword Using Non-Temporal Hint", Forms: []IForm{
+		{name: "MOVNTQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xe7, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+	}}},
+	{"MOVNTSD", Instruction{Summary: "Store Scalar Double-Precision Floating-Point Values Using Non-Temporal Hint", Forms: []IForm{
+		{name: "MOVNTSD", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2b, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+	}}},
+	{"MOVNTSS", Instruction{Summary: "Store Scalar Single-Precision Floating-Point Values Using Non-Temporal Hint", Forms: []IForm{
+		{name: "MOVNTSS", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2b, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+	}}},
+	{"MOVQ", Instruction{Summary: "Move Quadword", Forms: []IForm{
+		{name: "MOVQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x7e, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "MOVQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x7e, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "MOVQ", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x6e, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVQ", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x6f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x7f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "MOVQ", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x6f, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x6e, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x6e, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x7e, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xd6, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "MOVQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x7e, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+			{&prefix{b: 0x66}, &rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x6e, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x7f, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x7e, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "MOVQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xd6, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+			{&prefix{b: 0x66}, &rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x7e, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+	}}},
+	{"MOVQ2DQ", Instruction{Summary: "Move Quadword from MMX Technology to XMM Register", Forms: []IForm{
+		{name: "MOVQ2DQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xd6, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"MOVSD", Instruction{Summary: "Move Scalar Double-Precision Floating-Point Value", Forms: []IForm{
+		{name: "MOVSD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x10, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x11, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "MOVSD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x10, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVSD", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x11, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+	}}},
+	{"MOVSHDUP", Instruction{Summary: "Move Packed Single-FP High and Duplicate", Forms: []IForm{
+		{name: "MOVSHDUP", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x16, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVSHDUP", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x16, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"MOVSLDUP", Instruction{Summary: "Move Packed Single-FP Low and Duplicate", Forms: []IForm{
+		{name: "MOVSLDUP", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x12, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVSLDUP", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x12, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"MOVSS", Instruction{Summary: "Move Scalar Single-Precision Floating-Point Values", Forms: []IForm{
+		{name: "MOVSS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x10, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x11, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "MOVSS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x10, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVSS", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x11, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+	}}},
+	{"MOVSX", Instruction{Summary: "Move with Sign-Extension", Forms: []IForm{
+		{name: "MOVSBW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xbe, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVSBW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xbe, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVSBL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xbe, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVSWL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xbf, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVSBL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xbe, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVSWL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xbf, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVSBQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xbe, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVSWQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xbf, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVSBQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xbe, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVSWQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xbf, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"MOVSXD", Instruction{Summary: "Move Doubleword to Quadword with Sign-Extension", Forms: []IForm{
+		{name: "MOVSLQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x63, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVSLQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x63, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"MOVUPD", Instruction{Summary: "Move Unaligned Packed Double-Precision Floating-Point Values", Forms: []IForm{
+		{name: "MOVUPD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x10, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x11, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "MOVUPD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x10, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVUPD", opcount: 2, ops: []Op{{TN: "m128", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x11, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+	}}},
+	{"MOVUPS", Instruction{Summary: "Move Unaligned Packed Single-Precision Floating-Point Values", Forms: []IForm{
+		{name: "MOVUPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x10, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x11, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "MOVUPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x10, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVUPS", opcount: 2, ops: []Op{{TN: "m128", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x11, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+	}}},
+	{"MOVZX", Instruction{Summary: "Move with Zero-Extend", Forms: []IForm{
+		{name: "MOVZBW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xb6, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVZBW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xb6, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVZBL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xb6, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVZWL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xb7, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVZBL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xb6, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVZWL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xb7, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVZBQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xb6, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVZWQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xb7, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVZBQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xb6, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "MOVZWQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xb7, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"MPSADBW", Instruction{Summary: "Compute Multiple Packed Sums of Absolute Difference", Forms: []IForm{
+		{name: "MPSADBW", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x42, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "MPSADBW", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x42, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+	}}},
+	{"MUL", Instruction{Summary: "Unsigned Multiply", Forms: []IForm{
+		{name: "MULB", opcount: 1, ops: []Op{{TN: "r8"}, {TN: "ax", Output: true, Implicit: true}, {TN: "al", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xf6, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}},
+		}},
+		{name: "MULW", opcount: 1, ops: []Op{{TN: "r16"}, {TN: "ax", Output: true, Implicit: true}, {TN: "dx", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}},
+		}},
+		{name: "MULL", opcount: 1, ops: []Op{{TN: "r32"}, {TN: "eax", Output: true, Implicit: true}, {TN: "edx", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}},
+		}},
+		{name: "MULQ", opcount: 1, ops: []Op{{TN: "r64"}, {TN: "rax", Output: true, Implicit: true}, {TN: "rdx", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}},
+		}},
+		{name: "MULB", opcount: 1, ops: []Op{{TN: "m8"}, {TN: "ax", Output: true, Implicit: true}, {TN: "al", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xf6, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x84, rm: 0}},
+		}},
+		{name: "MULW", opcount: 1, ops: []Op{{TN: "m16"}, {TN: "ax", Output: true, Implicit: true}, {TN: "dx", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x84, rm: 0}},
+		}},
+		{name: "MULL", opcount: 1, ops: []Op{{TN: "m32"}, {TN: "eax", Output: true, Implicit: true}, {TN: "edx", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x84, rm: 0}},
+		}},
+		{name: "MULQ", opcount: 1, ops: []Op{{TN: "m64"}, {TN: "rax", Output: true, Implicit: true}, {TN: "rdx", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x84, rm: 0}},
+		}},
+	}}},
+	{"MULPD", Instruction{Summary: "Multiply Packed Double-Precision Floating-Point Values", Forms: []IForm{
+		{name: "MULPD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x59, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "MULPD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x59, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"MULPS", Instruction{Summary: "Multiply Packed Single-Precision Floating-Point Values", Forms: []IForm{
+		{name: "MULPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x59, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "MULPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x59, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"MULSD", Instruction{Summary: "Multiply Scalar Double-Precision Floating-Point Values", Forms: []IForm{
+		{name: "MULSD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x59, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "MULSD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x59, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"MULSS", Instruction{Summary: "Multiply Scalar Single-Precision Floating-Point Values", Forms: []IForm{
+		{name: "MULSS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x59, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "MULSS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x59, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"MULX", Instruction{Summary: "Unsigned Multiply Without Affecting Flags", Forms: []IForm{}}},
+	{"MWAIT", Instruction{Summary: "Monitor Wait", Forms: []IForm{
+		{name: "MWAIT", opcount: 0, ops: []Op{{TN: "eax", Implicit: true}, {TN: "ecx", Implicit: true}}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x01, hasAddend: false, addend: 0}, &opcode{op: 0xc9, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"MWAITX", Instruction{Summary: "Monitor Wait with Timeout", Forms: []IForm{
+		{name: "MWAITX", opcount: 0, ops: []Op{{TN: "eax", Implicit: true}, {TN: "ebx", Implicit: true}, {TN: "ecx", Implicit: true}}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x01, hasAddend: false, addend: 0}, &opcode{op: 0xfb, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"NEG", Instruction{Summary: "Two's Complement Negation", Forms: []IForm{
+		{name: "NEGB", opcount: 1, ops: []Op{{TN: "r8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xf6, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x83, rm: 0}},
+		}},
+		{name: "NEGW", opcount: 1, ops: []Op{{TN: "r16", Output: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x83, rm: 0}},
+		}},
+		{name: "NEGL", opcount: 1, ops: []Op{{TN: "r32", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x83, rm: 0}},
+		}},
+		{name: "NEGQ", opcount: 1, ops: []Op{{TN: "r64", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x83, rm: 0}},
+		}},
+		{name: "NEGB", opcount: 1, ops: []Op{{TN: "m8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xf6, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x83, rm: 0}},
+		}},
+		{name: "NEGW", opcount: 1, ops: []Op{{TN: "m16", Output: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x83, rm: 0}},
+		}},
+		{name: "NEGL", opcount: 1, ops: []Op{{TN: "m32", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x83, rm: 0}},
+		}},
+		{name: "NEGQ", opcount: 1, ops: []Op{{TN: "m64", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x83, rm: 0}},
+		}},
+	}}},
+	{"NOP", Instruction{Summary: "No Operation", Forms: []IForm{
+		{name: "NOP", opcount: 0, ops: []Op{}, enc: [][]Encoder{
+			{&opcode{op: 0x90, hasAddend: false, addend: 0}},
+		}},
+		{name: "NOPW", opcount: 1, ops: []Op{{TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x1f, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+		{name: "NOPL", opcount: 1, ops: []Op{{TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x1f, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+	}}},
+	{"NOT", Instruction{Summary: "One's Complement Negation", Forms: []IForm{
+		{name: "NOTB", opcount: 1, ops: []Op{{TN: "r8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xf6, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x82, rm: 0}},
+		}},
+		{name: "NOTW", opcount: 1, ops: []Op{{TN: "r16", Output: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x82, rm: 0}},
+		}},
+		{name: "NOTL", opcount: 1, ops: []Op{{TN: "r32", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x82, rm: 0}},
+		}},
+		{name: "NOTQ", opcount: 1, ops: []Op{{TN: "r64", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x82, rm: 0}},
+		}},
+		{name: "NOTB", opcount: 1, ops: []Op{{TN: "m8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xf6, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x82, rm: 0}},
+		}},
+		{name: "NOTW", opcount: 1, ops: []Op{{TN: "m16", Output: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x82, rm: 0}},
+		}},
+		{name: "NOTL", opcount: 1, ops: []Op{{TN: "m32", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x82, rm: 0}},
+		}},
+		{name: "NOTQ", opcount: 1, ops: []Op{{TN: "m64", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x82, rm: 0}},
+		}},
+	}}},
+	{"OR", Instruction{Summary: "Logical Inclusive OR", Forms: []IForm{
+		{name: "ORB", opcount: 1, ops: []Op{{TN: "al", Output: true, Implicit: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&opcode{op: 0x0c, hasAddend: false, addend: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ORB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x80, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x81, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ORB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "r8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x08, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0a, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "ORB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "m8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0a, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "ORW", opcount: 1, ops: []Op{{TN: "ax", Output: true, Implicit: true}, {TN: "imm16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &opcode{op: 0x0d, hasAddend: false, addend: 0}, &immediate{size: 2, value: 1}},
+		}},
+		{name: "ORW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x81, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ORW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "imm16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x81, rm: 0}, &immediate{size: 2, value: 1}},
+		}},
+		{name: "ORW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x09, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0b, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "ORW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0b, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "ORL", opcount: 1, ops: []Op{{TN: "eax", Output: true, Implicit: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&opcode{op: 0x0d, hasAddend: false, addend: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "ORL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x81, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ORL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x81, rm: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "ORL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x09, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0b, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "ORL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0b, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "ORQ", opcount: 1, ops: []Op{{TN: "rax", Output: true, Implicit: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x0d, hasAddend: false, addend: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "ORQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x81, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ORQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x81, rm: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "ORQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x09, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0b, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "ORQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0b, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "ORB", opcount: 2, ops: []Op{{TN: "m8", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x80, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x81, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ORB", opcount: 2, ops: []Op{{TN: "m8", Output: true}, {TN: "r8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x08, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "ORW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x81, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ORW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "imm16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x81, rm: 0}, &immediate{size: 2, value: 1}},
+		}},
+		{name: "ORW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x09, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "ORL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x81, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ORL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x81, rm: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "ORL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x09, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "ORQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x81, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ORQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x81, rm: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "ORQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x09, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+	}}},
+	{"ORPD", Instruction{Summary: "Bitwise Logical OR of Double-Precision Floating-Point Values", Forms: []IForm{
+		{name: "ORPD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x56, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "ORPD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x56, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"ORPS", Instruction{Summary: "Bitwise Logical OR of Single-Precision Floating-Point Values", Forms: []IForm{
+		{name: "ORPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x56, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "ORPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x56, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PABSB", Instruction{Summary: "Packed Absolute Value of Byte Integers", Forms: []IForm{
+		{name: "PABSB", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x1c, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PABSB", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x1c, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PABSB", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x1c, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PABSB", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x1c, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PABSD", Instruction{Summary: "Packed Absolute Value of Doubleword Integers", Forms: []IForm{
+		{name: "PABSD", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x1e, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PABSD", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x1e, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PABSD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x1e, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PABSD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x1e, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PABSW", Instruction{Summary: "Packed Absolute Value of Word Integers", Forms: []IForm{
+		{name: "PABSW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x1d, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PABSW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x1d, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PABSW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x1d, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PABSW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x1d, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PACKSSDW", Instruction{Summary: "Pack Doublewords into Words with Signed Saturation", Forms: []IForm{
+		{name: "PACKSSDW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x6b, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PACKSSDW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x6b, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PACKSSDW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x6b, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PACKSSDW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x6b, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PACKSSWB", Instruction{Summary: "Pack Words into Bytes with Signed Saturation", Forms: []IForm{
+		{name: "PACKSSWB", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x63, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PACKSSWB", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x63, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PACKSSWB", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x63, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PACKSSWB", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x63, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PACKUSDW", Instruction{Summary: "Pack Doublewords into Words with Unsigned Saturation", Forms: []IForm{
+		{name: "PACKUSDW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x2b, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PACKUSDW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x2b, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PACKUSWB", Instruction{Summary: "Pack Words into Bytes with Unsigned Saturation", Forms: []IForm{
+		{name: "PACKUSWB", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x67, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PACKUSWB", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x67, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PACKUSWB", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x67, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PACKUSWB", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x67, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PADDB", Instruction{Summary: "Add Packed Byte Integers", Forms: []IForm{
+		{name: "PADDB", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xfc, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PADDB", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xfc, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PADDB", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xfc, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PADDB", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xfc, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PADDD", Instruction{Summary: "Add Packed Doubleword Integers", Forms: []IForm{
+		{name: "PADDD", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xfe, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PADDD", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xfe, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PADDD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xfe, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PADDD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xfe, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PADDQ", Instruction{Summary: "Add Packed Quadword Integers", Forms: []IForm{
+		{name: "PADDQ", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xd4, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PADDQ", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xd4, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PADDQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xd4, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PADDQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xd4, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PADDSB", Instruction{Summary: "Add Packed Signed Byte Integers with Signed Saturation", Forms: []IForm{
+		{name: "PADDSB", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xec, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PADDSB", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xec, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PADDSB", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xec, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PADDSB", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xec, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PADDSW", Instruction{Summary: "Add Packed Signed Word Integers with Signed Saturation", Forms: []IForm{
+		{name: "PADDSW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xed, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PADDSW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xed, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PADDSW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xed, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PADDSW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xed, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PADDUSB", Instruction{Summary: "Add Packed Unsigned Byte Integers with Unsigned Saturation", Forms: []IForm{
+		{name: "PADDUSB", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xdc, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PADDUSB", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xdc, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PADDUSB", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xdc, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PADDUSB", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xdc, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PADDUSW", Instruction{Summary: "Add Packed Unsigned Word Integers with Unsigned Saturation", Forms: []IForm{
+		{name: "PADDUSW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xdd, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PADDUSW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xdd, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PADDUSW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xdd, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PADDUSW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xdd, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PADDW", Instruction{Summary: "Add Packed Word Integers", Forms: []IForm{
+		{name: "PADDW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xfd, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PADDW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xfd, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PADDW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xfd, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PADDW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xfd, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PALIGNR", Instruction{Summary: "Packed Align Right", Forms: []IForm{
+		{name: "PALIGNR", opcount: 3, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "PALIGNR", opcount: 3, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "PALIGNR", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "PALIGNR", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+	}}},
+	{"PAND", Instruction{Summary: "Packed Bitwise Logical AND", Forms: []IForm{
+		{name: "PAND", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xdb, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PAND", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xdb, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PAND", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xdb, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PAND", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xdb, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PANDN", Instruction{Summary: "Packed Bitwise Logical AND NOT", Forms: []IForm{
+		{name: "PANDN", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xdf, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PANDN", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xdf, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PANDN", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xdf, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PANDN", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xdf, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PAUSE", Instruction{Summary: "Spin Loop Hint", Forms: []IForm{
+		{name: "PAUSE", opcount: 0, ops: []Op{}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &opcode{op: 0x90, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"PAVGB", Instruction{Summary: "Average Packed Byte Integers", Forms: []IForm{
+		{name: "PAVGB", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xe0, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PAVGB", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xe0, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PAVGB", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xe0, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PAVGB", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xe0, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PAVGUSB", Instruction{Summary: "Average Packed Byte Integers", Forms: []IForm{
+		{name: "PAVGUSB", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &opcode{op: 0xbf, hasAddend: false, addend: 0}},
+		}},
+		{name: "PAVGUSB", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &opcode{op: 0xbf, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"PAVGW", Instruction{Summary: "Average Packed Word Integers", Forms: []IForm{
+		{name: "PAVGW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xe3, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PAVGW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xe3, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PAVGW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xe3, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PAVGW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xe3, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PBLENDVB", Instruction{Summary: "Variable Blend Packed Bytes", Forms: []IForm{
+		{name: "PBLENDVB", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}, {TN: "xmm0"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x10, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PBLENDVB", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}, {TN: "xmm0"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x10, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PBLENDW", Instruction{Summary: "Blend Packed Words", Forms: []IForm{
+		{name: "PBLENDW", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x0e, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "PBLENDW", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x0e, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+	}}},
+	{"PCLMULQDQ", Instruction{Summary: "Carry-Less Quadword Multiplication", Forms: []IForm{
+		{name: "PCLMULQDQ", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x44, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "PCLMULQDQ", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x44, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+	}}},
+	{"PCMPEQB", Instruction{Summary: "Compare Packed Byte Data for Equality", Forms: []IForm{
+		{name: "PCMPEQB", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x74, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PCMPEQB", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x74, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PCMPEQB", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x74, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PCMPEQB", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x74, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PCMPEQD", Instruction{Summary: "Compare Packed Doubleword Data for Equality", Forms: []IForm{
+		{name: "PCMPEQD", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x76, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PCMPEQD", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x76, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PCMPEQD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x76, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PCMPEQD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x76, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PCMPEQQ", Instruction{Summary: "Compare Packed Quadword Data for Equality", Forms: []IForm{
+		{name: "PCMPEQQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x29, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PCMPEQQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x29, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PCMPEQW", Instruction{Summary: "Compare Packed Word Data for Equality", Forms: []IForm{
+		{name: "PCMPEQW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x75, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PCMPEQW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x75, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PCMPEQW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x75, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PCMPEQW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x75, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PCMPESTRI", Instruction{Summary: "Packed Compare Explicit Length Strings, Return Index", Forms: []IForm{
+		{name: "PCMPESTRI", opcount: 3, ops: []Op{{TN: "xmm"}, {TN: "xmm"}, {TN: "imm8"}, {TN: "eax", Implicit: true}, {TN: "ecx", Output: true, Implicit: true}, {TN: "edx", Implicit: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x61, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "PCMPESTRI", opcount: 3, ops: []Op{{TN: "xmm"}, {TN: "m128"}, {TN: "imm8"}, {TN: "eax", Implicit: true}, {TN: "ecx", Output: true, Implicit: true}, {TN: "edx", Implicit: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x61, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+	}}},
+	{"PCMPESTRM", Instruction{Summary: "Packed Compare Explicit Length Strings, Return Mask", Forms: []IForm{
+		{name: "PCMPESTRM", opcount: 3, ops: []Op{{TN: "xmm"}, {TN: "xmm"}, {TN: "imm8"}, {TN: "eax", Implicit: true}, {TN: "edx", Implicit: true}, {TN: "xmm0", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x60, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "PCMPESTRM", opcount: 3, ops: []Op{{TN: "xmm"}, {TN: "m128"}, {TN: "imm8"}, {TN: "eax", Implicit: true}, {TN: "edx", Implicit: true}, {TN: "xmm0", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x60, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+	}}},
+	{"PCMPGTB", Instruction{Summary: "Compare Packed Signed Byte Integers for Greater Than", Forms: []IForm{
+		{name: "PCMPGTB", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x64, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PCMPGTB", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x64, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PCMPGTB", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x64, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PCMPGTB", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x64, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PCMPGTD", Instruction{Summary: "Compare Packed Signed Doubleword Integers for Greater Than", Forms: []IForm{
+		{name: "PCMPGTD", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x66, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PCMPGTD", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x66, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PCMPGTD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x66, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PCMPGTD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x66, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PCMPGTQ", Instruction{Summary: "Compare Packed Data for Greater Than", Forms: []IForm{
+		{name: "PCMPGTQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x37, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PCMPGTQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x37, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PCMPGTW", Instruction{Summary: "Compare Packed Signed Word Integers for Greater Than", Forms: []IForm{
+		{name: "PCMPGTW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x65, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PCMPGTW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x65, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PCMPGTW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x65, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PCMPGTW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x65, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PCMPISTRI", Instruction{Summary: "Packed Compare Implicit Length Strings, Return Index", Forms: []IForm{
+		{name: "PCMPISTRI", opcount: 3, ops: []Op{{TN: "xmm"}, {TN: "xmm"}, {TN: "imm8"}, {TN: "ecx", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x63, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "PCMPISTRI", opcount: 3, ops: []Op{{TN: "xmm"}, {TN: "m128"}, {TN: "imm8"}, {TN: "ecx", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x63, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+	}}},
+	{"PCMPISTRM", Instruction{Summary: "Packed Compare Implicit Length Strings, Return Mask", Forms: []IForm{
+		{name: "PCMPISTRM", opcount: 3, ops: []Op{{TN: "xmm"}, {TN: "xmm"}, {TN: "imm8"}, {TN: "xmm0", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x62, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "PCMPISTRM", opcount: 3, ops: []Op{{TN: "xmm"}, {TN: "m128"}, {TN: "imm8"}, {TN: "xmm0", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x62, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+	}}},
+	{"PDEP", Instruction{Summary: "Parallel Bits Deposit", Forms: []IForm{}}},
+	{"PEXT", Instruction{Summary: "Parallel Bits Extract", Forms: []IForm{}}},
+	{"PEXTRB", Instruction{Summary: "Extract Byte", Forms: []IForm{
+		{name: "PEXTRB", opcount: 3, ops: []Op{{TN: "r32", Output: true}, {TN: "xmm"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x14, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "PEXTRB", opcount: 3, ops: []Op{{TN: "m8", Output: true}, {TN: "xmm"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x14, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}, &immediate{size: 1, value: 2}},
+		}},
+	}}},
+	{"PEXTRD", Instruction{Summary: "Extract Doubleword", Forms: []IForm{
+		{name: "PEXTRD", opcount: 3, ops: []Op{{TN: "r32", Output: true}, {TN: "xmm"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x16, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "PEXTRD", opcount: 3, ops: []Op{{TN: "m32", Output: true}, {TN: "xmm"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x16, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}, &immediate{size: 1, value: 2}},
+		}},
+	}}},
+	{"PEXTRQ", Instruction{Summary: "Extract Quadword", Forms: []IForm{
+		{name: "PEXTRQ", opcount: 3, ops: []Op{{TN: "r64", Output: true}, {TN: "xmm"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x16, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "PEXTRQ", opcount: 3, ops: []Op{{TN: "m64", Output: true}, {TN: "xmm"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x16, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}, &immediate{size: 1, value: 2}},
+		}},
+	}}},
+	{"PEXTRW", Instruction{Summary: "Extract Word", Forms: []IForm{
+		{name: "PEXTRW", opcount: 3, ops: []Op{{TN: "r32", Output: true}, {TN: "mm"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xc5, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "PEXTRW", opcount: 3, ops: []Op{{TN: "r32", Output: true}, {TN: "xmm"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x15, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}, &immediate{size: 1, value: 2}},
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xc5, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "PEXTRW", opcount: 3, ops: []Op{{TN: "m16", Output: true}, {TN: "xmm"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x15, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}, &immediate{size: 1, value: 2}},
+		}},
+	}}},
+	{"PF2ID", Instruction{Summary: "Packed Floating-Point to Integer Doubleword Converson", Forms: []IForm{
+		{name: "PF2ID", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &opcode{op: 0x1d, hasAddend: false, addend: 0}},
+		}},
+		{name: "PF2ID", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &opcode{op: 0x1d, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"PF2IW", Instruction{Summary: "Packed Floating-Point to Integer Word Conversion", Forms: []IForm{
+		{name: "PF2IW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &opcode{op: 0x1c, hasAddend: false, addend: 0}},
+		}},
+		{name: "PF2IW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &opcode{op: 0x1c, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"PFACC", Instruction{Summary: "Packed Floating-Point Accumulate", Forms: []IForm{
+		{name: "PFACC", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &opcode{op: 0xae, hasAddend: false, addend: 0}},
+		}},
+		{name: "PFACC", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &opcode{op: 0xae, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"PFADD", Instruction{Summary: "Packed Floating-Point Add", Forms: []IForm{
+		{name: "PFADD", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &opcode{op: 0x9e, hasAddend: false, addend: 0}},
+		}},
+		{name: "PFADD", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &opcode{op: 0x9e, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"PFCMPEQ", Instruction{Summary: "Packed Floating-Point Compare for Equal", Forms: []IForm{
+		{name: "PFCMPEQ", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &opcode{op: 0xb0, hasAddend: false, addend: 0}},
+		}},
+		{name: "PFCMPEQ", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &opcode{op: 0xb0, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"PFCMPGE", Instruction{Summary: "Packed Floating-Point Compare for Greater or Equal", Forms: []IForm{
+		{name: "PFCMPGE", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &opcode{op: 0x90, hasAddend: false, addend: 0}},
+		}},
+		{name: "PFCMPGE", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &opcode{op: 0x90, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"PFCMPGT", Instruction{Summary: "Packed Floating-Point Compare for Greater Than", Forms: []IForm{
+		{name: "PFCMPGT", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &opcode{op: 0xa0, hasAddend: false, addend: 0}},
+		}},
+		{name: "PFCMPGT", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &opcode{op: 0xa0, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"PFMAX", Instruction{Summary: "Packed Floating-Point Maximum", Forms: []IForm{
+		{name: "PFMAX", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &opcode{op: 0xa4, hasAddend: false, addend: 0}},
+		}},
+		{name: "PFMAX", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &opcode{op: 0xa4, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"PFMIN", Instruction{Summary: "Packed Floating-Point Minimum", Forms: []IForm{
+		{name: "PFMIN", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &opcode{op: 0x94, hasAddend: false, addend: 0}},
+		}},
+		{name: "PFMIN", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &opcode{op: 0x94, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"PFMUL", Instruction{Summary: "Packed Floating-Point Multiply", Forms: []IForm{
+		{name: "PFMUL", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &opcode{op: 0xb4, hasAddend: false, addend: 0}},
+		}},
+		{name: "PFMUL", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &opcode{op: 0xb4, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"PFNACC", Instruction{Summary: "Packed Floating-Point Negative Accumulate", Forms: []IForm{
+		{name: "PFNACC", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &opcode{op: 0x8a, hasAddend: false, addend: 0}},
+		}},
+		{name: "PFNACC", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &opcode{op: 0x8a, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"PFPNACC", Instruction{Summary: "Packed Floating-Point Positive-Negative Accumulate", Forms: []IForm{
+		{name: "PFPNACC", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &opcode{op: 0x8e, hasAddend: false, addend: 0}},
+		}},
+		{name: "PFPNACC", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &opcode{op: 0x8e, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"PFRCP", Instruction{Summary: "Packed Floating-Point Reciprocal Approximation", Forms: []IForm{
+		{name: "PFRCP", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &opcode{op: 0x96, hasAddend: false, addend: 0}},
+		}},
+		{name: "PFRCP", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &opcode{op: 0x96, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"PFRCPIT1", Instruction{Summary: "Packed Floating-Point Reciprocal Iteration 1", Forms: []IForm{
+		{name: "PFRCPIT1", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &opcode{op: 0xa6, hasAddend: false, addend: 0}},
+		}},
+		{name: "PFRCPIT1", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &opcode{op: 0xa6, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"PFRCPIT2", Instruction{Summary: "Packed Floating-Point Reciprocal Iteration 2", Forms: []IForm{
+		{name: "PFRCPIT2", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &opcode{op: 0xb6, hasAddend: false, addend: 0}},
+		}},
+		{name: "PFRCPIT2", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &opcode{op: 0xb6, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"PFRSQIT1", Instruction{Summary: "Packed Floating-Point Reciprocal Square Root Iteration 1", Forms: []IForm{
+		{name: "PFRSQIT1", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &opcode{op: 0xa7, hasAddend: false, addend: 0}},
+		}},
+		{name: "PFRSQIT1", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &opcode{op: 0xa7, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"PFRSQRT", Instruction{Summary: "Packed Floating-Point Reciprocal Square Root Approximation", Forms: []IForm{
+		{name: "PFRSQRT", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &opcode{op: 0x97, hasAddend: false, addend: 0}},
+		}},
+		{name: "PFRSQRT", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &opcode{op: 0x97, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"PFSUB", Instruction{Summary: "Packed Floating-Point Subtract", Forms: []IForm{
+		{name: "PFSUB", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &opcode{op: 0x9a, hasAddend: false, addend: 0}},
+		}},
+		{name: "PFSUB", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &opcode{op: 0x9a, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"PFSUBR", Instruction{Summary: "Packed Floating-Point Subtract Reverse", Forms: []IForm{
+		{name: "PFSUBR", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &opcode{op: 0xaa, hasAddend: false, addend: 0}},
+		}},
+		{name: "PFSUBR", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &opcode{op: 0xaa, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"PHADDD", Instruction{Summary: "Packed Horizontal Add Doubleword Integer", Forms: []IForm{
+		{name: "PHADDD", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x02, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PHADDD", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x02, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PHADDD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x02, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PHADDD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x02, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PHADDSW", Instruction{Summary: "Packed Horizontal Add Signed Word Integers with Signed Saturation", Forms: []IForm{
+		{name: "PHADDSW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x03, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PHADDSW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x03, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PHADDSW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x03, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PHADDSW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x03, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PHADDW", Instruction{Summary: "Packed Horizontal Add Word Integers", Forms: []IForm{
+		{name: "PHADDW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x01, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PHADDW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x01, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PHADDW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x01, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PHADDW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x01, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PHMINPOSUW", Instruction{Summary: "Packed Horizontal Minimum of Unsigned Word Integers", Forms: []IForm{
+		{name: "PHMINPOSUW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x41, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PHMINPOSUW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x41, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PHSUBD", Instruction{Summary: "Packed Horizontal Subtract Doubleword Integers", Forms: []IForm{
+		{name: "PHSUBD", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x06, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PHSUBD", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x06, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PHSUBD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x06, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PHSUBD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x06, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PHSUBSW", Instruction{Summary: "Packed Horizontal Subtract Signed Word Integers with Signed Saturation", Forms: []IForm{
+		{name: "PHSUBSW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x07, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PHSUBSW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x07, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PHSUBSW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x07, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PHSUBSW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x07, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PHSUBW", Instruction{Summary: "Packed Horizontal Subtract Word Integers", Forms: []IForm{
+		{name: "PHSUBW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x05, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PHSUBW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x05, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PHSUBW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x05, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PHSUBW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x05, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PI2FD", Instruction{Summary: "Packed Integer to Floating-Point Doubleword Conversion", Forms: []IForm{
+		{name: "PI2FD", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &opcode{op: 0x0d, hasAddend: false, addend: 0}},
+		}},
+		{name: "PI2FD", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &opcode{op: 0x0d, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"PI2FW", Instruction{Summary: "Packed Integer to Floating-Point Word Conversion", Forms: []IForm{
+		{name: "PI2FW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &opcode{op: 0x0c, hasAddend: false, addend: 0}},
+		}},
+		{name: "PI2FW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &opcode{op: 0x0c, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"PINSRB", Instruction{Summary: "Insert Byte", Forms: []IForm{
+		{name: "PINSRB", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "r32"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x20, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "PINSRB", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "m8"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x20, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+	}}},
+	{"PINSRD", Instruction{Summary: "Insert Doubleword", Forms: []IForm{
+		{name: "PINSRD", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "r32"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x22, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "PINSRD", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "m32"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x22, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+	}}},
+	{"PINSRQ", Instruction{Summary: "Insert Quadword", Forms: []IForm{
+		{name: "PINSRQ", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "r64"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x22, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "PINSRQ", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "m64"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x22, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+	}}},
+	{"PINSRW", Instruction{Summary: "Insert Word", Forms: []IForm{
+		{name: "PINSRW", opcount: 3, ops: []Op{{TN: "mm", Output: true}, {TN: "r32"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xc4, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "PINSRW", opcount: 3, ops: []Op{{TN: "mm", Output: true}, {TN: "m16"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xc4, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "PINSRW", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "r32"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xc4, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "PINSRW", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "m16"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xc4, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+	}}},
+	{"PMADDUBSW", Instruction{Summary: "Multiply and Add Packed Signed and Unsigned Byte Integers", Forms: []IForm{
+		{name: "PMADDUBSW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x04, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMADDUBSW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x04, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMADDUBSW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x04, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMADDUBSW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x04, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PMADDWD", Instruction{Summary: "Multiply and Add Packed Signed Word Integers", Forms: []IForm{
+		{name: "PMADDWD", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xf5, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMADDWD", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xf5, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMADDWD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xf5, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMADDWD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xf5, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PMAXSB", Instruction{Summary: "Maximum of Packed Signed Byte Integers", Forms: []IForm{
+		{name: "PMAXSB", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x3c, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMAXSB", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x3c, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PMAXSD", Instruction{Summary: "Maximum of Packed Signed Doubleword Integers", Forms: []IForm{
+		{name: "PMAXSD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x3d, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMAXSD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x3d, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PMAXSW", Instruction{Summary: "Maximum of Packed Signed Word Integers", Forms: []IForm{
+		{name: "PMAXSW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xee, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMAXSW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xee, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMAXSW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xee, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMAXSW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xee, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PMAXUB", Instruction{Summary: "Maximum of Packed Unsigned Byte Integers", Forms: []IForm{
+		{name: "PMAXUB", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xde, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMAXUB", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xde, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMAXUB", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xde, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMAXUB", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xde, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PMAXUD", Instruction{Summary: "Maximum of Packed Unsigned Doubleword Integers", Forms: []IForm{
+		{name: "PMAXUD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x3f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMAXUD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x3f, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PMAXUW", Instruction{Summary: "Maximum of Packed Unsigned Word Integers", Forms: []IForm{
+		{name: "PMAXUW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x3e, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMAXUW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x3e, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PMINSB", Instruction{Summary: "Minimum of Packed Signed Byte Integers", Forms: []IForm{
+		{name: "PMINSB", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMINSB", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PMINSD", Instruction{Summary: "Minimum of Packed Signed Doubleword Integers", Forms: []IForm{
+		{name: "PMINSD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x39, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMINSD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x39, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PMINSW", Instruction{Summary: "Minimum of Packed Signed Word Integers", Forms: []IForm{
+		{name: "PMINSW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xea, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMINSW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xea, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMINSW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xea, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMINSW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xea, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PMINUB", Instruction{Summary: "Minimum of Packed Unsigned Byte Integers", Forms: []IForm{
+		{name: "PMINUB", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xda, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMINUB", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xda, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMINUB", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xda, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMINUB", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xda, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PMINUD", Instruction{Summary: "Minimum of Packed Unsigned Doubleword Integers", Forms: []IForm{
+		{name: "PMINUD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x3b, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMINUD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x3b, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PMINUW", Instruction{Summary: "Minimum of Packed Unsigned Word Integers", Forms: []IForm{
+		{name: "PMINUW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMINUW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PMOVMSKB", Instruction{Summary: "Move Byte Mask", Forms: []IForm{
+		{name: "PMOVMSKB", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xd7, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMOVMSKB", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xd7, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PMOVSXBD", Instruction{Summary: "Move Packed Byte Integers to Doubleword Integers with Sign Extension", Forms: []IForm{
+		{name: "PMOVSXBD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x21, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMOVSXBD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x21, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PMOVSXBQ", Instruction{Summary: "Move Packed Byte Integers to Quadword Integers with Sign Extension", Forms: []IForm{
+		{name: "PMOVSXBQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x22, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMOVSXBQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x22, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PMOVSXBW", Instruction{Summary: "Move Packed Byte Integers to Word Integers with Sign Extension", Forms: []IForm{
+		{name: "PMOVSXBW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x20, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMOVSXBW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x20, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PMOVSXDQ", Instruction{Summary: "Move Packed Doubleword Integers to Quadword Integers with Sign Extension", Forms: []IForm{
+		{name: "PMOVSXDQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x25, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMOVSXDQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x25, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PMOVSXWD", Instruction{Summary: "Move Packed Word Integers to Doubleword Integers with Sign Extension", Forms: []IForm{
+		{name: "PMOVSXWD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x23, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMOVSXWD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x23, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PMOVSXWQ", Instruction{Summary: "Move Packed Word Integers to Quadword Integers with Sign Extension", Forms: []IForm{
+		{name: "PMOVSXWQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x24, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMOVSXWQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x24, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PMOVZXBD", Instruction{Summary: "Move Packed Byte Integers to Doubleword Integers with Zero Extension", Forms: []IForm{
+		{name: "PMOVZXBD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x31, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMOVZXBD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x31, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PMOVZXBQ", Instruction{Summary: "Move Packed Byte Integers to Quadword Integers with Zero Extension", Forms: []IForm{
+		{name: "PMOVZXBQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x32, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMOVZXBQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x32, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PMOVZXBW", Instruction{Summary: "Move Packed Byte Integers to Word Integers with Zero Extension", Forms: []IForm{
+		{name: "PMOVZXBW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x30, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMOVZXBW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x30, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PMOVZXDQ", Instruction{Summary: "Move Packed Doubleword Integers to Quadword Integers with Zero Extension", Forms: []IForm{
+		{name: "PMOVZXDQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x35, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMOVZXDQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x35, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PMOVZXWD", Instruction{Summary: "Move Packed Word Integers to Doubleword Integers with Zero Extension", Forms: []IForm{
+		{name: "PMOVZXWD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x33, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMOVZXWD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x33, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PMOVZXWQ", Instruction{Summary: "Move Packed Word Integers to Quadword Integers with Zero Extension", Forms: []IForm{
+		{name: "PMOVZXWQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x34, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMOVZXWQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x34, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PMULDQ", Instruction{Summary: "Multiply Packed Signed Doubleword Integers and Store Quadword Result", Forms: []IForm{
+		{name: "PMULDQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x28, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMULDQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x28, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PMULHRSW", Instruction{Summary: "Packed Multiply Signed Word Integers and Store High Result with Round and Scale", Forms: []IForm{
+		{name: "PMULHRSW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x0b, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMULHRSW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x0b, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMULHRSW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x0b, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMULHRSW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x0b, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PMULHRW", Instruction{Summary: "Packed Multiply High Rounded Word", Forms: []IForm{
+		{name: "PMULHRW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &opcode{op: 0xb7, hasAddend: false, addend: 0}},
+		}},
+		{name: "PMULHRW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &opcode{op: 0xb7, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"PMULHUW", Instruction{Summary: "Multiply Packed Unsigned Word Integers and Store High Result", Forms: []IForm{
+		{name: "PMULHUW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xe4, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMULHUW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xe4, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMULHUW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xe4, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMULHUW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xe4, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PMULHW", Instruction{Summary: "Multiply Packed Signed Word Integers and Store High Result", Forms: []IForm{
+		{name: "PMULHW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xe5, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMULHW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xe5, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMULHW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xe5, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMULHW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xe5, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PMULLD", Instruction{Summary: "Multiply Packed Signed Doubleword Integers and Store Low Result", Forms: []IForm{
+		{name: "PMULLD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x40, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMULLD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x40, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PMULLW", Instruction{Summary: "Multiply Packed Signed Word Integers and Store Low Result", Forms: []IForm{
+		{name: "PMULLW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xd5, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMULLW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xd5, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMULLW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xd5, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMULLW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xd5, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PMULUDQ", Instruction{Summary: "Multiply Packed Unsigned Doubleword Integers", Forms: []IForm{
+		{name: "PMULUDQ", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xf4, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMULUDQ", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xf4, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMULUDQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xf4, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PMULUDQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xf4, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"POP", Instruction{Summary: "Pop a Value from the Stack", Forms: []IForm{
+		{name: "POPW", opcount: 1, ops: []Op{{TN: "r16", Output: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x58, hasAddend: true, addend: 0}},
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x8f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "POPQ", opcount: 1, ops: []Op{{TN: "r64", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x58, hasAddend: true, addend: 0}},
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x8f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "POPW", opcount: 1, ops: []Op{{TN: "m16", Output: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x8f, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+		{name: "POPQ", opcount: 1, ops: []Op{{TN: "m64", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x8f, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+	}}},
+	{"POPCNT", Instruction{Summary: "Count of Number of Bits Set to 1", Forms: []IForm{
+		{name: "POPCNTW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xb8, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "POPCNTW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xb8, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "POPCNTL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xb8, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "POPCNTL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xb8, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "POPCNTQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xb8, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "POPCNTQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xb8, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"POR", Instruction{Summary: "Packed Bitwise Logical OR", Forms: []IForm{
+		{name: "POR", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xeb, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "POR", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xeb, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "POR", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xeb, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "POR", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xeb, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PREFETCH", Instruction{Summary: "Prefetch Data into Caches", Forms: []IForm{
+		{name: "PREFETCH", opcount: 1, ops: []Op{{TN: "m8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0d, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+	}}},
+	{"PREFETCHNTA", Instruction{Summary: "Prefetch Data Into Caches using NTA Hint", Forms: []IForm{
+		{name: "PREFETCHNTA", opcount: 1, ops: []Op{{TN: "m8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x18, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+	}}},
+	{"PREFETCHT0", Instruction{Summary: "Prefetch Data Into Caches using T0 Hint", Forms: []IForm{
+		{name: "PREFETCHT0", opcount: 1, ops: []Op{{TN: "m8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x18, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x81, rm: 0}},
+		}},
+	}}},
+	{"PREFETCHT1", Instruction{Summary: "Prefetch Data Into Caches using T1 Hint", Forms: []IForm{
+		{name: "PREFETCHT1", opcount: 1, ops: []Op{{TN: "m8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x18, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x82, rm: 0}},
+		}},
+	}}},
+	{"PREFETCHT2", Instruction{Summary: "Prefetch Data Into Caches using T2 Hint", Forms: []IForm{
+		{name: "PREFETCHT2", opcount: 1, ops: []Op{{TN: "m8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x18, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x83, rm: 0}},
+		}},
+	}}},
+	{"PREFETCHW", Instruction{Summary: "Prefetch Data into Caches in Anticipation of a Write", Forms: []IForm{
+		{name: "PREFETCHW", opcount: 1, ops: []Op{{TN: "m8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0d, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x81, rm: 0}},
+		}},
+	}}},
+	{"PREFETCHWT1", Instruction{Summary: "Prefetch Vector Data Into Caches with Intent to Write and T1 Hint", Forms: []IForm{
+		{name: "PREFETCHWT1", opcount: 1, ops: []Op{{TN: "m8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0d, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x82, rm: 0}},
+		}},
+	}}},
+	{"PSADBW", Instruction{Summary: "Compute Sum of Absolute Differences", Forms: []IForm{
+		{name: "PSADBW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xf6, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSADBW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xf6, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSADBW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xf6, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSADBW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xf6, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PSHUFB", Instruction{Summary: "Packed Shuffle Bytes", Forms: []IForm{
+		{name: "PSHUFB", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x00, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSHUFB", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x00, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSHUFB", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x00, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSHUFB", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x00, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PSHUFD", Instruction{Summary: "Shuffle Packed Doublewords", Forms: []IForm{
+		{name: "PSHUFD", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x70, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "PSHUFD", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x70, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+	}}},
+	{"PSHUFHW", Instruction{Summary: "Shuffle Packed High Words", Forms: []IForm{
+		{name: "PSHUFHW", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x70, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "PSHUFHW", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x70, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+	}}},
+	{"PSHUFLW", Instruction{Summary: "Shuffle Packed Low Words", Forms: []IForm{
+		{name: "PSHUFLW", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x70, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "PSHUFLW", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x70, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+	}}},
+	{"PSHUFW", Instruction{Summary: "Shuffle Packed Words", Forms: []IForm{
+		{name: "PSHUFW", opcount: 3, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x70, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "PSHUFW", opcount: 3, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x70, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+	}}},
+	{"PSIGNB", Instruction{Summary: "Packed Sign of Byte Integers", Forms: []IForm{
+		{name: "PSIGNB", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x08, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSIGNB", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x08, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSIGNB", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x08, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSIGNB", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x08, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PSIGND", Instruction{Summary: "Packed Sign of Doubleword Integers", Forms: []IForm{
+		{name: "PSIGND", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x0a, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSIGND", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x0a, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSIGND", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x0a, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSIGND", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x0a, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PSIGNW", Instruction{Summary: "Packed Sign of Word Integers", Forms: []IForm{
+		{name: "PSIGNW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x09, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSIGNW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x09, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSIGNW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x09, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSIGNW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x09, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PSLLD", Instruction{Summary: "Shift Packed Doubleword Data Left Logical", Forms: []IForm{
+		{name: "PSLLD", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x72, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x86, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "PSLLD", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xf2, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSLLD", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xf2, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSLLD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x72, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x86, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "PSLLD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xf2, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSLLD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xf2, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PSLLDQ", Instruction{Summary: "Shift Packed Double Quadword Left Logical", Forms: []IForm{
+		{name: "PSLLDQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x73, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x87, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+	}}},
+	{"PSLLQ", Instruction{Summary: "Shift Packed Quadword Data Left Logical", Forms: []IForm{
+		{name: "PSLLQ", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x73, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x86, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "PSLLQ", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xf3, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSLLQ", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xf3, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSLLQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x73, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x86, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "PSLLQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xf3, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSLLQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xf3, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PSLLW", Instruction{Summary: "Shift Packed Word Data Left Logical", Forms: []IForm{
+		{name: "PSLLW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x71, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x86, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "PSLLW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xf1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSLLW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xf1, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSLLW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x71, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x86, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "PSLLW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xf1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSLLW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xf1, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PSRAD", Instruction{Summary: "Shift Packed Doubleword Data Right Arithmetic", Forms: []IForm{
+		{name: "PSRAD", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x72, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "PSRAD", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xe2, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSRAD", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xe2, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSRAD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x72, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "PSRAD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xe2, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSRAD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xe2, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PSRAW", Instruction{Summary: "Shift Packed Word Data Right Arithmetic", Forms: []IForm{
+		{name: "PSRAW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x71, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "PSRAW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xe1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSRAW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xe1, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSRAW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x71, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "PSRAW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xe1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSRAW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xe1, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PSRLD", Instruction{Summary: "Shift Packed Doubleword Data Right Logical", Forms: []IForm{
+		{name: "PSRLD", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x72, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x82, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "PSRLD", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xd2, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSRLD", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xd2, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSRLD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x72, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x82, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "PSRLD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xd2, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSRLD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xd2, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PSRLDQ", Instruction{Summary: "Shift Packed Double Quadword Right Logical", Forms: []IForm{
+		{name: "PSRLDQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x73, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x83, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+	}}},
+	{"PSRLQ", Instruction{Summary: "Shift Packed Quadword Data Right Logical", Forms: []IForm{
+		{name: "PSRLQ", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x73, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x82, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "PSRLQ", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSRLQ", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSRLQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x73, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x82, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "PSRLQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSRLQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PSRLW", Instruction{Summary: "Shift Packed Word Data Right Logical", Forms: []IForm{
+		{name: "PSRLW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x71, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x82, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "PSRLW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSRLW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSRLW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x71, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x82, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "PSRLW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSRLW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PSUBB", Instruction{Summary: "Subtract Packed Byte Integers", Forms: []IForm{
+		{name: "PSUBB", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xf8, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSUBB", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xf8, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSUBB", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xf8, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSUBB", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xf8, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PSUBD", Instruction{Summary: "Subtract Packed Doubleword Integers", Forms: []IForm{
+		{name: "PSUBD", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xfa, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSUBD", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xfa, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSUBD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xfa, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSUBD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xfa, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PSUBQ", Instruction{Summary: "Subtract Packed Quadword Integers", Forms: []IForm{
+		{name: "PSUBQ", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xfb, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSUBQ", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xfb, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSUBQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xfb, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSUBQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xfb, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PSUBSB", Instruction{Summary: "Subtract Packed Signed Byte Integers with Signed Saturation", Forms: []IForm{
+		{name: "PSUBSB", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xe8, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSUBSB", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xe8, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSUBSB", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xe8, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSUBSB", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xe8, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PSUBSW", Instruction{Summary: "Subtract Packed Signed Word Integers with Signed Saturation", Forms: []IForm{
+		{name: "PSUBSW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xe9, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSUBSW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xe9, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSUBSW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xe9, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSUBSW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xe9, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PSUBUSB", Instruction{Summary: "Subtract Packed Unsigned Byte Integers with Unsigned Saturation", Forms: []IForm{
+		{name: "PSUBUSB", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xd8, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSUBUSB", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xd8, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSUBUSB", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xd8, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSUBUSB", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xd8, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PSUBUSW", Instruction{Summary: "Subtract Packed Unsigned Word Integers with Unsigned Saturation", Forms: []IForm{
+		{name: "PSUBUSW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xd9, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSUBUSW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xd9, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSUBUSW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xd9, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSUBUSW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xd9, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PSUBW", Instruction{Summary: "Subtract Packed Word Integers", Forms: []IForm{
+		{name: "PSUBW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xf9, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSUBW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xf9, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSUBW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xf9, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PSUBW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xf9, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PSWAPD", Instruction{Summary: "Packed Swap Doubleword", Forms: []IForm{
+		{name: "PSWAPD", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &opcode{op: 0xbb, hasAddend: false, addend: 0}},
+		}},
+		{name: "PSWAPD", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &opcode{op: 0xbb, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"PTEST", Instruction{Summary: "Packed Logical Compare", Forms: []IForm{
+		{name: "PTEST", opcount: 2, ops: []Op{{TN: "xmm"}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x17, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PTEST", opcount: 2, ops: []Op{{TN: "xmm"}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0x17, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PUNPCKHBW", Instruction{Summary: "Unpack and Interleave High-Order Bytes into Words", Forms: []IForm{
+		{name: "PUNPCKHBW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x68, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PUNPCKHBW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x68, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PUNPCKHBW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x68, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PUNPCKHBW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x68, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PUNPCKHDQ", Instruction{Summary: "Unpack and Interleave High-Order Doublewords into Quadwords", Forms: []IForm{
+		{name: "PUNPCKHDQ", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x6a, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PUNPCKHDQ", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x6a, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PUNPCKHDQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x6a, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PUNPCKHDQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x6a, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PUNPCKHQDQ", Instruction{Summary: "Unpack and Interleave High-Order Quadwords into Double Quadwords", Forms: []IForm{
+		{name: "PUNPCKHQDQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x6d, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PUNPCKHQDQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x6d, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PUNPCKHWD", Instruction{Summary: "Unpack and Interleave High-Order Words into Doublewords", Forms: []IForm{
+		{name: "PUNPCKHWD", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x69, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PUNPCKHWD", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x69, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PUNPCKHWD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x69, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PUNPCKHWD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x69, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PUNPCKLBW", Instruction{Summary: "Unpack and Interleave Low-Order Bytes into Words", Forms: []IForm{
+		{name: "PUNPCKLBW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x60, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PUNPCKLBW", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x60, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PUNPCKLBW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x60, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PUNPCKLBW", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x60, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PUNPCKLDQ", Instruction{Summary: "Unpack and Interleave Low-Order Doublewords into Quadwords", Forms: []IForm{
+		{name: "PUNPCKLDQ", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x62, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PUNPCKLDQ", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x62, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PUNPCKLDQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x62, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PUNPCKLDQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x62, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PUNPCKLQDQ", Instruction{Summary: "Unpack and Interleave Low-Order Quadwords into Double Quadwords", Forms: []IForm{
+		{name: "PUNPCKLQDQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x6c, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PUNPCKLQDQ", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x6c, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PUNPCKLWD", Instruction{Summary: "Unpack and Interleave Low-Order Words into Doublewords", Forms: []IForm{
+		{name: "PUNPCKLWD", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x61, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PUNPCKLWD", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x61, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PUNPCKLWD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x61, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PUNPCKLWD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x61, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"PUSH", Instruction{Summary: "Push Value Onto the Stack", Forms: []IForm{
+		{name: "PUSHQ", opcount: 1, ops: []Op{{TN: "imm8"}}, enc: [][]Encoder{
+			{&opcode{op: 0x6a, hasAddend: false, addend: 0}, &immediate{size: 1, value: 0}},
+		}},
+		{name: "PUSHQ", opcount: 1, ops: []Op{{TN: "imm32"}}, enc: [][]Encoder{
+			{&opcode{op: 0x68, hasAddend: false, addend: 0}, &immediate{size: 4, value: 0}},
+		}},
+		{name: "PUSHW", opcount: 1, ops: []Op{{TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x50, hasAddend: true, addend: 0}},
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xff, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x86, rm: 0}},
+		}},
+		{name: "PUSHQ", opcount: 1, ops: []Op{{TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x50, hasAddend: true, addend: 0}},
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xff, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x86, rm: 0}},
+		}},
+		{name: "PUSHW", opcount: 1, ops: []Op{{TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xff, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x86, rm: 0}},
+		}},
+		{name: "PUSHQ", opcount: 1, ops: []Op{{TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xff, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x86, rm: 0}},
+		}},
+	}}},
+	{"PXOR", Instruction{Summary: "Packed Bitwise Logical Exclusive OR", Forms: []IForm{
+		{name: "PXOR", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "mm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xef, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PXOR", opcount: 2, ops: []Op{{TN: "mm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xef, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "PXOR", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xef, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "PXOR", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xef, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"RCL", Instruction{Summary: "Rotate Left through Carry Flag", Forms: []IForm{
+		{name: "RCLB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd0, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x82, rm: 0}},
+		}},
+		{name: "RCLB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc0, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x82, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "RCLB", opcount: 1, ops: []Op{{TN: "r8", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd2, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x82, rm: 0}},
+		}},
+		{name: "RCLW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x82, rm: 0}},
+		}},
+		{name: "RCLW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x82, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "RCLW", opcount: 1, ops: []Op{{TN: "r16", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x82, rm: 0}},
+		}},
+		{name: "RCLL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x82, rm: 0}},
+		}},
+		{name: "RCLL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x82, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "RCLL", opcount: 1, ops: []Op{{TN: "r32", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x82, rm: 0}},
+		}},
+		{name: "RCLQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x82, rm: 0}},
+		}},
+		{name: "RCLQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x82, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "RCLQ", opcount: 1, ops: []Op{{TN: "r64", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x82, rm: 0}},
+		}},
+		{name: "RCLB", opcount: 2, ops: []Op{{TN: "m8", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd0, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x82, rm: 0}},
+		}},
+		{name: "RCLB", opcount: 2, ops: []Op{{TN: "m8", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc0, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x82, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "RCLB", opcount: 1, ops: []Op{{TN: "m8", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd2, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x82, rm: 0}},
+		}},
+		{name: "RCLW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x82, rm: 0}},
+		}},
+		{name: "RCLW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x82, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "RCLW", opcount: 1, ops: []Op{{TN: "m16", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x82, rm: 0}},
+		}},
+		{name: "RCLL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x82, rm: 0}},
+		}},
+		{name: "RCLL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x82, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "RCLL", opcount: 1, ops: []Op{{TN: "m32", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x82, rm: 0}},
+		}},
+		{name: "RCLQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x82, rm: 0}},
+		}},
+		{name: "RCLQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x82, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "RCLQ", opcount: 1, ops: []Op{{TN: "m64", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x82, rm: 0}},
+		}},
+	}}},
+	{"RCPPS", Instruction{Summary: "Compute Approximate Reciprocals of Packed Single-Precision Floating-Point Values", Forms: []IForm{
+		{name: "RCPPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x53, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "RCPPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x53, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"RCPSS", Instruction{Summary: "Compute Approximate Reciprocal of Scalar Single-Precision Floating-Point Values", Forms: []IForm{
+		{name: "RCPSS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x53, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "RCPSS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x53, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"RCR", Instruction{Summary: "Rotate Right through Carry Flag", Forms: []IForm{
+		{name: "RCRB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd0, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x83, rm: 0}},
+		}},
+		{name: "RCRB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc0, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x83, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "RCRB", opcount: 1, ops: []Op{{TN: "r8", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd2, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x83, rm: 0}},
+		}},
+		{name: "RCRW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x83, rm: 0}},
+		}},
+		{name: "RCRW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x83, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "RCRW", opcount: 1, ops: []Op{{TN: "r16", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x83, rm: 0}},
+		}},
+		{name: "RCRL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x83, rm: 0}},
+		}},
+		{name: "RCRL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x83, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "RCRL", opcount: 1, ops: []Op{{TN: "r32", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x83, rm: 0}},
+		}},
+		{name: "RCRQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x83, rm: 0}},
+		}},
+		{name: "RCRQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x83, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "RCRQ", opcount: 1, ops: []Op{{TN: "r64", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x83, rm: 0}},
+		}},
+		{name: "RCRB", opcount: 2, ops: []Op{{TN: "m8", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd0, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x83, rm: 0}},
+		}},
+		{name: "RCRB", opcount: 2, ops: []Op{{TN: "m8", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc0, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x83, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "RCRB", opcount: 1, ops: []Op{{TN: "m8", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd2, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x83, rm: 0}},
+		}},
+		{name: "RCRW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x83, rm: 0}},
+		}},
+		{name: "RCRW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x83, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "RCRW", opcount: 1, ops: []Op{{TN: "m16", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x83, rm: 0}},
+		}},
+		{name: "RCRL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x83, rm: 0}},
+		}},
+		{name: "RCRL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x83, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "RCRL", opcount: 1, ops: []Op{{TN: "m32", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x83, rm: 0}},
+		}},
+		{name: "RCRQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x83, rm: 0}},
+		}},
+		{name: "RCRQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x83, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "RCRQ", opcount: 1, ops: []Op{{TN: "m64", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x83, rm: 0}},
+		}},
+	}}},
+	{"RDRAND", Instruction{Summary: "Read Random Number", Forms: []IForm{
+		{name: "RDRAND", opcount: 1, ops: []Op{{TN: "r16", Output: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xc7, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x86, rm: 0}},
+		}},
+		{name: "RDRAND", opcount: 1, ops: []Op{{TN: "r32", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xc7, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x86, rm: 0}},
+		}},
+		{name: "RDRAND", opcount: 1, ops: []Op{{TN: "r64", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xc7, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x86, rm: 0}},
+		}},
+	}}},
+	{"RDSEED", Instruction{Summary: "Read Random SEED", Forms: []IForm{
+		{name: "RDSEED", opcount: 1, ops: []Op{{TN: "r16", Output: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xc7, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x87, rm: 0}},
+		}},
+		{name: "RDSEED", opcount: 1, ops: []Op{{TN: "r32", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xc7, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x87, rm: 0}},
+		}},
+		{name: "RDSEED", opcount: 1, ops: []Op{{TN: "r64", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xc7, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x87, rm: 0}},
+		}},
+	}}},
+	{"RDTSC", Instruction{Summary: "Read Time-Stamp Counter", Forms: []IForm{
+		{name: "RDTSC", opcount: 0, ops: []Op{{TN: "eax", Output: true, Implicit: true}, {TN: "edx", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x31, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"RDTSCP", Instruction{Summary: "Read Time-Stamp Counter and Processor ID", Forms: []IForm{
+		{name: "RDTSCP", opcount: 0, ops: []Op{{TN: "eax", Output: true, Implicit: true}, {TN: "ecx", Output: true, Implicit: true}, {TN: "edx", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x01, hasAddend: false, addend: 0}, &opcode{op: 0xf9, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"RET", Instruction{Summary: "Return from Procedure", Forms: []IForm{
+		{name: "RET", opcount: 0, ops: []Op{}, enc: [][]Encoder{
+			{&opcode{op: 0xc3, hasAddend: false, addend: 0}},
+		}},
+		{name: "RET", opcount: 1, ops: []Op{{TN: "imm16"}}, enc: [][]Encoder{
+			{&opcode{op: 0xc2, hasAddend: false, addend: 0}, &immediate{size: 2, value: 0}},
+		}},
+	}}},
+	{"ROL", Instruction{Summary: "Rotate Left", Forms: []IForm{
+		{name: "ROLB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd0, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "ROLB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc0, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ROLB", opcount: 1, ops: []Op{{TN: "r8", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd2, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "ROLW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "ROLW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ROLW", opcount: 1, ops: []Op{{TN: "r16", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "ROLL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "ROLL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ROLL", opcount: 1, ops: []Op{{TN: "r32", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "ROLQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "ROLQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ROLQ", opcount: 1, ops: []Op{{TN: "r64", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "ROLB", opcount: 2, ops: []Op{{TN: "m8", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd0, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+		{name: "ROLB", opcount: 2, ops: []Op{{TN: "m8", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc0, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ROLB", opcount: 1, ops: []Op{{TN: "m8", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd2, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+		{name: "ROLW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+		{name: "ROLW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ROLW", opcount: 1, ops: []Op{{TN: "m16", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+		{name: "ROLL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+		{name: "ROLL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ROLL", opcount: 1, ops: []Op{{TN: "m32", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+		{name: "ROLQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+		{name: "ROLQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "ROLQ", opcount: 1, ops: []Op{{TN: "m64", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+	}}},
+	{"ROR", Instruction{Summary: "Rotate Right", Forms: []IForm{
+		{name: "RORB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd0, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x81, rm: 0}},
+		}},
+		{name: "RORB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc0, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x81, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "RORB", opcount: 1, ops: []Op{{TN: "r8", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd2, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x81, rm: 0}},
+		}},
+		{name: "RORW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x81, rm: 0}},
+		}},
+		{name: "RORW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x81, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "RORW", opcount: 1, ops: []Op{{TN: "r16", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x81, rm: 0}},
+		}},
+		{name: "RORL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x81, rm: 0}},
+		}},
+		{name: "RORL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x81, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "RORL", opcount: 1, ops: []Op{{TN: "r32", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x81, rm: 0}},
+		}},
+		{name: "RORQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x81, rm: 0}},
+		}},
+		{name: "RORQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x81, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "RORQ", opcount: 1, ops: []Op{{TN: "r64", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x81, rm: 0}},
+		}},
+		{name: "RORB", opcount: 2, ops: []Op{{TN: "m8", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd0, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x81, rm: 0}},
+		}},
+		{name: "RORB", opcount: 2, ops: []Op{{TN: "m8", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc0, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x81, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "RORB", opcount: 1, ops: []Op{{TN: "m8", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd2, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x81, rm: 0}},
+		}},
+		{name: "RORW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x81, rm: 0}},
+		}},
+		{name: "RORW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x81, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "RORW", opcount: 1, ops: []Op{{TN: "m16", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x81, rm: 0}},
+		}},
+		{name: "RORL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x81, rm: 0}},
+		}},
+		{name: "RORL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x81, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "RORL", opcount: 1, ops: []Op{{TN: "m32", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x81, rm: 0}},
+		}},
+		{name: "RORQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x81, rm: 0}},
+		}},
+		{name: "RORQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x81, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "RORQ", opcount: 1, ops: []Op{{TN: "m64", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x81, rm: 0}},
+		}},
+	}}},
+	{"RORX", Instruction{Summary: "Rotate Right Logical Without Affecting Flags", Forms: []IForm{}}},
+	{"ROUNDPD", Instruction{Summary: "Round Packed Double Precision Floating-Point Values", Forms: []IForm{
+		{name: "ROUNDPD", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x09, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "ROUNDPD", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x09, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+	}}},
+	{"ROUNDPS", Instruction{Summary: "Round Packed Single Precision Floating-Point Values", Forms: []IForm{
+		{name: "ROUNDPS", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x08, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "ROUNDPS", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x08, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+	}}},
+	{"ROUNDSD", Instruction{Summary: "Round Scalar Double Precision Floating-Point Values", Forms: []IForm{
+		{name: "ROUNDSD", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x0b, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "ROUNDSD", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "m64"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x0b, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+	}}},
+	{"ROUNDSS", Instruction{Summary: "Round Scalar Single Precision Floating-Point Values", Forms: []IForm{
+		{name: "ROUNDSS", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x0a, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "ROUNDSS", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "m32"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0x0a, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+	}}},
+	{"RSQRTPS", Instruction{Summary: "Compute Reciprocals of Square Roots of Packed Single-Precision Floating-Point Values", Forms: []IForm{
+		{name: "RSQRTPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x52, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "RSQRTPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x52, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"RSQRTSS", Instruction{Summary: "Compute Reciprocal of Square Root of Scalar Single-Precision Floating-Point Value", Forms: []IForm{
+		{name: "RSQRTSS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x52, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "RSQRTSS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x52, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"SAL", Instruction{Summary: "Arithmetic Shift Left", Forms: []IForm{
+		{name: "SALB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd0, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}},
+		}},
+		{name: "SALB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc0, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SALB", opcount: 1, ops: []Op{{TN: "r8", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd2, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}},
+		}},
+		{name: "SALW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}},
+		}},
+		{name: "SALW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SALW", opcount: 1, ops: []Op{{TN: "r16", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}},
+		}},
+		{name: "SALL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}},
+		}},
+		{name: "SALL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SALL", opcount: 1, ops: []Op{{TN: "r32", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}},
+		}},
+		{name: "SALQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}},
+		}},
+		{name: "SALQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SALQ", opcount: 1, ops: []Op{{TN: "r64", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}},
+		}},
+		{name: "SALB", opcount: 2, ops: []Op{{TN: "m8", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd0, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x84, rm: 0}},
+		}},
+		{name: "SALB", opcount: 2, ops: []Op{{TN: "m8", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc0, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x84, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SALB", opcount: 1, ops: []Op{{TN: "m8", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd2, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x84, rm: 0}},
+		}},
+		{name: "SALW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x84, rm: 0}},
+		}},
+		{name: "SALW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x84, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SALW", opcount: 1, ops: []Op{{TN: "m16", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x84, rm: 0}},
+		}},
+		{name: "SALL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x84, rm: 0}},
+		}},
+		{name: "SALL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x84, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SALL", opcount: 1, ops: []Op{{TN: "m32", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x84, rm: 0}},
+		}},
+		{name: "SALQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x84, rm: 0}},
+		}},
+		{name: "SALQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x84, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SALQ", opcount: 1, ops: []Op{{TN: "m64", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x84, rm: 0}},
+		}},
+	}}},
+	{"SAR", Instruction{Summary: "Arithmetic Shift Right", Forms: []IForm{
+		{name: "SARB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd0, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x87, rm: 0}},
+		}},
+		{name: "SARB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc0, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x87, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SARB", opcount: 1, ops: []Op{{TN: "r8", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd2, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x87, rm: 0}},
+		}},
+		{name: "SARW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x87, rm: 0}},
+		}},
+		{name: "SARW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x87, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SARW", opcount: 1, ops: []Op{{TN: "r16", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x87, rm: 0}},
+		}},
+		{name: "SARL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x87, rm: 0}},
+		}},
+		{name: "SARL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x87, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SARL", opcount: 1, ops: []Op{{TN: "r32", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x87, rm: 0}},
+		}},
+		{name: "SARQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x87, rm: 0}},
+		}},
+		{name: "SARQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x87, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SARQ", opcount: 1, ops: []Op{{TN: "r64", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x87, rm: 0}},
+		}},
+		{name: "SARB", opcount: 2, ops: []Op{{TN: "m8", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd0, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x87, rm: 0}},
+		}},
+		{name: "SARB", opcount: 2, ops: []Op{{TN: "m8", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc0, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x87, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SARB", opcount: 1, ops: []Op{{TN: "m8", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd2, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x87, rm: 0}},
+		}},
+		{name: "SARW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x87, rm: 0}},
+		}},
+		{name: "SARW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x87, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SARW", opcount: 1, ops: []Op{{TN: "m16", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x87, rm: 0}},
+		}},
+		{name: "SARL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x87, rm: 0}},
+		}},
+		{name: "SARL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x87, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SARL", opcount: 1, ops: []Op{{TN: "m32", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x87, rm: 0}},
+		}},
+		{name: "SARQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x87, rm: 0}},
+		}},
+		{name: "SARQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x87, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SARQ", opcount: 1, ops: []Op{{TN: "m64", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x87, rm: 0}},
+		}},
+	}}},
+	{"SARX", Instruction{Summary: "Arithmetic Shift Right Without Affecting Flags", Forms: []IForm{}}},
+	{"SBB", Instruction{Summary: "Subtract with Borrow", Forms: []IForm{
+		{name: "SBBB", opcount: 1, ops: []Op{{TN: "al", Output: true, Implicit: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&opcode{op: 0x1c, hasAddend: false, addend: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SBBB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x80, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x83, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SBBB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "r8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x18, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x1a, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "SBBB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "m8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x1a, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "SBBW", opcount: 1, ops: []Op{{TN: "ax", Output: true, Implicit: true}, {TN: "imm16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &opcode{op: 0x1d, hasAddend: false, addend: 0}, &immediate{size: 2, value: 1}},
+		}},
+		{name: "SBBW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x83, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SBBW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "imm16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x83, rm: 0}, &immediate{size: 2, value: 1}},
+		}},
+		{name: "SBBW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x19, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x1b, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "SBBW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x1b, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "SBBL", opcount: 1, ops: []Op{{TN: "eax", Output: true, Implicit: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&opcode{op: 0x1d, hasAddend: false, addend: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "SBBL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x83, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SBBL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x83, rm: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "SBBL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x19, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x1b, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "SBBL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x1b, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "SBBQ", opcount: 1, ops: []Op{{TN: "rax", Output: true, Implicit: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x1d, hasAddend: false, addend: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "SBBQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x83, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SBBQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x83, rm: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "SBBQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x19, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x1b, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "SBBQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x1b, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "SBBB", opcount: 2, ops: []Op{{TN: "m8", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x80, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x83, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SBBB", opcount: 2, ops: []Op{{TN: "m8", Output: true}, {TN: "r8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x18, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "SBBW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x83, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SBBW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "imm16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x83, rm: 0}, &immediate{size: 2, value: 1}},
+		}},
+		{name: "SBBW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x19, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "SBBL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x83, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SBBL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x83, rm: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "SBBL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x19, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "SBBQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x83, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SBBQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x83, rm: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "SBBQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x19, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+	}}},
+	{"SETA", Instruction{Summary: "Set byte if above (CF == 0 and ZF == 0)", Forms: []IForm{
+		{name: "SETA", opcount: 1, ops: []Op{{TN: "r8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x97, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "SETA", opcount: 1, ops: []Op{{TN: "m8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x97, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+	}}},
+	{"SETAE", Instruction{Summary: "Set byte if above or equal (CF == 0)", Forms: []IForm{
+		{name: "SETAE", opcount: 1, ops: []Op{{TN: "r8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x93, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "SETAE", opcount: 1, ops: []Op{{TN: "m8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x93, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+	}}},
+	{"SETB", Instruction{Summary: "Set byte if below (CF == 1)", Forms: []IForm{
+		{name: "SETB", opcount: 1, ops: []Op{{TN: "r8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x92, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "SETB", opcount: 1, ops: []Op{{TN: "m8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x92, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+	}}},
+	{"SETBE", Instruction{Summary: "Set byte if below or equal (CF == 1 or ZF == 1)", Forms: []IForm{
+		{name: "SETBE", opcount: 1, ops: []Op{{TN: "r8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x96, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "SETBE", opcount: 1, ops: []Op{{TN: "m8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x96, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+	}}},
+	{"SETC", Instruction{Summary: "Set byte if carry (CF == 1)", Forms: []IForm{
+		{name: "SETC", opcount: 1, ops: []Op{{TN: "r8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x92, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "SETC", opcount: 1, ops: []Op{{TN: "m8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x92, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+	}}},
+	{"SETE", Instruction{Summary: "Set byte if equal (ZF == 1)", Forms: []IForm{
+		{name: "SETE", opcount: 1, ops: []Op{{TN: "r8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x94, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "SETE", opcount: 1, ops: []Op{{TN: "m8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x94, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+	}}},
+	{"SETG", Instruction{Summary: "Set byte if greater (ZF == 0 and SF == OF)", Forms: []IForm{
+		{name: "SETG", opcount: 1, ops: []Op{{TN: "r8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x9f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "SETG", opcount: 1, ops: []Op{{TN: "m8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x9f, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+	}}},
+	{"SETGE", Instruction{Summary: "Set byte if greater or equal (SF == OF)", Forms: []IForm{
+		{name: "SETGE", opcount: 1, ops: []Op{{TN: "r8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x9d, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "SETGE", opcount: 1, ops: []Op{{TN: "m8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x9d, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+	}}},
+	{"SETL", Instruction{Summary: "Set byte if less (SF != OF)", Forms: []IForm{
+		{name: "SETL", opcount: 1, ops: []Op{{TN: "r8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x9c, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "SETL", opcount: 1, ops: []Op{{TN: "m8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x9c, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+	}}},
+	{"SETLE", Instruction{Summary: "Set byte if less or equal (ZF == 1 or SF != OF)", Forms: []IForm{
+		{name: "SETLE", opcount: 1, ops: []Op{{TN: "r8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x9e, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "SETLE", opcount: 1, ops: []Op{{TN: "m8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x9e, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+	}}},
+	{"SETNA", Instruction{Summary: "Set byte if not above (CF == 1 or ZF == 1)", Forms: []IForm{
+		{name: "SETNA", opcount: 1, ops: []Op{{TN: "r8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x96, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "SETNA", opcount: 1, ops: []Op{{TN: "m8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x96, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+	}}},
+	{"SETNAE", Instruction{Summary: "Set byte if not above or equal (CF == 1)", Forms: []IForm{
+		{name: "SETNAE", opcount: 1, ops: []Op{{TN: "r8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x92, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "SETNAE", opcount: 1, ops: []Op{{TN: "m8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x92, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+	}}},
+	{"SETNB", Instruction{Summary: "Set byte if not below (CF == 0)", Forms: []IForm{
+		{name: "SETNB", opcount: 1, ops: []Op{{TN: "r8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x93, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "SETNB", opcount: 1, ops: []Op{{TN: "m8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x93, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+	}}},
+	{"SETNBE", Instruction{Summary: "Set byte if not below or equal (CF == 0 and ZF == 0)", Forms: []IForm{
+		{name: "SETNBE", opcount: 1, ops: []Op{{TN: "r8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x97, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "SETNBE", opcount: 1, ops: []Op{{TN: "m8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x97, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+	}}},
+	{"SETNC", Instruction{Summary: "Set byte if not carry (CF == 0)", Forms: []IForm{
+		{name: "SETNC", opcount: 1, ops: []Op{{TN: "r8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x93, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "SETNC", opcount: 1, ops: []Op{{TN: "m8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x93, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+	}}},
+	{"SETNE", Instruction{Summary: "Set byte if not equal (ZF == 0)", Forms: []IForm{
+		{name: "SETNE", opcount: 1, ops: []Op{{TN: "r8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x95, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "SETNE", opcount: 1, ops: []Op{{TN: "m8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x95, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+	}}},
+	{"SETNG", Instruction{Summary: "Set byte if not greater (ZF == 1 or SF != OF)", Forms: []IForm{
+		{name: "SETNG", opcount: 1, ops: []Op{{TN: "r8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x9e, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "SETNG", opcount: 1, ops: []Op{{TN: "m8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x9e, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+	}}},
+	{"SETNGE", Instruction{Summary: "Set byte if not greater or equal (SF != OF)", Forms: []IForm{
+		{name: "SETNGE", opcount: 1, ops: []Op{{TN: "r8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x9c, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "SETNGE", opcount: 1, ops: []Op{{TN: "m8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x9c, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+	}}},
+	{"SETNL", Instruction{Summary: "Set byte if not less (SF == OF)", Forms: []IForm{
+		{name: "SETNL", opcount: 1, ops: []Op{{TN: "r8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x9d, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "SETNL", opcount: 1, ops: []Op{{TN: "m8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x9d, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+	}}},
+	{"SETNLE", Instruction{Summary: "Set byte if not less or equal (ZF == 0 and SF == OF)", Forms: []IForm{
+		{name: "SETNLE", opcount: 1, ops: []Op{{TN: "r8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x9f, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "SETNLE", opcount: 1, ops: []Op{{TN: "m8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x9f, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+	}}},
+	{"SETNO", Instruction{Summary: "Set byte if not overflow (OF == 0)", Forms: []IForm{
+		{name: "SETNO", opcount: 1, ops: []Op{{TN: "r8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x91, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "SETNO", opcount: 1, ops: []Op{{TN: "m8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x91, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+	}}},
+	{"SETNP", Instruction{Summary: "Set byte if not parity (PF == 0)", Forms: []IForm{
+		{name: "SETNP", opcount: 1, ops: []Op{{TN: "r8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x9b, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "SETNP", opcount: 1, ops: []Op{{TN: "m8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x9b, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+	}}},
+	{"SETNS", Instruction{Summary: "Set byte if not sign (SF == 0)", Forms: []IForm{
+		{name: "SETNS", opcount: 1, ops: []Op{{TN: "r8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x99, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "SETNS", opcount: 1, ops: []Op{{TN: "m8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x99, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+	}}},
+	{"SETNZ", Instruction{Summary: "Set byte if not zero (ZF == 0)", Forms: []IForm{
+		{name: "SETNZ", opcount: 1, ops: []Op{{TN: "r8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x95, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "SETNZ", opcount: 1, ops: []Op{{TN: "m8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x95, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+	}}},
+	{"SETO", Instruction{Summary: "Set byte if overflow (OF == 1)", Forms: []IForm{
+		{name: "SETO", opcount: 1, ops: []Op{{TN: "r8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x90, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "SETO", opcount: 1, ops: []Op{{TN: "m8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x90, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+	}}},
+	{"SETP", Instruction{Summary: "Set byte if parity (PF == 1)", Forms: []IForm{
+		{name: "SETP", opcount: 1, ops: []Op{{TN: "r8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x9a, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "SETP", opcount: 1, ops: []Op{{TN: "m8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x9a, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+	}}},
+	{"SETPE", Instruction{Summary: "Set byte if parity even (PF == 1)", Forms: []IForm{
+		{name: "SETPE", opcount: 1, ops: []Op{{TN: "r8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x9a, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "SETPE", opcount: 1, ops: []Op{{TN: "m8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x9a, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+	}}},
+	{"SETPO", Instruction{Summary: "Set byte if parity odd (PF == 0)", Forms: []IForm{
+		{name: "SETPO", opcount: 1, ops: []Op{{TN: "r8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x9b, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "SETPO", opcount: 1, ops: []Op{{TN: "m8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x9b, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+	}}},
+	{"SETS", Instruction{Summary: "Set byte if sign (SF == 1)", Forms: []IForm{
+		{name: "SETS", opcount: 1, ops: []Op{{TN: "r8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x98, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "SETS", opcount: 1, ops: []Op{{TN: "m8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x98, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+	}}},
+	{"SETZ", Instruction{Summary: "Set byte if zero (ZF == 1)", Forms: []IForm{
+		{name: "SETZ", opcount: 1, ops: []Op{{TN: "r8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x94, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}},
+		}},
+		{name: "SETZ", opcount: 1, ops: []Op{{TN: "m8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x94, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}},
+		}},
+	}}},
+	{"SFENCE", Instruction{Summary: "Store Fence", Forms: []IForm{
+		{name: "SFENCE", opcount: 0, ops: []Op{}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xae, hasAddend: false, addend: 0}, &opcode{op: 0xf8, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"SHA1MSG1", Instruction{Summary: "Perform an Intermediate Calculation for the Next Four SHA1 Message Doublewords", Forms: []IForm{
+		{name: "SHA1MSG1", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xc9, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "SHA1MSG1", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xc9, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"SHA1MSG2", Instruction{Summary: "Perform a Final Calculation for the Next Four SHA1 Message Doublewords", Forms: []IForm{
+		{name: "SHA1MSG2", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xca, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "SHA1MSG2", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xca, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"SHA1NEXTE", Instruction{Summary: "Calculate SHA1 State Variable E after Four Rounds", Forms: []IForm{
+		{name: "SHA1NEXTE", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xc8, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "SHA1NEXTE", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xc8, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"SHA1RNDS4", Instruction{Summary: "Perform Four Rounds of SHA1 Operation", Forms: []IForm{
+		{name: "SHA1RNDS4", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0xcc, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "SHA1RNDS4", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x3a, hasAddend: false, addend: 0}, &opcode{op: 0xcc, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+	}}},
+	{"SHA256MSG1", Instruction{Summary: "Perform an Intermediate Calculation for the Next Four SHA256 Message Doublewords", Forms: []IForm{
+		{name: "SHA256MSG1", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xcc, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "SHA256MSG1", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xcc, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"SHA256MSG2", Instruction{Summary: "Perform a Final Calculation for the Next Four SHA256 Message Doublewords", Forms: []IForm{
+		{name: "SHA256MSG2", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xcd, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "SHA256MSG2", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xcd, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"SHA256RNDS2", Instruction{Summary: "Perform Two Rounds of SHA256 Operation", Forms: []IForm{
+		{name: "SHA256RNDS2", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}, {TN: "xmm0"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xcb, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "SHA256RNDS2", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}, {TN: "xmm0"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x38, hasAddend: false, addend: 0}, &opcode{op: 0xcb, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"SHL", Instruction{Summary: "Logical Shift Left", Forms: []IForm{
+		{name: "SHLB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd0, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}},
+		}},
+		{name: "SHLB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc0, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SHLB", opcount: 1, ops: []Op{{TN: "r8", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd2, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}},
+		}},
+		{name: "SHLW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}},
+		}},
+		{name: "SHLW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SHLW", opcount: 1, ops: []Op{{TN: "r16", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}},
+		}},
+		{name: "SHLL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}},
+		}},
+		{name: "SHLL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SHLL", opcount: 1, ops: []Op{{TN: "r32", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}},
+		}},
+		{name: "SHLQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}},
+		}},
+		{name: "SHLQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SHLQ", opcount: 1, ops: []Op{{TN: "r64", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x84, rm: 0}},
+		}},
+		{name: "SHLB", opcount: 2, ops: []Op{{TN: "m8", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd0, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x84, rm: 0}},
+		}},
+		{name: "SHLB", opcount: 2, ops: []Op{{TN: "m8", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc0, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x84, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SHLB", opcount: 1, ops: []Op{{TN: "m8", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd2, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x84, rm: 0}},
+		}},
+		{name: "SHLW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x84, rm: 0}},
+		}},
+		{name: "SHLW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x84, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SHLW", opcount: 1, ops: []Op{{TN: "m16", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x84, rm: 0}},
+		}},
+		{name: "SHLL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x84, rm: 0}},
+		}},
+		{name: "SHLL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x84, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SHLL", opcount: 1, ops: []Op{{TN: "m32", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x84, rm: 0}},
+		}},
+		{name: "SHLQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x84, rm: 0}},
+		}},
+		{name: "SHLQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x84, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SHLQ", opcount: 1, ops: []Op{{TN: "m64", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x84, rm: 0}},
+		}},
+	}}},
+	{"SHLD", Instruction{Summary: "Integer Double Precision Shift Left", Forms: []IForm{
+		{name: "SHLDW", opcount: 3, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xa4, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "SHLDW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xa5, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "SHLDL", opcount: 3, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xa4, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "SHLDL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xa5, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "SHLDQ", opcount: 3, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xa4, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "SHLDQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xa5, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "SHLDW", opcount: 3, ops: []Op{{TN: "m16", Output: true}, {TN: "r16"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xa4, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "SHLDW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "r16"}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xa5, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "SHLDL", opcount: 3, ops: []Op{{TN: "m32", Output: true}, {TN: "r32"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xa4, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "SHLDL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "r32"}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xa5, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "SHLDQ", opcount: 3, ops: []Op{{TN: "m64", Output: true}, {TN: "r64"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xa4, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "SHLDQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "r64"}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xa5, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+	}}},
+	{"SHLX", Instruction{Summary: "Logical Shift Left Without Affecting Flags", Forms: []IForm{}}},
+	{"SHR", Instruction{Summary: "Logical Shift Right", Forms: []IForm{
+		{name: "SHRB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd0, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x85, rm: 0}},
+		}},
+		{name: "SHRB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc0, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x85, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SHRB", opcount: 1, ops: []Op{{TN: "r8", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd2, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x85, rm: 0}},
+		}},
+		{name: "SHRW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x85, rm: 0}},
+		}},
+		{name: "SHRW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x85, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SHRW", opcount: 1, ops: []Op{{TN: "r16", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x85, rm: 0}},
+		}},
+		{name: "SHRL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x85, rm: 0}},
+		}},
+		{name: "SHRL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x85, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SHRL", opcount: 1, ops: []Op{{TN: "r32", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x85, rm: 0}},
+		}},
+		{name: "SHRQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x85, rm: 0}},
+		}},
+		{name: "SHRQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x85, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SHRQ", opcount: 1, ops: []Op{{TN: "r64", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x85, rm: 0}},
+		}},
+		{name: "SHRB", opcount: 2, ops: []Op{{TN: "m8", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd0, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x85, rm: 0}},
+		}},
+		{name: "SHRB", opcount: 2, ops: []Op{{TN: "m8", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc0, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x85, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SHRB", opcount: 1, ops: []Op{{TN: "m8", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd2, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x85, rm: 0}},
+		}},
+		{name: "SHRW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x85, rm: 0}},
+		}},
+		{name: "SHRW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x85, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SHRW", opcount: 1, ops: []Op{{TN: "m16", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x85, rm: 0}},
+		}},
+		{name: "SHRL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x85, rm: 0}},
+		}},
+		{name: "SHRL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x85, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SHRL", opcount: 1, ops: []Op{{TN: "m32", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x85, rm: 0}},
+		}},
+		{name: "SHRQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "1"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xd1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x85, rm: 0}},
+		}},
+		{name: "SHRQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x85, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SHRQ", opcount: 1, ops: []Op{{TN: "m64", Output: true}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xd3, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x85, rm: 0}},
+		}},
+	}}},
+	{"SHRD", Instruction{Summary: "Integer Double Precision Shift Right", Forms: []IForm{
+		{name: "SHRDW", opcount: 3, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xac, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "SHRDW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xad, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "SHRDL", opcount: 3, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xac, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "SHRDL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xad, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "SHRDQ", opcount: 3, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xac, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "SHRDQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xad, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "SHRDW", opcount: 3, ops: []Op{{TN: "m16", Output: true}, {TN: "r16"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xac, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "SHRDW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "r16"}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xad, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "SHRDL", opcount: 3, ops: []Op{{TN: "m32", Output: true}, {TN: "r32"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xac, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "SHRDL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "r32"}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xad, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "SHRDQ", opcount: 3, ops: []Op{{TN: "m64", Output: true}, {TN: "r64"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xac, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "SHRDQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "r64"}, {TN: "cl", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xad, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+	}}},
+	{"SHRX", Instruction{Summary: "Logical Shift Right Without Affecting Flags", Forms: []IForm{}}},
+	{"SHUFPD", Instruction{Summary: "Shuffle Packed Double-Precision Floating-Point Values", Forms: []IForm{
+		{name: "SHUFPD", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xc6, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "SHUFPD", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xc6, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+	}}},
+	{"SHUFPS", Instruction{Summary: "Shuffle Packed Single-Precision Floating-Point Values", Forms: []IForm{
+		{name: "SHUFPS", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xc6, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+		{name: "SHUFPS", opcount: 3, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xc6, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}, &immediate{size: 1, value: 2}},
+		}},
+	}}},
+	{"SQRTPD", Instruction{Summary: "Compute Square Roots of Packed Double-Precision Floating-Point Values", Forms: []IForm{
+		{name: "SQRTPD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x51, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "SQRTPD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x51, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"SQRTPS", Instruction{Summary: "Compute Square Roots of Packed Single-Precision Floating-Point Values", Forms: []IForm{
+		{name: "SQRTPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x51, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "SQRTPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x51, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"SQRTSD", Instruction{Summary: "Compute Square Root of Scalar Double-Precision Floating-Point Value", Forms: []IForm{
+		{name: "SQRTSD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x51, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "SQRTSD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x51, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"SQRTSS", Instruction{Summary: "Compute Square Root of Scalar Single-Precision Floating-Point Value", Forms: []IForm{
+		{name: "SQRTSS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x51, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "SQRTSS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x51, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"STC", Instruction{Summary: "Set Carry Flag", Forms: []IForm{
+		{name: "STC", opcount: 0, ops: []Op{}, enc: [][]Encoder{
+			{&opcode{op: 0xf9, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"STD", Instruction{Summary: "Set Direction Flag", Forms: []IForm{
+		{name: "STD", opcount: 0, ops: []Op{}, enc: [][]Encoder{
+			{&opcode{op: 0xfd, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"STMXCSR", Instruction{Summary: "Store MXCSR Register State", Forms: []IForm{
+		{name: "STMXCSR", opcount: 1, ops: []Op{{TN: "m32", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xae, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x83, rm: 0}},
+		}},
+	}}},
+	{"SUB", Instruction{Summary: "Subtract", Forms: []IForm{
+		{name: "SUBB", opcount: 1, ops: []Op{{TN: "al", Output: true, Implicit: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&opcode{op: 0x2c, hasAddend: false, addend: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SUBB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x80, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x85, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SUBB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "r8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x28, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x2a, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "SUBB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "m8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x2a, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "SUBW", opcount: 1, ops: []Op{{TN: "ax", Output: true, Implicit: true}, {TN: "imm16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &opcode{op: 0x2d, hasAddend: false, addend: 0}, &immediate{size: 2, value: 1}},
+		}},
+		{name: "SUBW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x85, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SUBW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "imm16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x85, rm: 0}, &immediate{size: 2, value: 1}},
+		}},
+		{name: "SUBW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x29, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x2b, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "SUBW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x2b, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "SUBL", opcount: 1, ops: []Op{{TN: "eax", Output: true, Implicit: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&opcode{op: 0x2d, hasAddend: false, addend: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "SUBL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x85, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SUBL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x85, rm: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "SUBL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x29, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x2b, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "SUBL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x2b, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "SUBQ", opcount: 1, ops: []Op{{TN: "rax", Output: true, Implicit: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x2d, hasAddend: false, addend: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "SUBQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x85, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SUBQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x85, rm: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "SUBQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x29, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x2b, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "SUBQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x2b, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "SUBB", opcount: 2, ops: []Op{{TN: "m8", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x80, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x85, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SUBB", opcount: 2, ops: []Op{{TN: "m8", Output: true}, {TN: "r8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x28, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "SUBW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x85, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SUBW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "imm16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x85, rm: 0}, &immediate{size: 2, value: 1}},
+		}},
+		{name: "SUBW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x29, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "SUBL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x85, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SUBL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x85, rm: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "SUBL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x29, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "SUBQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x85, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "SUBQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x85, rm: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "SUBQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x29, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+	}}},
+	{"SUBPD", Instruction{Summary: "Subtract Packed Double-Precision Floating-Point Values", Forms: []IForm{
+		{name: "SUBPD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5c, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "SUBPD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5c, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"SUBPS", Instruction{Summary: "Subtract Packed Single-Precision Floating-Point Values", Forms: []IForm{
+		{name: "SUBPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5c, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "SUBPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5c, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"SUBSD", Instruction{Summary: "Subtract Scalar Double-Precision Floating-Point Values", Forms: []IForm{
+		{name: "SUBSD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5c, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "SUBSD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf2}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5c, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"SUBSS", Instruction{Summary: "Subtract Scalar Single-Precision Floating-Point Values", Forms: []IForm{
+		{name: "SUBSS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5c, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "SUBSS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x5c, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"SYSCALL", Instruction{Summary: "Fast System Call", Forms: []IForm{
+		{name: "SYSCALL", opcount: 0, ops: []Op{{TN: "r11", Output: true, Implicit: true}, {TN: "rcx", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x05, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"T1MSKC", Instruction{Summary: "Inverse Mask From Trailing Ones", Forms: []IForm{}}},
+	{"TEST", Instruction{Summary: "Logical Compare", Forms: []IForm{
+		{name: "TESTB", opcount: 1, ops: []Op{{TN: "al", Implicit: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&opcode{op: 0xa8, hasAddend: false, addend: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "TESTB", opcount: 2, ops: []Op{{TN: "r8"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xf6, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "TESTB", opcount: 2, ops: []Op{{TN: "r8"}, {TN: "r8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x84, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "TESTW", opcount: 1, ops: []Op{{TN: "ax", Implicit: true}, {TN: "imm16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &opcode{op: 0xa9, hasAddend: false, addend: 0}, &immediate{size: 2, value: 1}},
+		}},
+		{name: "TESTW", opcount: 2, ops: []Op{{TN: "r16"}, {TN: "imm16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}, &immediate{size: 2, value: 1}},
+		}},
+		{name: "TESTW", opcount: 2, ops: []Op{{TN: "r16"}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x85, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "TESTL", opcount: 1, ops: []Op{{TN: "eax", Implicit: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&opcode{op: 0xa9, hasAddend: false, addend: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "TESTL", opcount: 2, ops: []Op{{TN: "r32"}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "TESTL", opcount: 2, ops: []Op{{TN: "r32"}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x85, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "TESTQ", opcount: 1, ops: []Op{{TN: "rax", Implicit: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xa9, hasAddend: false, addend: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "TESTQ", opcount: 2, ops: []Op{{TN: "r64"}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x80, rm: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "TESTQ", opcount: 2, ops: []Op{{TN: "r64"}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x85, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "TESTB", opcount: 2, ops: []Op{{TN: "m8"}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xf6, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "TESTB", opcount: 2, ops: []Op{{TN: "m8"}, {TN: "r8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x84, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "TESTW", opcount: 2, ops: []Op{{TN: "m16"}, {TN: "imm16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}, &immediate{size: 2, value: 1}},
+		}},
+		{name: "TESTW", opcount: 2, ops: []Op{{TN: "m16"}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x85, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "TESTL", opcount: 2, ops: []Op{{TN: "m32"}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "TESTL", opcount: 2, ops: []Op{{TN: "m32"}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x85, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "TESTQ", opcount: 2, ops: []Op{{TN: "m64"}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xf7, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x80, rm: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "TESTQ", opcount: 2, ops: []Op{{TN: "m64"}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x85, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+	}}},
+	{"TZCNT", Instruction{Summary: "Count the Number of Trailing Zero Bits", Forms: []IForm{
+		{name: "TZCNTW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xbc, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "TZCNTW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xbc, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "TZCNTL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xbc, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "TZCNTL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xbc, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "TZCNTQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xbc, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "TZCNTQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&prefix{b: 0xf3}, &rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xbc, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"TZMSK", Instruction{Summary: "Mask From Trailing Zeros", Forms: []IForm{}}},
+	{"UCOMISD", Instruction{Summary: "Unordered Compare Scalar Double-Precision Floating-Point Values and Set EFLAGS", Forms: []IForm{
+		{name: "UCOMISD", opcount: 2, ops: []Op{{TN: "xmm"}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2e, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "UCOMISD", opcount: 2, ops: []Op{{TN: "xmm"}, {TN: "m64"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2e, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"UCOMISS", Instruction{Summary: "Unordered Compare Scalar Single-Precision Floating-Point Values and Set EFLAGS", Forms: []IForm{
+		{name: "UCOMISS", opcount: 2, ops: []Op{{TN: "xmm"}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2e, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "UCOMISS", opcount: 2, ops: []Op{{TN: "xmm"}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x2e, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"UD2", Instruction{Summary: "Undefined Instruction", Forms: []IForm{
+		{name: "UD2", opcount: 0, ops: []Op{}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x0b, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"UNPCKHPD", Instruction{Summary: "Unpack and Interleave High Packed Double-Precision Floating-Point Values", Forms: []IForm{
+		{name: "UNPCKHPD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x15, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "UNPCKHPD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x15, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"UNPCKHPS", Instruction{Summary: "Unpack and Interleave High Packed Single-Precision Floating-Point Values", Forms: []IForm{
+		{name: "UNPCKHPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x15, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "UNPCKHPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x15, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"UNPCKLPD", Instruction{Summary: "Unpack and Interleave Low Packed Double-Precision Floating-Point Values", Forms: []IForm{
+		{name: "UNPCKLPD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x14, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "UNPCKLPD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x14, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"UNPCKLPS", Instruction{Summary: "Unpack and Interleave Low Packed Single-Precision Floating-Point Values", Forms: []IForm{
+		{name: "UNPCKLPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x14, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "UNPCKLPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x14, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"VADDPD", Instruction{Summary: "Add Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VADDPS", Instruction{Summary: "Add Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VADDSD", Instruction{Summary: "Add Scalar Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VADDSS", Instruction{Summary: "Add Scalar Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VADDSUBPD", Instruction{Summary: "Packed Double-FP Add/Subtract", Forms: []IForm{}}},
+	{"VADDSUBPS", Instruction{Summary: "Packed Single-FP Add/Subtract", Forms: []IForm{}}},
+	{"VAESDEC", Instruction{Summary: "Perform One Round of an AES Decryption Flow", Forms: []IForm{}}},
+	{"VAESDECLAST", Instruction{Summary: "Perform Last Round of an AES Decryption Flow", Forms: []IForm{}}},
+	{"VAESENC", Instruction{Summary: "Perform One Round of an AES Encryption Flow", Forms: []IForm{}}},
+	{"VAESENCLAST", Instruction{Summary: "Perform Last Round of an AES Encryption Flow", Forms: []IForm{}}},
+	{"VAESIMC", Instruction{Summary: "Perform the AES InvMixColumn Transformation", Forms: []IForm{}}},
+	{"VAESKEYGENASSIST", Instruction{Summary: "AES Round Key Generation Assist", Forms: []IForm{}}},
+	{"VALIGND", Instruction{Summary: "Align Doubleword Vectors", Forms: []IForm{}}},
+	{"VALIGNQ", Instruction{Summary: "Align Quadword Vectors", Forms: []IForm{}}},
+	{"VANDNPD", Instruction{Summary: "Bitwise Logical AND NOT of Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VANDNPS", Instruction{Summary: "Bitwise Logical AND NOT of Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VANDPD", Instruction{Summary: "Bitwise Logical AND of Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VANDPS", Instruction{Summary: "Bitwise Logical AND of Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VBLENDMPD", Instruction{Summary: "Blend Packed Double-Precision Floating-Point Vectors Using an OpMask Control", Forms: []IForm{}}},
+	{"VBLENDMPS", Instruction{Summary: "Blend Packed Single-Precision Floating-Point Vectors Using an OpMask Control", Forms: []IForm{}}},
+	{"VBLENDPD", Instruction{Summary: "Blend Packed Double Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VBLENDPS", Instruction{Summary: " Blend Packed Single Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VBLENDVPD", Instruction{Summary: " Variable Blend Packed Double Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VBLENDVPS", Instruction{Summary: " Variable Blend Packed Single Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VBROADCASTF128", Instruction{Summary: "Broadcast 128 Bit of Floating-Point Data", Forms: []IForm{}}},
+	{"VBROADCASTF32X2", Instruction{Summary: "Broadcast Two Single-Precision Floating-Point Elements", Forms: []IForm{}}},
+	{"VBROADCASTF32X4", Instruction{Summary: "Broadcast Four Single-Precision Floating-Point Elements", Forms: []IForm{}}},
+	{"VBROADCASTF32X8", Instruction{Summary: "Broadcast Eight Single-Precision Floating-Point Elements", Forms: []IForm{}}},
+	{"VBROADCASTF64X2", Instruction{Summary: "Broadcast Two Double-Precision Floating-Point Elements", Forms: []IForm{}}},
+	{"VBROADCASTF64X4", Instruction{Summary: "Broadcast Four Double-Precision Floating-Point Elements", Forms: []IForm{}}},
+	{"VBROADCASTI128", Instruction{Summary: "Broadcast 128 Bits of Integer Data", Forms: []IForm{}}},
+	{"VBROADCASTI32X2", Instruction{Summary: "Broadcast Two Doubleword Elements", Forms: []IForm{}}},
+	{"VBROADCASTI32X4", Instruction{Summary: "Broadcast Four Doubleword Elements", Forms: []IForm{}}},
+	{"VBROADCASTI32X8", Instruction{Summary: "Broadcast Eight Doubleword Elements", Forms: []IForm{}}},
+	{"VBROADCASTI64X2", Instruction{Summary: "Broadcast Two Quadword Elements", Forms: []IForm{}}},
+	{"VBROADCASTI64X4", Instruction{Summary: "Broadcast Four Quadword Elements", Forms: []IForm{}}},
+	{"VBROADCASTSD", Instruction{Summary: "Broadcast Double-Precision Floating-Point Element", Forms: []IForm{}}},
+	{"VBROADCASTSS", Instruction{Summary: "Broadcast Single-Precision Floating-Point Element", Forms: []IForm{}}},
+	{"VCMPPD", Instruction{Summary: "Compare Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VCMPPS", Instruction{Summary: "Compare Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VCMPSD", Instruction{Summary: "Compare Scalar Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VCMPSS", Instruction{Summary: "Compare Scalar Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VCOMISD", Instruction{Summary: "Compare Scalar Ordered Double-Precision Floating-Point Values and Set EFLAGS", Forms: []IForm{}}},
+	{"VCOMISS", Instruction{Summary: "Compare Scalar Ordered Single-Precision Floating-Point Values and Set EFLAGS", Forms: []IForm{}}},
+	{"VCOMPRESSPD", Instruction{Summary: "Store Sparse Packed Double-Precision Floating-Point Values into Dense Memory/Register", Forms: []IForm{}}},
+	{"VCOMPRESSPS", Instruction{Summary: "Store Sparse Packed Single-Precision Floating-Point Values into Dense Memory/Register", Forms: []IForm{}}},
+	{"VCVTDQ2PD", Instruction{Summary: "Convert Packed Dword Integers to Packed Double-Precision FP Values", Forms: []IForm{}}},
+	{"VCVTDQ2PS", Instruction{Summary: "Convert Packed Dword Integers to Packed Single-Precision FP Values", Forms: []IForm{}}},
+	{"VCVTPD2DQ", Instruction{Summary: "Convert Packed Double-Precision FP Values to Packed Dword Integers", Forms: []IForm{}}},
+	{"VCVTPD2PS", Instruction{Summary: "Convert Packed Double-Precision FP Values to Packed Single-Precision FP Values", Forms: []IForm{}}},
+	{"VCVTPD2QQ", Instruction{Summary: "Convert Packed Double-Precision Floating-Point Values to Packed Quadword Integers", Forms: []IForm{}}},
+	{"VCVTPD2UDQ", Instruction{Summary: "Convert Packed Double-Precision Floating-Point Values to Packed Unsigned Doubleword Integers", Forms: []IForm{}}},
+	{"VCVTPD2UQQ", Instruction{Summary: "Convert Packed Double-Precision Floating-Point Values to Packed Unsigned Quadword Integers", Forms: []IForm{}}},
+	{"VCVTPH2PS", Instruction{Summary: "Convert Half-Precision FP Values to Single-Precision FP Values", Forms: []IForm{}}},
+	{"VCVTPS2DQ", Instruction{Summary: "Convert Packed Single-Precision FP Values to Packed Dword Integers", Forms: []IForm{}}},
+	{"VCVTPS2PD", Instruction{Summary: "Convert Packed Single-Precision FP Values to Packed Double-Precision FP Values", Forms: []IForm{}}},
+	{"VCVTPS2PH", Instruction{Summary: "Convert Single-Precision FP value to Half-Precision FP value", Forms: []IForm{}}},
+	{"VCVTPS2QQ", Instruction{Summary: "Convert Packed Single Precision Floating-Point Values to Packed Singed Quadword Integer Values", Forms: []IForm{}}},
+	{"VCVTPS2UDQ", Instruction{Summary: "Convert Packed Single-Precision Floating-Point Values to Packed Unsigned Doubleword Integer Values", Forms: []IForm{}}},
+	{"VCVTPS2UQQ", Instruction{Summary: "Convert Packed Single Precision Floating-Point Values to Packed Unsigned Quadword Integer Values", Forms: []IForm{}}},
+	{"VCVTQQ2PD", Instruction{Summary: "Convert Packed Quadword Integers to Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VCVTQQ2PS", Instruction{Summary: "Convert Packed Quadword Integers to Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VCVTSD2SI", Instruction{Summary: "Convert Scalar Double-Precision FP Value to Integer", Forms: []IForm{}}},
+	{"VCVTSD2SS", Instruction{Summary: "Convert Scalar Double-Precision FP Value to Scalar Single-Precision FP Value", Forms: []IForm{}}},
+	{"VCVTSD2USI", Instruction{Summary: "Convert Scalar Double-Precision Floating-Point Value to Unsigned Doubleword Integer", Forms: []IForm{}}},
+	{"VCVTSI2SD", Instruction{Summary: "Convert Dword Integer to Scalar Double-Precision FP Value", Forms: []IForm{}}},
+	{"VCVTSI2SS", Instruction{Summary: "Convert Dword Integer to Scalar Single-Precision FP Value", Forms: []IForm{}}},
+	{"VCVTSS2SD", Instruction{Summary: "Convert Scalar Single-Precision FP Value to Scalar Double-Precision FP Value", Forms: []IForm{}}},
+	{"VCVTSS2SI", Instruction{Summary: "Convert Scalar Single-Precision FP Value to Dword Integer", Forms: []IForm{}}},
+	{"VCVTSS2USI", Instruction{Summary: "Convert Scalar Single-Precision Floating-Point Value to Unsigned Doubleword Integer", Forms: []IForm{}}},
+	{"VCVTTPD2DQ", Instruction{Summary: "Convert with Truncation Packed Double-Precision FP Values to Packed Dword Integers", Forms: []IForm{}}},
+	{"VCVTTPD2QQ", Instruction{Summary: "Convert with Truncation Packed Double-Precision Floating-Point Values to Packed Quadword Integers", Forms: []IForm{}}},
+	{"VCVTTPD2UDQ", Instruction{Summary: "Convert with Truncation Packed Double-Precision Floating-Point Values to Packed Unsigned Doubleword Integers", Forms: []IForm{}}},
+	{"VCVTTPD2UQQ", Instruction{Summary: "Convert with Truncation Packed Double-Precision Floating-Point Values to Packed Unsigned Quadword Integers", Forms: []IForm{}}},
+	{"VCVTTPS2DQ", Instruction{Summary: "Convert with Truncation Packed Single-Precision FP Values to Packed Dword Integers", Forms: []IForm{}}},
+	{"VCVTTPS2QQ", Instruction{Summary: "Convert with Truncation Packed Single Precision Floating-Point Values to Packed Singed Quadword Integer Values", Forms: []IForm{}}},
+	{"VCVTTPS2UDQ", Instruction{Summary: "Convert with Truncation Packed Single-Precision Floating-Point Values to Packed Unsigned Doubleword Integer Values", Forms: []IForm{}}},
+	{"VCVTTPS2UQQ", Instruction{Summary: "Convert with Truncation Packed Single Precision Floating-Point Values to Packed Unsigned Quadword Integer Values", Forms: []IForm{}}},
+	{"VCVTTSD2SI", Instruction{Summary: "Convert with Truncation Scalar Double-Precision FP Value to Signed Integer", Forms: []IForm{}}},
+	{"VCVTTSD2USI", Instruction{Summary: "Convert with Truncation Scalar Double-Precision Floating-Point Value to Unsigned Integer", Forms: []IForm{}}},
+	{"VCVTTSS2SI", Instruction{Summary: "Convert with Truncation Scalar Single-Precision FP Value to Dword Integer", Forms: []IForm{}}},
+	{"VCVTTSS2USI", Instruction{Summary: "Convert with Truncation Scalar Single-Precision Floating-Point Value to Unsigned Integer", Forms: []IForm{}}},
+	{"VCVTUDQ2PD", Instruction{Summary: "Convert Packed Unsigned Doubleword Integers to Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VCVTUDQ2PS", Instruction{Summary: "Convert Packed Unsigned Doubleword Integers to Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VCVTUQQ2PD", Instruction{Summary: "Convert Packed Unsigned Quadword Integers to Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VCVTUQQ2PS", Instruction{Summary: "Convert Packed Unsigned Quadword Integers to Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VCVTUSI2SD", Instruction{Summary: "Convert Unsigned Integer to Scalar Double-Precision Floating-Point Value", Forms: []IForm{}}},
+	{"VCVTUSI2SS", Instruction{Summary: "Convert Unsigned Integer to Scalar Single-Precision Floating-Point Value", Forms: []IForm{}}},
+	{"VDBPSADBW", Instruction{Summary: "Double Block Packed Sum-Absolute-Differences on Unsigned Bytes", Forms: []IForm{}}},
+	{"VDIVPD", Instruction{Summary: "Divide Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VDIVPS", Instruction{Summary: "Divide Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VDIVSD", Instruction{Summary: "Divide Scalar Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VDIVSS", Instruction{Summary: "Divide Scalar Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VDPPD", Instruction{Summary: "Dot Product of Packed Double Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VDPPS", Instruction{Summary: "Dot Product of Packed Single Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VEXP2PD", Instruction{Summary: "Approximation to the Exponential 2^x of Packed Double-Precision Floating-Point Values with Less Than 2^-23 Relative Error", Forms: []IForm{}}},
+	{"VEXP2PS", Instruction{Summary: "Approximation to the Exponential 2^x of Packed Single-Precision Floating-Point Values with Less Than 2^-23 Relative Error", Forms: []IForm{}}},
+	{"VEXPANDPD", Instruction{Summary: "Load Sparse Packed Double-Precision Floating-Point Values from Dense Memory", Forms: []IForm{}}},
+	{"VEXPANDPS", Instruction{Summary: "Load Sparse Packed Single-Precision Floating-Point Values from Dense Memory", Forms: []IForm{}}},
+	{"VEXTRACTF128", Instruction{Summary: "Extract Packed Floating-Point Values", Forms: []IForm{}}},
+	{"VEXTRACTF32X4", Instruction{Summary: "Extract 128 Bits of Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VEXTRACTF32X8", Instruction{Summary: "Extract 256 Bits of Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VEXTRACTF64X2", Instruction{Summary: "Extract 128 Bits of Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VEXTRACTF64X4", Instruction{Summary: "Extract 256 Bits of Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VEXTRACTI128", Instruction{Summary: "Extract Packed Integer Values", Forms: []IForm{}}},
+	{"VEXTRACTI32X4", Instruction{Summary: "Extract 128 Bits of Packed Doubleword Integer Values", Forms: []IForm{}}},
+	{"VEXTRACTI32X8", Instruction{Summary: "Extract 256 Bits of Packed Doubleword Integer Values", Forms: []IForm{}}},
+	{"VEXTRACTI64X2", Instruction{Summary: "Extract 128 Bits of Packed Quadword Integer Values", Forms: []IForm{}}},
+	{"VEXTRACTI64X4", Instruction{Summary: "Extract 256 Bits of Packed Quadword Integer Values", Forms: []IForm{}}},
+	{"VEXTRACTPS", Instruction{Summary: "Extract Packed Single Precision Floating-Point Value", Forms: []IForm{}}},
+	{"VFIXUPIMMPD", Instruction{Summary: "Fix Up Special Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFIXUPIMMPS", Instruction{Summary: "Fix Up Special Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFIXUPIMMSD", Instruction{Summary: "Fix Up Special Scalar Double-Precision Floating-Point Value", Forms: []IForm{}}},
+	{"VFIXUPIMMSS", Instruction{Summary: "Fix Up Special Scalar Single-Precision Floating-Point Value", Forms: []IForm{}}},
+	{"VFMADD132PD", Instruction{Summary: "Fused Multiply-Add of Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMADD132PS", Instruction{Summary: "Fused Multiply-Add of Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMADD132SD", Instruction{Summary: "Fused Multiply-Add of Scalar Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMADD132SS", Instruction{Summary: "Fused Multiply-Add of Scalar Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMADD213PD", Instruction{Summary: "Fused Multiply-Add of Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMADD213PS", Instruction{Summary: "Fused Multiply-Add of Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMADD213SD", Instruction{Summary: "Fused Multiply-Add of Scalar Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMADD213SS", Instruction{Summary: "Fused Multiply-Add of Scalar Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMADD231PD", Instruction{Summary: "Fused Multiply-Add of Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMADD231PS", Instruction{Summary: "Fused Multiply-Add of Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMADD231SD", Instruction{Summary: "Fused Multiply-Add of Scalar Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMADD231SS", Instruction{Summary: "Fused Multiply-Add of Scalar Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMADDPD", Instruction{Summary: "Fused Multiply-Add of Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMADDPS", Instruction{Summary: "Fused Multiply-Add of Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMADDSD", Instruction{Summary: "Fused Multiply-Add of Scalar Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMADDSS", Instruction{Summary: "Fused Multiply-Add of Scalar Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMADDSUB132PD", Instruction{Summary: "Fused Multiply-Alternating Add/Subtract of Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMADDSUB132PS", Instruction{Summary: "Fused Multiply-Alternating Add/Subtract of Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMADDSUB213PD", Instruction{Summary: "Fused Multiply-Alternating Add/Subtract of Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMADDSUB213PS", Instruction{Summary: "Fused Multiply-Alternating Add/Subtract of Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMADDSUB231PD", Instruction{Summary: "Fused Multiply-Alternating Add/Subtract of Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMADDSUB231PS", Instruction{Summary: "Fused Multiply-Alternating Add/Subtract of Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMADDSUBPD", Instruction{Summary: "Fused Multiply-Alternating Add/Subtract of Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMADDSUBPS", Instruction{Summary: "Fused Multiply-Alternating Add/Subtract of Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMSUB132PD", Instruction{Summary: "Fused Multiply-Subtract of Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMSUB132PS", Instruction{Summary: "Fused Multiply-Subtract of Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMSUB132SD", Instruction{Summary: "Fused Multiply-Subtract of Scalar Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMSUB132SS", Instruction{Summary: "Fused Multiply-Subtract of Scalar Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMSUB213PD", Instruction{Summary: "Fused Multiply-Subtract of Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMSUB213PS", Instruction{Summary: "Fused Multiply-Subtract of Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMSUB213SD", Instruction{Summary: "Fused Multiply-Subtract of Scalar Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMSUB213SS", Instruction{Summary: "Fused Multiply-Subtract of Scalar Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMSUB231PD", Instruction{Summary: "Fused Multiply-Subtract of Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMSUB231PS", Instruction{Summary: "Fused Multiply-Subtract of Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMSUB231SD", Instruction{Summary: "Fused Multiply-Subtract of Scalar Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMSUB231SS", Instruction{Summary: "Fused Multiply-Subtract of Scalar Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMSUBADD132PD", Instruction{Summary: "Fused Multiply-Alternating Subtract/Add of Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMSUBADD132PS", Instruction{Summary: "Fused Multiply-Alternating Subtract/Add of Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMSUBADD213PD", Instruction{Summary: "Fused Multiply-Alternating Subtract/Add of Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMSUBADD213PS", Instruction{Summary: "Fused Multiply-Alternating Subtract/Add of Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMSUBADD231PD", Instruction{Summary: "Fused Multiply-Alternating Subtract/Add of Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMSUBADD231PS", Instruction{Summary: "Fused Multiply-Alternating Subtract/Add of Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMSUBADDPD", Instruction{Summary: "Fused Multiply-Alternating Subtract/Add of Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMSUBADDPS", Instruction{Summary: "Fused Multiply-Alternating Subtract/Add of Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMSUBPD", Instruction{Summary: "Fused Multiply-Subtract of Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMSUBPS", Instruction{Summary: "Fused Multiply-Subtract of Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMSUBSD", Instruction{Summary: "Fused Multiply-Subtract of Scalar Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFMSUBSS", Instruction{Summary: "Fused Multiply-Subtract of Scalar Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFNMADD132PD", Instruction{Summary: "Fused Negative Multiply-Add of Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFNMADD132PS", Instruction{Summary: "Fused Negative Multiply-Add of Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFNMADD132SD", Instruction{Summary: "Fused Negative Multiply-Add of Scalar Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFNMADD132SS", Instruction{Summary: "Fused Negative Multiply-Add of Scalar Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFNMADD213PD", Instruction{Summary: "Fused Negative Multiply-Add of Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFNMADD213PS", Instruction{Summary: "Fused Negative Multiply-Add of Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFNMADD213SD", Instruction{Summary: "Fused Negative Multiply-Add of Scalar Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFNMADD213SS", Instruction{Summary: "Fused Negative Multiply-Add of Scalar Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFNMADD231PD", Instruction{Summary: "Fused Negative Multiply-Add of Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFNMADD231PS", Instruction{Summary: "Fused Negative Multiply-Add of Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFNMADD231SD", Instruction{Summary: "Fused Negative Multiply-Add of Scalar Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFNMADD231SS", Instruction{Summary: "Fused Negative Multiply-Add of Scalar Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFNMADDPD", Instruction{Summary: "Fused Negative Multiply-Add of Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFNMADDPS", Instruction{Summary: "Fused Negative Multiply-Add of Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFNMADDSD", Instruction{Summary: "Fused Negative Multiply-Add of Scalar Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFNMADDSS", Instruction{Summary: "Fused Negative Multiply-Add of Scalar Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFNMSUB132PD", Instruction{Summary: "Fused Negative Multiply-Subtract of Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFNMSUB132PS", Instruction{Summary: "Fused Negative Multiply-Subtract of Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFNMSUB132SD", Instruction{Summary: "Fused Negative Multiply-Subtract of Scalar Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFNMSUB132SS", Instruction{Summary: "Fused Negative Multiply-Subtract of Scalar Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFNMSUB213PD", Instruction{Summary: "Fused Negative Multiply-Subtract of Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFNMSUB213PS", Instruction{Summary: "Fused Negative Multiply-Subtract of Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFNMSUB213SD", Instruction{Summary: "Fused Negative Multiply-Subtract of Scalar Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFNMSUB213SS", Instruction{Summary: "Fused Negative Multiply-Subtract of Scalar Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFNMSUB231PD", Instruction{Summary: "Fused Negative Multiply-Subtract of Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFNMSUB231PS", Instruction{Summary: "Fused Negative Multiply-Subtract of Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFNMSUB231SD", Instruction{Summary: "Fused Negative Multiply-Subtract of Scalar Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFNMSUB231SS", Instruction{Summary: "Fused Negative Multiply-Subtract of Scalar Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFNMSUBPD", Instruction{Summary: "Fused Negative Multiply-Subtract of Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFNMSUBPS", Instruction{Summary: "Fused Negative Multiply-Subtract of Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFNMSUBSD", Instruction{Summary: "Fused Negative Multiply-Subtract of Scalar Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFNMSUBSS", Instruction{Summary: "Fused Negative Multiply-Subtract of Scalar Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFPCLASSPD", Instruction{Summary: "Test Class of Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFPCLASSPS", Instruction{Summary: "Test Class of Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VFPCLASSSD", Instruction{Summary: "Test Class of Scalar Double-Precision Floating-Point Value", Forms: []IForm{}}},
+	{"VFPCLASSSS", Instruction{Summary: "Test Class of Scalar Single-Precision Floating-Point Value", Forms: []IForm{}}},
+	{"VFRCZPD", Instruction{Summary: "Extract Fraction Packed Double-Precision Floating-Point", Forms: []IForm{}}},
+	{"VFRCZPS", Instruction{Summary: "Extract Fraction Packed Single-Precision Floating-Point", Forms: []IForm{}}},
+	{"VFRCZSD", Instruction{Summary: "Extract Fraction Scalar Double-Precision Floating-Point", Forms: []IForm{}}},
+	{"VFRCZSS", Instruction{Summary: "Extract Fraction Scalar Single-Precision Floating Point", Forms: []IForm{}}},
+	{"VGATHERDPD", Instruction{Summary: "Gather Packed Double-Precision Floating-Point Values Using Signed Doubleword Indices", Forms: []IForm{}}},
+	{"VGATHERDPS", Instruction{Summary: "Gather Packed Single-Precision Floating-Point Values Using Signed Doubleword Indices", Forms: []IForm{}}},
+	{"VGATHERPF0DPD", Instruction{Summary: "Sparse Prefetch Packed Double-Precision Floating-Point Data Values with Signed Doubleword Indices Using T0 Hint", Forms: []IForm{}}},
+	{"VGATHERPF0DPS", Instruction{Summary: "Sparse Prefetch Packed Single-Precision Floating-Point Data Values with Signed Doubleword Indices Using T0 Hint", Forms: []IForm{}}},
+	{"VGATHERPF0QPD", Instruction{Summary: "Sparse Prefetch Packed Double-Precision Floating-Point Data Values with Signed Quadword Indices Using T0 Hint", Forms: []IForm{}}},
+	{"VGATHERPF0QPS", Instruction{Summary: "Sparse Prefetch Packed Single-Precision Floating-Point Data Values with Signed Quadword Indices Using T0 Hint", Forms: []IForm{}}},
+	{"VGATHERPF1DPD", Instruction{Summary: "Sparse Prefetch Packed Double-Precision Floating-Point Data Values with Signed Doubleword Indices Using T1 Hint", Forms: []IForm{}}},
+	{"VGATHERPF1DPS", Instruction{Summary: "Sparse Prefetch Packed Single-Precision Floating-Point Data Values with Signed Doubleword Indices Using T1 Hint", Forms: []IForm{}}},
+	{"VGATHERPF1QPD", Instruction{Summary: "Sparse Prefetch Packed Double-Precision Floating-Point Data Values with Signed Quadword Indices Using T1 Hint", Forms: []IForm{}}},
+	{"VGATHERPF1QPS", Instruction{Summary: "Sparse Prefetch Packed Single-Precision Floating-Point Data Values with Signed Quadword Indices Using T1 Hint", Forms: []IForm{}}},
+	{"VGATHERQPD", Instruction{Summary: "Gather Packed Double-Precision Floating-Point Values Using Signed Quadword Indices", Forms: []IForm{}}},
+	{"VGATHERQPS", Instruction{Summary: "Gather Packed Single-Precision Floating-Point Values Using Signed Quadword Indices", Forms: []IForm{}}},
+	{"VGETEXPPD", Instruction{Summary: "Extract Exponents of Packed Double-Precision Floating-Point Values as Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VGETEXPPS", Instruction{Summary: "Extract Exponents of Packed Single-Precision Floating-Point Values as Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VGETEXPSD", Instruction{Summary: "Extract Exponent of Scalar Double-Precision Floating-Point Value as Double-Precision Floating-Point Value", Forms: []IForm{}}},
+	{"VGETEXPSS", Instruction{Summary: "Extract Exponent of Scalar Single-Precision Floating-Point Value as Single-Precision Floating-Point Value", Forms: []IForm{}}},
+	{"VGETMANTPD", Instruction{Summary: "Extract Normalized Mantissas from Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VGETMANTPS", Instruction{Summary: "Extract Normalized Mantissas from Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VGETMANTSD", Instruction{Summary: "Extract Normalized Mantissa from Scalar Double-Precision Floating-Point Value", Forms: []IForm{}}},
+	{"VGETMANTSS", Instruction{Summary: "Extract Normalized Mantissa from Scalar Single-Precision Floating-Point Value", Forms: []IForm{}}},
+	{"VHADDPD", Instruction{Summary: "Packed Double-FP Horizontal Add", Forms: []IForm{}}},
+	{"VHADDPS", Instruction{Summary: "Packed Single-FP Horizontal Add", Forms: []IForm{}}},
+	{"VHSUBPD", Instruction{Summary: "Packed Double-FP Horizontal Subtract", Forms: []IForm{}}},
+	{"VHSUBPS", Instruction{Summary: "Packed Single-FP Horizontal Subtract", Forms: []IForm{}}},
+	{"VINSERTF128", Instruction{Summary: "Insert Packed Floating-Point Values", Forms: []IForm{}}},
+	{"VINSERTF32X4", Instruction{Summary: "Insert 128 Bits of Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VINSERTF32X8", Instruction{Summary: "Insert 256 Bits of Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VINSERTF64X2", Instruction{Summary: "Insert 128 Bits of Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VINSERTF64X4", Instruction{Summary: "Insert 256 Bits of Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VINSERTI128", Instruction{Summary: "Insert Packed Integer Values", Forms: []IForm{}}},
+	{"VINSERTI32X4", Instruction{Summary: "Insert 128 Bits of Packed Doubleword Integer Values", Forms: []IForm{}}},
+	{"VINSERTI32X8", Instruction{Summary: "Insert 256 Bits of Packed Doubleword Integer Values", Forms: []IForm{}}},
+	{"VINSERTI64X2", Instruction{Summary: "Insert 128 Bits of Packed Quadword Integer Values", Forms: []IForm{}}},
+	{"VINSERTI64X4", Instruction{Summary: "Insert 256 Bits of Packed Quadword Integer Values", Forms: []IForm{}}},
+	{"VINSERTPS", Instruction{Summary: "Insert Packed Single Precision Floating-Point Value", Forms: []IForm{}}},
+	{"VLDDQU", Instruction{Summary: "Load Unaligned Integer 128 Bits", Forms: []IForm{}}},
+	{"VLDMXCSR", Instruction{Summary: "Load MXCSR Register", Forms: []IForm{}}},
+	{"VMASKMOVDQU", Instruction{Summary: "Store Selected Bytes of Double Quadword", Forms: []IForm{}}},
+	{"VMASKMOVPD", Instruction{Summary: "Conditional Move Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VMASKMOVPS", Instruction{Summary: "Conditional Move Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VMAXPD", Instruction{Summary: "Return Maximum Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VMAXPS", Instruction{Summary: "Return Maximum Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VMAXSD", Instruction{Summary: "Return Maximum Scalar Double-Precision Floating-Point Value", Forms: []IForm{}}},
+	{"VMAXSS", Instruction{Summary: "Return Maximum Scalar Single-Precision Floating-Point Value", Forms: []IForm{}}},
+	{"VMINPD", Instruction{Summary: "Return Minimum Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VMINPS", Instruction{Summary: "Return Minimum Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VMINSD", Instruction{Summary: "Return Minimum Scalar Double-Precision Floating-Point Value", Forms: []IForm{}}},
+	{"VMINSS", Instruction{Summary: "Return Minimum Scalar Single-Precision Floating-Point Value", Forms: []IForm{}}},
+	{"VMOVAPD", Instruction{Summary: "Move Aligned Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VMOVAPS", Instruction{Summary: "Move Aligned Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VMOVD", Instruction{Summary: "Move Doubleword", Forms: []IForm{}}},
+	{"VMOVDDUP", Instruction{Summary: "Move One Double-FP and Duplicate", Forms: []IForm{}}},
+	{"VMOVDQA", Instruction{Summary: "Move Aligned Double Quadword", Forms: []IForm{}}},
+	{"VMOVDQA32", Instruction{Summary: "Move Aligned Doubleword Values", Forms: []IForm{}}},
+	{"VMOVDQA64", Instruction{Summary: "Move Aligned Quadword Values", Forms: []IForm{}}},
+	{"VMOVDQU", Instruction{Summary: "Move Unaligned Double Quadword", Forms: []IForm{}}},
+	{"VMOVDQU16", Instruction{Summary: "Move Unaligned Word Values", Forms: []IForm{}}},
+	{"VMOVDQU32", Instruction{Summary: "Move Unaligned Doubleword Values", Forms: []IForm{}}},
+	{"VMOVDQU64", Instruction{Summary: "Move Unaligned Quadword Values", Forms: []IForm{}}},
+	{"VMOVDQU8", Instruction{Summary: "Move Unaligned Byte Values", Forms: []IForm{}}},
+	{"VMOVHLPS", Instruction{Summary: "Move Packed Single-Precision Floating-Point Values High to Low", Forms: []IForm{}}},
+	{"VMOVHPD", Instruction{Summary: "Move High Packed Double-Precision Floating-Point Value", Forms: []IForm{}}},
+	{"VMOVHPS", Instruction{Summary: "Move High Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VMOVLHPS", Instruction{Summary: "Move Packed Single-Precision Floating-Point Values Low to High", Forms: []IForm{}}},
+	{"VMOVLPD", Instruction{Summary: "Move Low Packed Double-Precision Floating-Point Value", Forms: []IForm{}}},
+	{"VMOVLPS", Instruction{Summary: "Move Low Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VMOVMSKPD", Instruction{Summary: "Extract Packed Double-Precision Floating-Point Sign Mask", Forms: []IForm{}}},
+	{"VMOVMSKPS", Instruction{Summary: "Extract Packed Single-Precision Floating-Point Sign Mask", Forms: []IForm{}}},
+	{"VMOVNTDQ", Instruction{Summary: "Store Double Quadword Using Non-Temporal Hint", Forms: []IForm{}}},
+	{"VMOVNTDQA", Instruction{Summary: "Load Double Quadword Non-Temporal Aligned Hint", Forms: []IForm{}}},
+	{"VMOVNTPD", Instruction{Summary: "Store Packed Double-Precision Floating-Point Values Using Non-Temporal Hint", Forms: []IForm{}}},
+	{"VMOVNTPS", Instruction{Summary: "Store Packed Single-Precision Floating-Point Values Using Non-Temporal Hint", Forms: []IForm{}}},
+	{"VMOVQ", Instruction{Summary: "Move Quadword", Forms: []IForm{}}},
+	{"VMOVSD", Instruction{Summary: "Move Scalar Double-Precision Floating-Point Value", Forms: []IForm{}}},
+	{"VMOVSHDUP", Instruction{Summary: "Move Packed Single-FP High and Duplicate", Forms: []IForm{}}},
+	{"VMOVSLDUP", Instruction{Summary: "Move Packed Single-FP Low and Duplicate", Forms: []IForm{}}},
+	{"VMOVSS", Instruction{Summary: "Move Scalar Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VMOVUPD", Instruction{Summary: "Move Unaligned Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VMOVUPS", Instruction{Summary: "Move Unaligned Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VMPSADBW", Instruction{Summary: "Compute Multiple Packed Sums of Absolute Difference", Forms: []IForm{}}},
+	{"VMULPD", Instruction{Summary: "Multiply Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VMULPS", Instruction{Summary: "Multiply Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VMULSD", Instruction{Summary: "Multiply Scalar Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VMULSS", Instruction{Summary: "Multiply Scalar Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VORPD", Instruction{Summary: "Bitwise Logical OR of Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VORPS", Instruction{Summary: "Bitwise Logical OR of Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VPABSB", Instruction{Summary: "Packed Absolute Value of Byte Integers", Forms: []IForm{}}},
+	{"VPABSD", Instruction{Summary: "Packed Absolute Value of Doubleword Integers", Forms: []IForm{}}},
+	{"VPABSQ", Instruction{Summary: "Packed Absolute Value of Quadword Integers", Forms: []IForm{}}},
+	{"VPABSW", Instruction{Summary: "Packed Absolute Value of Word Integers", Forms: []IForm{}}},
+	{"VPACKSSDW", Instruction{Summary: "Pack Doublewords into Words with Signed Saturation", Forms: []IForm{}}},
+	{"VPACKSSWB", Instruction{Summary: "Pack Words into Bytes with Signed Saturation", Forms: []IForm{}}},
+	{"VPACKUSDW", Instruction{Summary: "Pack Doublewords into Words with Unsigned Saturation", Forms: []IForm{}}},
+	{"VPACKUSWB", Instruction{Summary: "Pack Words into Bytes with Unsigned Saturation", Forms: []IForm{}}},
+	{"VPADDB", Instruction{Summary: "Add Packed Byte Integers", Forms: []IForm{}}},
+	{"VPADDD", Instruction{Summary: "Add Packed Doubleword Integers", Forms: []IForm{}}},
+	{"VPADDQ", Instruction{Summary: "Add Packed Quadword Integers", Forms: []IForm{}}},
+	{"VPADDSB", Instruction{Summary: "Add Packed Signed Byte Integers with Signed Saturation", Forms: []IForm{}}},
+	{"VPADDSW", Instruction{Summary: "Add Packed Signed Word Integers with Signed Saturation", Forms: []IForm{}}},
+	{"VPADDUSB", Instruction{Summary: "Add Packed Unsigned Byte Integers with Unsigned Saturation", Forms: []IForm{}}},
+	{"VPADDUSW", Instruction{Summary: "Add Packed Unsigned Word Integers with Unsigned Saturation", Forms: []IForm{}}},
+	{"VPADDW", Instruction{Summary: "Add Packed Word Integers", Forms: []IForm{}}},
+	{"VPALIGNR", Instruction{Summary: "Packed Align Right", Forms: []IForm{}}},
+	{"VPAND", Instruction{Summary: "Packed Bitwise Logical AND", Forms: []IForm{}}},
+	{"VPANDD", Instruction{Summary: "Bitwise Logical AND of Packed Doubleword Integers", Forms: []IForm{}}},
+	{"VPANDN", Instruction{Summary: "Packed Bitwise Logical AND NOT", Forms: []IForm{}}},
+	{"VPANDND", Instruction{Summary: "Bitwise Logical AND NOT of Packed Doubleword Integers", Forms: []IForm{}}},
+	{"VPANDNQ", Instruction{Summary: "Bitwise Logical AND NOT of Packed Quadword Integers", Forms: []IForm{}}},
+	{"VPANDQ", Instruction{Summary: "Bitwise Logical AND of Packed Quadword Integers", Forms: []IForm{}}},
+	{"VPAVGB", Instruction{Summary: "Average Packed Byte Integers", Forms: []IForm{}}},
+	{"VPAVGW", Instruction{Summary: "Average Packed Word Integers", Forms: []IForm{}}},
+	{"VPBLENDD", Instruction{Summary: "Blend Packed Doublewords", Forms: []IForm{}}},
+	{"VPBLENDMB", Instruction{Summary: "Blend Byte Vectors Using an OpMask Control", Forms: []IForm{}}},
+	{"VPBLENDMD", Instruction{Summary: "Blend Doubleword Vectors Using an OpMask Control", Forms: []IForm{}}},
+	{"VPBLENDMQ", Instruction{Summary: "Blend Quadword Vectors Using an OpMask Control", Forms: []IForm{}}},
+	{"VPBLENDMW", Instruction{Summary: "Blend Word Vectors Using an OpMask Control", Forms: []IForm{}}},
+	{"VPBLENDVB", Instruction{Summary: "Variable Blend Packed Bytes", Forms: []IForm{}}},
+	{"VPBLENDW", Instruction{Summary: "Blend Packed Words", Forms: []IForm{}}},
+	{"VPBROADCASTB", Instruction{Summary: "Broadcast Byte Integer", Forms: []IForm{}}},
+	{"VPBROADCASTD", Instruction{Summary: "Broadcast Doubleword Integer", Forms: []IForm{}}},
+	{"VPBROADCASTMB2Q", Instruction{Summary: "Broadcast Low Byte of Mask Register to Packed Quadword Values", Forms: []IForm{}}},
+	{"VPBROADCASTMW2D", Instruction{Summary: "Broadcast Low Word of Mask Register to Packed Doubleword Values", Forms: []IForm{}}},
+	{"VPBROADCASTQ", Instruction{Summary: "Broadcast Quadword Integer", Forms: []IForm{}}},
+	{"VPBROADCASTW", Instruction{Summary: "Broadcast Word Integer", Forms: []IForm{}}},
+	{"VPCLMULQDQ", Instruction{Summary: "Carry-Less Quadword Multiplication", Forms: []IForm{}}},
+	{"VPCMOV", Instruction{Summary: "Packed Conditional Move", Forms: []IForm{}}},
+	{"VPCMPB", Instruction{Summary: "Compare Packed Signed Byte Values", Forms: []IForm{}}},
+	{"VPCMPD", Instruction{Summary: "Compare Packed Signed Doubleword Values", Forms: []IForm{}}},
+	{"VPCMPEQB", Instruction{Summary: "Compare Packed Byte Data for Equality", Forms: []IForm{}}},
+	{"VPCMPEQD", Instruction{Summary: "Compare Packed Doubleword Data for Equality", Forms: []IForm{}}},
+	{"VPCMPEQQ", Instruction{Summary: "Compare Packed Quadword Data for Equality", Forms: []IForm{}}},
+	{"VPCMPEQW", Instruction{Summary: "Compare Packed Word Data for Equality", Forms: []IForm{}}},
+	{"VPCMPESTRI", Instruction{Summary: "Packed Compare Explicit Length Strings, Return Index", Forms: []IForm{}}},
+	{"VPCMPESTRM", Instruction{Summary: "Packed Compare Explicit Length Strings, Return Mask", Forms: []IForm{}}},
+	{"VPCMPGTB", Instruction{Summary: "Compare Packed Signed Byte Integers for Greater Than", Forms: []IForm{}}},
+	{"VPCMPGTD", Instruction{Summary: "Compare Packed Signed Doubleword Integers for Greater Than", Forms: []IForm{}}},
+	{"VPCMPGTQ", Instruction{Summary: "Compare Packed Data for Greater Than", Forms: []IForm{}}},
+	{"VPCMPGTW", Instruction{Summary: "Compare Packed Signed Word Integers for Greater Than", Forms: []IForm{}}},
+	{"VPCMPISTRI", Instruction{Summary: "Packed Compare Implicit Length Strings, Return Index", Forms: []IForm{}}},
+	{"VPCMPISTRM", Instruction{Summary: "Packed Compare Implicit Length Strings, Return Mask", Forms: []IForm{}}},
+	{"VPCMPQ", Instruction{Summary: "Compare Packed Signed Quadword Values", Forms: []IForm{}}},
+	{"VPCMPUB", Instruction{Summary: "Compare Packed Unsigned Byte Values", Forms: []IForm{}}},
+	{"VPCMPUD", Instruction{Summary: "Compare Packed Unsigned Doubleword Values", Forms: []IForm{}}},
+	{"VPCMPUQ", Instruction{Summary: "Compare Packed Unsigned Quadword Values", Forms: []IForm{}}},
+	{"VPCMPUW", Instruction{Summary: "Compare Packed Unsigned Word Values", Forms: []IForm{}}},
+	{"VPCMPW", Instruction{Summary: "Compare Packed Signed Word Values", Forms: []IForm{}}},
+	{"VPCOMB", Instruction{Summary: "Compare Packed Signed Byte Integers", Forms: []IForm{}}},
+	{"VPCOMD", Instruction{Summary: "Compare Packed Signed Doubleword Integers", Forms: []IForm{}}},
+	{"VPCOMPRESSD", Instruction{Summary: "Store Sparse Packed Doubleword Integer Values into Dense Memory/Register", Forms: []IForm{}}},
+	{"VPCOMPRESSQ", Instruction{Summary: "Store Sparse Packed Quadword Integer Values into Dense Memory/Register", Forms: []IForm{}}},
+	{"VPCOMQ", Instruction{Summary: "Compare Packed Signed Quadword Integers", Forms: []IForm{}}},
+	{"VPCOMUB", Instruction{Summary: "Compare Packed Unsigned Byte Integers", Forms: []IForm{}}},
+	{"VPCOMUD", Instruction{Summary: "Compare Packed Unsigned Doubleword Integers", Forms: []IForm{}}},
+	{"VPCOMUQ", Instruction{Summary: "Compare Packed Unsigned Quadword Integers", Forms: []IForm{}}},
+	{"VPCOMUW", Instruction{Summary: "Compare Packed Unsigned Word Integers", Forms: []IForm{}}},
+	{"VPCOMW", Instruction{Summary: "Compare Packed Signed Word Integers", Forms: []IForm{}}},
+	{"VPCONFLICTD", Instruction{Summary: "Detect Conflicts Within a Vector of Packed Doubleword Values into Dense Memory/Register", Forms: []IForm{}}},
+	{"VPCONFLICTQ", Instruction{Summary: "Detect Conflicts Within a Vector of Packed Quadword Values into Dense Memory/Register", Forms: []IForm{}}},
+	{"VPERM2F128", Instruction{Summary: "Permute Floating-Point Values", Forms: []IForm{}}},
+	{"VPERM2I128", Instruction{Summary: "Permute 128-Bit Integer Values", Forms: []IForm{}}},
+	{"VPERMB", Instruction{Summary: "Permute Byte Integers", Forms: []IForm{}}},
+	{"VPERMD", Instruction{Summary: "Permute Doubleword Integers", Forms: []IForm{}}},
+	{"VPERMI2B", Instruction{Summary: "Full Permute of Bytes From Two Tables Overwriting the Index", Forms: []IForm{}}},
+	{"VPERMI2D", Instruction{Summary: "Full Permute of Doublewords From Two Tables Overwriting the Index", Forms: []IForm{}}},
+	{"VPERMI2PD", Instruction{Summary: "Full Permute of Double-Precision Floating-Point Values From Two Tables Overwriting the Index", Forms: []IForm{}}},
+	{"VPERMI2PS", Instruction{Summary: "Full Permute of Single-Precision Floating-Point Values From Two Tables Overwriting the Index", Forms: []IForm{}}},
+	{"VPERMI2Q", Instruction{Summary: "Full Permute of Quadwords From Two Tables Overwriting the Index", Forms: []IForm{}}},
+	{"VPERMI2W", Instruction{Summary: "Full Permute of Words From Two Tables Overwriting the Index", Forms: []IForm{}}},
+	{"VPERMIL2PD", Instruction{Summary: "Permute Two-Source Double-Precision Floating-Point Vectors", Forms: []IForm{}}},
+	{"VPERMIL2PS", Instruction{Summary: "Permute Two-Source Single-Precision Floating-Point Vectors", Forms: []IForm{}}},
+	{"VPERMILPD", Instruction{Summary: "Permute Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VPERMILPS", Instruction{Summary: "Permute Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VPERMPD", Instruction{Summary: "Permute Double-Precision Floating-Point Elements", Forms: []IForm{}}},
+	{"VPERMPS", Instruction{Summary: "Permute Single-Precision Floating-Point Elements", Forms: []IForm{}}},
+	{"VPERMQ", Instruction{Summary: "Permute Quadword Integers", Forms: []IForm{}}},
+	{"VPERMT2B", Instruction{Summary: "Full Permute of Bytes From Two Tables Overwriting a Table", Forms: []IForm{}}},
+	{"VPERMT2D", Instruction{Summary: "Full Permute of Doublewords From Two Tables Overwriting a Table", Forms: []IForm{}}},
+	{"VPERMT2PD", Instruction{Summary: "Full Permute of Double-Precision Floating-Point Values From Two Tables Overwriting a Table", Forms: []IForm{}}},
+	{"VPERMT2PS", Instruction{Summary: "Full Permute of Single-Precision Floating-Point Values From Two Tables Overwriting a Table", Forms: []IForm{}}},
+	{"VPERMT2Q", Instruction{Summary: "Full Permute of Quadwords From Two Tables Overwriting a Table", Forms: []IForm{}}},
+	{"VPERMT2W", Instruction{Summary: "Full Permute of Words From Two Tables Overwriting a Table", Forms: []IForm{}}},
+	{"VPERMW", Instruction{Summary: "Permute Word Integers", Forms: []IForm{}}},
+	{"VPEXPANDD", Instruction{Summary: "Load Sparse Packed Doubleword Integer Values from Dense Memory/Register", Forms: []IForm{}}},
+	{"VPEXPANDQ", Instruction{Summary: "Load Sparse Packed Quadword Integer Values from Dense Memory/Register", Forms: []IForm{}}},
+	{"VPEXTRB", Instruction{Summary: "Extract Byte", Forms: []IForm{}}},
+	{"VPEXTRD", Instruction{Summary: "Extract Doubleword", Forms: []IForm{}}},
+	{"VPEXTRQ", Instruction{Summary: "Extract Quadword", Forms: []IForm{}}},
+	{"VPEXTRW", Instruction{Summary: "Extract Word", Forms: []IForm{}}},
+	{"VPGATHERDD", Instruction{Summary: "Gather Packed Doubleword Values Using Signed Doubleword Indices", Forms: []IForm{}}},
+	{"VPGATHERDQ", Instruction{Summary: "Gather Packed Quadword Values Using Signed Doubleword Indices", Forms: []IForm{}}},
+	{"VPGATHERQD", Instruction{Summary: "Gather Packed Doubleword Values Using Signed Quadword Indices", Forms: []IForm{}}},
+	{"VPGATHERQQ", Instruction{Summary: "Gather Packed Quadword Values Using Signed Quadword Indices", Forms: []IForm{}}},
+	{"VPHADDBD", Instruction{Summary: "Packed Horizontal Add Signed Byte to Signed Doubleword", Forms: []IForm{}}},
+	{"VPHADDBQ", Instruction{Summary: "Packed Horizontal Add Signed Byte to Signed Quadword", Forms: []IForm{}}},
+	{"VPHADDBW", Instruction{Summary: "Packed Horizontal Add Signed Byte to Signed Word", Forms: []IForm{}}},
+	{"VPHADDD", Instruction{Summary: "Packed Horizontal Add Doubleword Integer", Forms: []IForm{}}},
+	{"VPHADDDQ", Instruction{Summary: "Packed Horizontal Add Signed Doubleword to Signed Quadword", Forms: []IForm{}}},
+	{"VPHADDSW", Instruction{Summary: "Packed Horizontal Add Signed Word Integers with Signed Saturation", Forms: []IForm{}}},
+	{"VPHADDUBD", Instruction{Summary: "Packed Horizontal Add Unsigned Byte to Doubleword", Forms: []IForm{}}},
+	{"VPHADDUBQ", Instruction{Summary: "Packed Horizontal Add Unsigned Byte to Quadword", Forms: []IForm{}}},
+	{"VPHADDUBW", Instruction{Summary: "Packed Horizontal Add Unsigned Byte to Word", Forms: []IForm{}}},
+	{"VPHADDUDQ", Instruction{Summary: "Packed Horizontal Add Unsigned Doubleword to Quadword", Forms: []IForm{}}},
+	{"VPHADDUWD", Instruction{Summary: "Packed Horizontal Add Unsigned Word to Doubleword", Forms: []IForm{}}},
+	{"VPHADDUWQ", Instruction{Summary: "Packed Horizontal Add Unsigned Word to Quadword", Forms: []IForm{}}},
+	{"VPHADDW", Instruction{Summary: "Packed Horizontal Add Word Integers", Forms: []IForm{}}},
+	{"VPHADDWD", Instruction{Summary: "Packed Horizontal Add Signed Word to Signed Doubleword", Forms: []IForm{}}},
+	{"VPHADDWQ", Instruction{Summary: "Packed Horizontal Add Signed Word to Signed Quadword", Forms: []IForm{}}},
+	{"VPHMINPOSUW", Instruction{Summary: "Packed Horizontal Minimum of Unsigned Word Integers", Forms: []IForm{}}},
+	{"VPHSUBBW", Instruction{Summary: "Packed Horizontal Subtract Signed Byte to Signed Word", Forms: []IForm{}}},
+	{"VPHSUBD", Instruction{Summary: "Packed Horizontal Subtract Doubleword Integers", Forms: []IForm{}}},
+	{"VPHSUBDQ", Instruction{Summary: "Packed Horizontal Subtract Signed Doubleword to Signed Quadword", Forms: []IForm{}}},
+	{"VPHSUBSW", Instruction{Summary: "Packed Horizontal Subtract Signed Word Integers with Signed Saturation", Forms: []IForm{}}},
+	{"VPHSUBW", Instruction{Summary: "Packed Horizontal Subtract Word Integers", Forms: []IForm{}}},
+	{"VPHSUBWD", Instruction{Summary: "Packed Horizontal Subtract Signed Word to Signed Doubleword", Forms: []IForm{}}},
+	{"VPINSRB", Instruction{Summary: "Insert Byte", Forms: []IForm{}}},
+	{"VPINSRD", Instruction{Summary: "Insert Doubleword", Forms: []IForm{}}},
+	{"VPINSRQ", Instruction{Summary: "Insert Quadword", Forms: []IForm{}}},
+	{"VPINSRW", Instruction{Summary: "Insert Word", Forms: []IForm{}}},
+	{"VPLZCNTD", Instruction{Summary: "Count the Number of Leading Zero Bits for Packed Doubleword Values", Forms: []IForm{}}},
+	{"VPLZCNTQ", Instruction{Summary: "Count the Number of Leading Zero Bits for Packed Quadword Values", Forms: []IForm{}}},
+	{"VPMACSDD", Instruction{Summary: "Packed Multiply Accumulate Signed Doubleword to Signed Doubleword", Forms: []IForm{}}},
+	{"VPMACSDQH", Instruction{Summary: "Packed Multiply Accumulate Signed High Doubleword to Signed Quadword", Forms: []IForm{}}},
+	{"VPMACSDQL", Instruction{Summary: "Packed Multiply Accumulate Signed Low Doubleword to Signed Quadword", Forms: []IForm{}}},
+	{"VPMACSSDD", Instruction{Summary: "Packed Multiply Accumulate with Saturation Signed Doubleword to Signed Doubleword", Forms: []IForm{}}},
+	{"VPMACSSDQH", Instruction{Summary: "Packed Multiply Accumulate with Saturation Signed High Doubleword to Signed Quadword", Forms: []IForm{}}},
+	{"VPMACSSDQL", Instruction{Summary: "Packed Multiply Accumulate with Saturation Signed Low Doubleword to Signed Quadword", Forms: []IForm{}}},
+	{"VPMACSSWD", Instruction{Summary: "Packed Multiply Accumulate with Saturation Signed Word to Signed Doubleword", Forms: []IForm{}}},
+	{"VPMACSSWW", Instruction{Summary: "Packed Multiply Accumulate with Saturation Signed Word to Signed Word", Forms: []IForm{}}},
+	{"VPMACSWD", Instruction{Summary: "Packed Multiply Accumulate Signed Word to Signed Doubleword", Forms: []IForm{}}},
+	{"VPMACSWW", Instruction{Summary: "Packed Multiply Accumulate Signed Word to Signed Word", Forms: []IForm{}}},
+	{"VPMADCSSWD", Instruction{Summary: "Packed Multiply Add Accumulate with Saturation Signed Word to Signed Doubleword", Forms: []IForm{}}},
+	{"VPMADCSWD", Instruction{Summary: "Packed Multiply Add Accumulate Signed Word to Signed Doubleword", Forms: []IForm{}}},
+	{"VPMADD52HUQ", Instruction{Summary: "Packed Multiply of Unsigned 52-bit Unsigned Integers and Add High 52-bit Products to Quadword Accumulators", Forms: []IForm{}}},
+	{"VPMADD52LUQ", Instruction{Summary: "Packed Multiply of Unsigned 52-bit Integers and Add the Low 52-bit Products to Quadword Accumulators", Forms: []IForm{}}},
+	{"VPMADDUBSW", Instruction{Summary: "Multiply and Add Packed Signed and Unsigned Byte Integers", Forms: []IForm{}}},
+	{"VPMADDWD", Instruction{Summary: "Multiply and Add Packed Signed Word Integers", Forms: []IForm{}}},
+	{"VPMASKMOVD", Instruction{Summary: "Conditional Move Packed Doubleword Integers", Forms: []IForm{}}},
+	{"VPMASKMOVQ", Instruction{Summary: "Conditional Move Packed Quadword Integers", Forms: []IForm{}}},
+	{"VPMAXSB", Instruction{Summary: "Maximum of Packed Signed Byte Integers", Forms: []IForm{}}},
+	{"VPMAXSD", Instruction{Summary: "Maximum of Packed Signed Doubleword Integers", Forms: []IForm{}}},
+	{"VPMAXSQ", Instruction{Summary: "Maximum of Packed Signed Quadword Integers", Forms: []IForm{}}},
+	{"VPMAXSW", Instruction{Summary: "Maximum of Packed Signed Word Integers", Forms: []IForm{}}},
+	{"VPMAXUB", Instruction{Summary: "Maximum of Packed Unsigned Byte Integers", Forms: []IForm{}}},
+	{"VPMAXUD", Instruction{Summary: "Maximum of Packed Unsigned Doubleword Integers", Forms: []IForm{}}},
+	{"VPMAXUQ", Instruction{Summary: "Maximum of Packed Unsigned Quadword Integers", Forms: []IForm{}}},
+	{"VPMAXUW", Instruction{Summary: "Maximum of Packed Unsigned Word Integers", Forms: []IForm{}}},
+	{"VPMINSB", Instruction{Summary: "Minimum of Packed Signed Byte Integers", Forms: []IForm{}}},
+	{"VPMINSD", Instruction{Summary: "Minimum of Packed Signed Doubleword Integers", Forms: []IForm{}}},
+	{"VPMINSQ", Instruction{Summary: "Minimum of Packed Signed Quadword Integers", Forms: []IForm{}}},
+	{"VPMINSW", Instruction{Summary: "Minimum of Packed Signed Word Integers", Forms: []IForm{}}},
+	{"VPMINUB", Instruction{Summary: "Minimum of Packed Unsigned Byte Integers", Forms: []IForm{}}},
+	{"VPMINUD", Instruction{Summary: "Minimum of Packed Unsigned Doubleword Integers", Forms: []IForm{}}},
+	{"VPMINUQ", Instruction{Summary: "Minimum of Packed Unsigned Quadword Integers", Forms: []IForm{}}},
+	{"VPMINUW", Instruction{Summary: "Minimum of Packed Unsigned Word Integers", Forms: []IForm{}}},
+	{"VPMOVB2M", Instruction{Summary: "Move Signs of Packed Byte Integers to Mask Register", Forms: []IForm{}}},
+	{"VPMOVD2M", Instruction{Summary: "Move Signs of Packed Doubleword Integers to Mask Register", Forms: []IForm{}}},
+	{"VPMOVDB", Instruction{Summary: "Down Convert Packed Doubleword Values to Byte Values with Truncation", Forms: []IForm{}}},
+	{"VPMOVDW", Instruction{Summary: "Down Convert Packed Doubleword Values to Word Values with Truncation", Forms: []IForm{}}},
+	{"VPMOVM2B", Instruction{Summary: "Expand Bits of Mask Register to Packed Byte Integers", Forms: []IForm{}}},
+	{"VPMOVM2D", Instruction{Summary: "Expand Bits of Mask Register to Packed Doubleword Integers", Forms: []IForm{}}},
+	{"VPMOVM2Q", Instruction{Summary: "Expand Bits of Mask Register to Packed Quadword Integers", Forms: []IForm{}}},
+	{"VPMOVM2W", Instruction{Summary: "Expand Bits of Mask Register to Packed Word Integers", Forms: []IForm{}}},
+	{"VPMOVMSKB", Instruction{Summary: "Move Byte Mask", Forms: []IForm{}}},
+	{"VPMOVQ2M", Instruction{Summary: "Move Signs of Packed Quadword Integers to Mask Register", Forms: []IForm{}}},
+	{"VPMOVQB", Instruction{Summary: "Down Convert Packed Quadword Values to Byte Values with Truncation", Forms: []IForm{}}},
+	{"VPMOVQD", Instruction{Summary: "Down Convert Packed Quadword Values to Doubleword Values with Truncation", Forms: []IForm{}}},
+	{"VPMOVQW", Instruction{Summary: "Down Convert Packed Quadword Values to Word Values with Truncation", Forms: []IForm{}}},
+	{"VPMOVSDB", Instruction{Summary: "Down Convert Packed Doubleword Values to Byte Values with Signed Saturation", Forms: []IForm{}}},
+	{"VPMOVSDW", Instruction{Summary: "Down Convert Packed Doubleword Values to Word Values with Signed Saturation", Forms: []IForm{}}},
+	{"VPMOVSQB", Instruction{Summary: "Down Convert Packed Quadword Values to Byte Values with Signed Saturation", Forms: []IForm{}}},
+	{"VPMOVSQD", Instruction{Summary: "Down Convert Packed Quadword Values to Doubleword Values with Signed Saturation", Forms: []IForm{}}},
+	{"VPMOVSQW", Instruction{Summary: "Down Convert Packed Quadword Values to Word Values with Signed Saturation", Forms: []IForm{}}},
+	{"VPMOVSWB", Instruction{Summary: "Down Convert Packed Word Values to Byte Values with Signed Saturation", Forms: []IForm{}}},
+	{"VPMOVSXBD", Instruction{Summary: "Move Packed Byte Integers to Doubleword Integers with Sign Extension", Forms: []IForm{}}},
+	{"VPMOVSXBQ", Instruction{Summary: "Move Packed Byte Integers to Quadword Integers with Sign Extension", Forms: []IForm{}}},
+	{"VPMOVSXBW", Instruction{Summary: "Move Packed Byte Integers to Word Integers with Sign Extension", Forms: []IForm{}}},
+	{"VPMOVSXDQ", Instruction{Summary: "Move Packed Doubleword Integers to Quadword Integers with Sign Extension", Forms: []IForm{}}},
+	{"VPMOVSXWD", Instruction{Summary: "Move Packed Word Integers to Doubleword Integers with Sign Extension", Forms: []IForm{}}},
+	{"VPMOVSXWQ", Instruction{Summary: "Move Packed Word Integers to Quadword Integers with Sign Extension", Forms: []IForm{}}},
+	{"VPMOVUSDB", Instruction{Summary: "Down Convert Packed Doubleword Values to Byte Values with Unsigned Saturation", Forms: []IForm{}}},
+	{"VPMOVUSDW", Instruction{Summary: "Down Convert Packed Doubleword Values to Word Values with Unsigned Saturation", Forms: []IForm{}}},
+	{"VPMOVUSQB", Instruction{Summary: "Down Convert Packed Quadword Values to Byte Values with Unsigned Saturation", Forms: []IForm{}}},
+	{"VPMOVUSQD", Instruction{Summary: "Down Convert Packed Quadword Values to Doubleword Values with Unsigned Saturation", Forms: []IForm{}}},
+	{"VPMOVUSQW", Instruction{Summary: "Down Convert Packed Quadword Values to Word Values with Unsigned Saturation", Forms: []IForm{}}},
+	{"VPMOVUSWB", Instruction{Summary: "Down Convert Packed Word Values to Byte Values with Unsigned Saturation", Forms: []IForm{}}},
+	{"VPMOVW2M", Instruction{Summary: "Move Signs of Packed Word Integers to Mask Register", Forms: []IForm{}}},
+	{"VPMOVWB", Instruction{Summary: "Down Convert Packed Word Values to Byte Values with Truncation", Forms: []IForm{}}},
+	{"VPMOVZXBD", Instruction{Summary: "Move Packed Byte Integers to Doubleword Integers with Zero Extension", Forms: []IForm{}}},
+	{"VPMOVZXBQ", Instruction{Summary: "Move Packed Byte Integers to Quadword Integers with Zero Extension", Forms: []IForm{}}},
+	{"VPMOVZXBW", Instruction{Summary: "Move Packed Byte Integers to Word Integers with Zero Extension", Forms: []IForm{}}},
+	{"VPMOVZXDQ", Instruction{Summary: "Move Packed Doubleword Integers to Quadword Integers with Zero Extension", Forms: []IForm{}}},
+	{"VPMOVZXWD", Instruction{Summary: "Move Packed Word Integers to Doubleword Integers with Zero Extension", Forms: []IForm{}}},
+	{"VPMOVZXWQ", Instruction{Summary: "Move Packed Word Integers to Quadword Integers with Zero Extension", Forms: []IForm{}}},
+	{"VPMULDQ", Instruction{Summary: "Multiply Packed Signed Doubleword Integers and Store Quadword Result", Forms: []IForm{}}},
+	{"VPMULHRSW", Instruction{Summary: "Packed Multiply Signed Word Integers and Store High Result with Round and Scale", Forms: []IForm{}}},
+	{"VPMULHUW", Instruction{Summary: "Multiply Packed Unsigned Word Integers and Store High Result", Forms: []IForm{}}},
+	{"VPMULHW", Instruction{Summary: "Multiply Packed Signed Word Integers and Store High Result", Forms: []IForm{}}},
+	{"VPMULLD", Instruction{Summary: "Multiply Packed Signed Doubleword Integers and Store Low Result", Forms: []IForm{}}},
+	{"VPMULLQ", Instruction{Summary: "Multiply Packed Signed Quadword Integers and Store Low Result", Forms: []IForm{}}},
+	{"VPMULLW", Instruction{Summary: "Multiply Packed Signed Word Integers and Store Low Result", Forms: []IForm{}}},
+	{"VPMULTISHIFTQB", Instruction{Summary: "Select Packed Unaligned Bytes from Quadword Sources", Forms: []IForm{}}},
+	{"VPMULUDQ", Instruction{Summary: "Multiply Packed Unsigned Doubleword Integers", Forms: []IForm{}}},
+	{"VPOPCNTD", Instruction{Summary: "Packed Population Count for Doubleword Integers", Forms: []IForm{}}},
+	{"VPOPCNTQ", Instruction{Summary: "Packed Population Count for Quadword Integers", Forms: []IForm{}}},
+	{"VPOR", Instruction{Summary: "Packed Bitwise Logical OR", Forms: []IForm{}}},
+	{"VPORD", Instruction{Summary: "Bitwise Logical OR of Packed Doubleword Integers", Forms: []IForm{}}},
+	{"VPORQ", Instruction{Summary: "Bitwise Logical OR of Packed Quadword Integers", Forms: []IForm{}}},
+	{"VPPERM", Instruction{Summary: "Packed Permute Bytes", Forms: []IForm{}}},
+	{"VPROLD", Instruction{Summary: "Rotate Packed Doubleword Left", Forms: []IForm{}}},
+	{"VPROLQ", Instruction{Summary: "Rotate Packed Quadword Left", Forms: []IForm{}}},
+	{"VPROLVD", Instruction{Summary: "Variable Rotate Packed Doubleword Left", Forms: []IForm{}}},
+	{"VPROLVQ", Instruction{Summary: "Variable Rotate Packed Quadword Left", Forms: []IForm{}}},
+	{"VPRORD", Instruction{Summary: "Rotate Packed Doubleword Right", Forms: []IForm{}}},
+	{"VPRORQ", Instruction{Summary: "Rotate Packed Quadword Right", Forms: []IForm{}}},
+	{"VPRORVD", Instruction{Summary: "Variable Rotate Packed Doubleword Right", Forms: []IForm{}}},
+	{"VPRORVQ", Instruction{Summary: "Variable Rotate Packed Quadword Right", Forms: []IForm{}}},
+	{"VPROTB", Instruction{Summary: "Packed Rotate Bytes", Forms: []IForm{}}},
+	{"VPROTD", Instruction{Summary: "Packed Rotate Doublewords", Forms: []IForm{}}},
+	{"VPROTQ", Instruction{Summary: "Packed Rotate Quadwords", Forms: []IForm{}}},
+	{"VPROTW", Instruction{Summary: "Packed Rotate Words", Forms: []IForm{}}},
+	{"VPSADBW", Instruction{Summary: "Compute Sum of Absolute Differences", Forms: []IForm{}}},
+	{"VPSCATTERDD", Instruction{Summary: "Scatter Packed Doubleword Values with Signed Doubleword Indices", Forms: []IForm{}}},
+	{"VPSCATTERDQ", Instruction{Summary: "Scatter Packed Quadword Values with Signed Doubleword Indices", Forms: []IForm{}}},
+	{"VPSCATTERQD", Instruction{Summary: "Scatter Packed Doubleword Values with Signed Quadword Indices", Forms: []IForm{}}},
+	{"VPSCATTERQQ", Instruction{Summary: "Scatter Packed Quadword Values with Signed Quadword Indices", Forms: []IForm{}}},
+	{"VPSHAB", Instruction{Summary: "Packed Shift Arithmetic Bytes", Forms: []IForm{}}},
+	{"VPSHAD", Instruction{Summary: "Packed Shift Arithmetic Doublewords", Forms: []IForm{}}},
+	{"VPSHAQ", Instruction{Summary: "Packed Shift Arithmetic Quadwords", Forms: []IForm{}}},
+	{"VPSHAW", Instruction{Summary: "Packed Shift Arithmetic Words", Forms: []IForm{}}},
+	{"VPSHLB", Instruction{Summary: "Packed Shift Logical Bytes", Forms: []IForm{}}},
+	{"VPSHLD", Instruction{Summary: "Packed Shift Logical Doublewords", Forms: []IForm{}}},
+	{"VPSHLQ", Instruction{Summary: "Packed Shift Logical Quadwords", Forms: []IForm{}}},
+	{"VPSHLW", Instruction{Summary: "Packed Shift Logical Words", Forms: []IForm{}}},
+	{"VPSHUFB", Instruction{Summary: "Packed Shuffle Bytes", Forms: []IForm{}}},
+	{"VPSHUFD", Instruction{Summary: "Shuffle Packed Doublewords", Forms: []IForm{}}},
+	{"VPSHUFHW", Instruction{Summary: "Shuffle Packed High Words", Forms: []IForm{}}},
+	{"VPSHUFLW", Instruction{Summary: "Shuffle Packed Low Words", Forms: []IForm{}}},
+	{"VPSIGNB", Instruction{Summary: "Packed Sign of Byte Integers", Forms: []IForm{}}},
+	{"VPSIGND", Instruction{Summary: "Packed Sign of Doubleword Integers", Forms: []IForm{}}},
+	{"VPSIGNW", Instruction{Summary: "Packed Sign of Word Integers", Forms: []IForm{}}},
+	{"VPSLLD", Instruction{Summary: "Shift Packed Doubleword Data Left Logical", Forms: []IForm{}}},
+	{"VPSLLDQ", Instruction{Summary: "Shift Packed Double Quadword Left Logical", Forms: []IForm{}}},
+	{"VPSLLQ", Instruction{Summary: "Shift Packed Quadword Data Left Logical", Forms: []IForm{}}},
+	{"VPSLLVD", Instruction{Summary: "Variable Shift Packed Doubleword Data Left Logical", Forms: []IForm{}}},
+	{"VPSLLVQ", Instruction{Summary: "Variable Shift Packed Quadword Data Left Logical", Forms: []IForm{}}},
+	{"VPSLLVW", Instruction{Summary: "Variable Shift Packed Word Data Left Logical", Forms: []IForm{}}},
+	{"VPSLLW", Instruction{Summary: "Shift Packed Word Data Left Logical", Forms: []IForm{}}},
+	{"VPSRAD", Instruction{Summary: "Shift Packed Doubleword Data Right Arithmetic", Forms: []IForm{}}},
+	{"VPSRAQ", Instruction{Summary: "Shift Packed Quadword Data Right Arithmetic", Forms: []IForm{}}},
+	{"VPSRAVD", Instruction{Summary: "Variable Shift Packed Doubleword Data Right Arithmetic", Forms: []IForm{}}},
+	{"VPSRAVQ", Instruction{Summary: "Variable Shift Packed Quadword Data Right Arithmetic", Forms: []IForm{}}},
+	{"VPSRAVW", Instruction{Summary: "Variable Shift Packed Word Data Right Arithmetic", Forms: []IForm{}}},
+	{"VPSRAW", Instruction{Summary: "Shift Packed Word Data Right Arithmetic", Forms: []IForm{}}},
+	{"VPSRLD", Instruction{Summary: "Shift Packed Doubleword Data Right Logical", Forms: []IForm{}}},
+	{"VPSRLDQ", Instruction{Summary: "Shift Packed Double Quadword Right Logical", Forms: []IForm{}}},
+	{"VPSRLQ", Instruction{Summary: "Shift Packed Quadword Data Right Logical", Forms: []IForm{}}},
+	{"VPSRLVD", Instruction{Summary: "Variable Shift Packed Doubleword Data Right Logical", Forms: []IForm{}}},
+	{"VPSRLVQ", Instruction{Summary: "Variable Shift Packed Quadword Data Right Logical", Forms: []IForm{}}},
+	{"VPSRLVW", Instruction{Summary: "Variable Shift Packed Word Data Right Logical", Forms: []IForm{}}},
+	{"VPSRLW", Instruction{Summary: "Shift Packed Word Data Right Logical", Forms: []IForm{}}},
+	{"VPSUBB", Instruction{Summary: "Subtract Packed Byte Integers", Forms: []IForm{}}},
+	{"VPSUBD", Instruction{Summary: "Subtract Packed Doubleword Integers", Forms: []IForm{}}},
+	{"VPSUBQ", Instruction{Summary: "Subtract Packed Quadword Integers", Forms: []IForm{}}},
+	{"VPSUBSB", Instruction{Summary: "Subtract Packed Signed Byte Integers with Signed Saturation", Forms: []IForm{}}},
+	{"VPSUBSW", Instruction{Summary: "Subtract Packed Signed Word Integers with Signed Saturation", Forms: []IForm{}}},
+	{"VPSUBUSB", Instruction{Summary: "Subtract Packed Unsigned Byte Integers with Unsigned Saturation", Forms: []IForm{}}},
+	{"VPSUBUSW", Instruction{Summary: "Subtract Packed Unsigned Word Integers with Unsigned Saturation", Forms: []IForm{}}},
+	{"VPSUBW", Instruction{Summary: "Subtract Packed Word Integers", Forms: []IForm{}}},
+	{"VPTERNLOGD", Instruction{Summary: "Bitwise Ternary Logical Operation on Doubleword Values", Forms: []IForm{}}},
+	{"VPTERNLOGQ", Instruction{Summary: "Bitwise Ternary Logical Operation on Quadword Values", Forms: []IForm{}}},
+	{"VPTEST", Instruction{Summary: "Packed Logical Compare", Forms: []IForm{}}},
+	{"VPTESTMB", Instruction{Summary: "Logical AND of Packed Byte Integer Values and Set Mask", Forms: []IForm{}}},
+	{"VPTESTMD", Instruction{Summary: "Logical AND of Packed Doubleword Integer Values and Set Mask", Forms: []IForm{}}},
+	{"VPTESTMQ", Instruction{Summary: "Logical AND of Packed Quadword Integer Values and Set Mask", Forms: []IForm{}}},
+	{"VPTESTMW", Instruction{Summary: "Logical AND of Packed Word Integer Values and Set Mask", Forms: []IForm{}}},
+	{"VPTESTNMB", Instruction{Summary: "Logical NAND of Packed Byte Integer Values and Set Mask", Forms: []IForm{}}},
+	{"VPTESTNMD", Instruction{Summary: "Logical NAND of Packed Doubleword Integer Values and Set Mask", Forms: []IForm{}}},
+	{"VPTESTNMQ", Instruction{Summary: "Logical NAND of Packed Quadword Integer Values and Set Mask", Forms: []IForm{}}},
+	{"VPTESTNMW", Instruction{Summary: "Logical NAND of Packed Word Integer Values and Set Mask", Forms: []IForm{}}},
+	{"VPUNPCKHBW", Instruction{Summary: "Unpack and Interleave High-Order Bytes into Words", Forms: []IForm{}}},
+	{"VPUNPCKHDQ", Instruction{Summary: "Unpack and Interleave High-Order Doublewords into Quadwords", Forms: []IForm{}}},
+	{"VPUNPCKHQDQ", Instruction{Summary: "Unpack and Interleave High-Order Quadwords into Double Quadwords", Forms: []IForm{}}},
+	{"VPUNPCKHWD", Instruction{Summary: "Unpack and Interleave High-Order Words into Doublewords", Forms: []IForm{}}},
+	{"VPUNPCKLBW", Instruction{Summary: "Unpack and Interleave Low-Order Bytes into Words", Forms: []IForm{}}},
+	{"VPUNPCKLDQ", Instruction{Summary: "Unpack and Interleave Low-Order Doublewords into Quadwords", Forms: []IForm{}}},
+	{"VPUNPCKLQDQ", Instruction{Summary: "Unpack and Interleave Low-Order Quadwords into Double Quadwords", Forms: []IForm{}}},
+	{"VPUNPCKLWD", Instruction{Summary: "Unpack and Interleave Low-Order Words into Doublewords", Forms: []IForm{}}},
+	{"VPXOR", Instruction{Summary: "Packed Bitwise Logical Exclusive OR", Forms: []IForm{}}},
+	{"VPXORD", Instruction{Summary: "Bitwise Logical Exclusive OR of Packed Doubleword Integers", Forms: []IForm{}}},
+	{"VPXORQ", Instruction{Summary: "Bitwise Logical Exclusive OR of Packed Quadword Integers", Forms: []IForm{}}},
+	{"VRANGEPD", Instruction{Summary: "Range Restriction Calculation For Packed Pairs of Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VRANGEPS", Instruction{Summary: "Range Restriction Calculation For Packed Pairs of Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VRANGESD", Instruction{Summary: "Range Restriction Calculation For a pair of Scalar Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VRANGESS", Instruction{Summary: "Range Restriction Calculation For a pair of Scalar Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VRCP14PD", Instruction{Summary: "Compute Approximate Reciprocals of Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VRCP14PS", Instruction{Summary: "Compute Approximate Reciprocals of Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VRCP14SD", Instruction{Summary: "Compute Approximate Reciprocal of a Scalar Double-Precision Floating-Point Value", Forms: []IForm{}}},
+	{"VRCP14SS", Instruction{Summary: "Compute Approximate Reciprocal of a Scalar Single-Precision Floating-Point Value", Forms: []IForm{}}},
+	{"VRCP28PD", Instruction{Summary: "Approximation to the Reciprocal of Packed Double-Precision Floating-Point Values with Less Than 2^-28 Relative Error", Forms: []IForm{}}},
+	{"VRCP28PS", Instruction{Summary: "Approximation to the Reciprocal of Packed Single-Precision Floating-Point Values with Less Than 2^-28 Relative Error", Forms: []IForm{}}},
+	{"VRCP28SD", Instruction{Summary: "Approximation to the Reciprocal of a Scalar Double-Precision Floating-Point Value with Less Than 2^-28 Relative Error", Forms: []IForm{}}},
+	{"VRCP28SS", Instruction{Summary: "Approximation to the Reciprocal of a Scalar Single-Precision Floating-Point Value with Less Than 2^-28 Relative Error", Forms: []IForm{}}},
+	{"VRCPPS", Instruction{Summary: "Compute Approximate Reciprocals of Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VRCPSS", Instruction{Summary: "Compute Approximate Reciprocal of Scalar Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VREDUCEPD", Instruction{Summary: "Perform Reduction Transformation on Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VREDUCEPS", Instruction{Summary: "Perform Reduction Transformation on Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VREDUCESD", Instruction{Summary: "Perform Reduction Transformation on a Scalar Double-Precision Floating-Point Value", Forms: []IForm{}}},
+	{"VREDUCESS", Instruction{Summary: "Perform Reduction Transformation on a Scalar Single-Precision Floating-Point Value", Forms: []IForm{}}},
+	{"VRNDSCALEPD", Instruction{Summary: "Round Packed Double-Precision Floating-Point Values To Include A Given Number Of Fraction Bits", Forms: []IForm{}}},
+	{"VRNDSCALEPS", Instruction{Summary: "Round Packed Single-Precision Floating-Point Values To Include A Given Number Of Fraction Bits", Forms: []IForm{}}},
+	{"VRNDSCALESD", Instruction{Summary: "Round Scalar Double-Precision Floating-Point Value To Include A Given Number Of Fraction Bits", Forms: []IForm{}}},
+	{"VRNDSCALESS", Instruction{Summary: "Round Scalar Single-Precision Floating-Point Value To Include A Given Number Of Fraction Bits", Forms: []IForm{}}},
+	{"VROUNDPD", Instruction{Summary: "Round Packed Double Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VROUNDPS", Instruction{Summary: "Round Packed Single Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VROUNDSD", Instruction{Summary: "Round Scalar Double Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VROUNDSS", Instruction{Summary: "Round Scalar Single Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VRSQRT14PD", Instruction{Summary: "Compute Approximate Reciprocals of Square Roots of Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VRSQRT14PS", Instruction{Summary: "Compute Approximate Reciprocals of Square Roots of Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VRSQRT14SD", Instruction{Summary: "Compute Approximate Reciprocal of a Square Root of a Scalar Double-Precision Floating-Point Value", Forms: []IForm{}}},
+	{"VRSQRT14SS", Instruction{Summary: "Compute Approximate Reciprocal of a Square Root of a Scalar Single-Precision Floating-Point Value", Forms: []IForm{}}},
+	{"VRSQRT28PD", Instruction{Summary: "Approximation to the Reciprocal Square Root of Packed Double-Precision Floating-Point Values with Less Than 2^-28 Relative Error", Forms: []IForm{}}},
+	{"VRSQRT28PS", Instruction{Summary: "Approximation to the Reciprocal Square Root of Packed Single-Precision Floating-Point Values with Less Than 2^-28 Relative Error", Forms: []IForm{}}},
+	{"VRSQRT28SD", Instruction{Summary: "Approximation to the Reciprocal Square Root of a Scalar Double-Precision Floating-Point Value with Less Than 2^-28 Relative Error", Forms: []IForm{}}},
+	{"VRSQRT28SS", Instruction{Summary: "Approximation to the Reciprocal Square Root of a Scalar Single-Precision Floating-Point Value with Less Than 2^-28 Relative Error", Forms: []IForm{}}},
+	{"VRSQRTPS", Instruction{Summary: "Compute Reciprocals of Square Roots of Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VRSQRTSS", Instruction{Summary: "Compute Reciprocal of Square Root of Scalar Single-Precision Floating-Point Value", Forms: []IForm{}}},
+	{"VSCALEFPD", Instruction{Summary: "Scale Packed Double-Precision Floating-Point Values With Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VSCALEFPS", Instruction{Summary: "Scale Packed Single-Precision Floating-Point Values With Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VSCALEFSD", Instruction{Summary: "Scale Scalar Double-Precision Floating-Point Value With a Double-Precision Floating-Point Value", Forms: []IForm{}}},
+	{"VSCALEFSS", Instruction{Summary: "Scale Scalar Single-Precision Floating-Point Value With a Single-Precision Floating-Point Value", Forms: []IForm{}}},
+	{"VSCATTERDPD", Instruction{Summary: "Scatter Packed Double-Precision Floating-Point Values with Signed Doubleword Indices", Forms: []IForm{}}},
+	{"VSCATTERDPS", Instruction{Summary: "Scatter Packed Single-Precision Floating-Point Values with Signed Doubleword Indices", Forms: []IForm{}}},
+	{"VSCATTERPF0DPD", Instruction{Summary: "Sparse Prefetch Packed Double-Precision Floating-Point Data Values with Signed Doubleword Indices Using T0 Hint with Intent to Write", Forms: []IForm{}}},
+	{"VSCATTERPF0DPS", Instruction{Summary: "Sparse Prefetch Packed Single-Precision Floating-Point Data Values with Signed Doubleword Indices Using T0 Hint with Intent to Write", Forms: []IForm{}}},
+	{"VSCATTERPF0QPD", Instruction{Summary: "Sparse Prefetch Packed Double-Precision Floating-Point Data Values with Signed Quadword Indices Using T0 Hint with Intent to Write", Forms: []IForm{}}},
+	{"VSCATTERPF0QPS", Instruction{Summary: "Sparse Prefetch Packed Single-Precision Floating-Point Data Values with Signed Quadword Indices Using T0 Hint with Intent to Write", Forms: []IForm{}}},
+	{"VSCATTERPF1DPD", Instruction{Summary: "Sparse Prefetch Packed Double-Precision Floating-Point Data Values with Signed Doubleword Indices Using T1 Hint with Intent to Write", Forms: []IForm{}}},
+	{"VSCATTERPF1DPS", Instruction{Summary: "Sparse Prefetch Packed Single-Precision Floating-Point Data Values with Signed Doubleword Indices Using T1 Hint with Intent to Write", Forms: []IForm{}}},
+	{"VSCATTERPF1QPD", Instruction{Summary: "Sparse Prefetch Packed Double-Precision Floating-Point Data Values with Signed Quadword Indices Using T1 Hint with Intent to Write", Forms: []IForm{}}},
+	{"VSCATTERPF1QPS", Instruction{Summary: "Sparse Prefetch Packed Single-Precision Floating-Point Data Values with Signed Quadword Indices Using T1 Hint with Intent to Write", Forms: []IForm{}}},
+	{"VSCATTERQPD", Instruction{Summary: "Scatter Packed Double-Precision Floating-Point Values with Signed Quadword Indices", Forms: []IForm{}}},
+	{"VSCATTERQPS", Instruction{Summary: "Scatter Packed Single-Precision Floating-Point Values with Signed Quadword Indices", Forms: []IForm{}}},
+	{"VSHUFF32X4", Instruction{Summary: "Shuffle 128-Bit Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VSHUFF64X2", Instruction{Summary: "Shuffle 128-Bit Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VSHUFI32X4", Instruction{Summary: "Shuffle 128-Bit Packed Doubleword Integer Values", Forms: []IForm{}}},
+	{"VSHUFI64X2", Instruction{Summary: "Shuffle 128-Bit Packed Quadword Integer Values", Forms: []IForm{}}},
+	{"VSHUFPD", Instruction{Summary: "Shuffle Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VSHUFPS", Instruction{Summary: "Shuffle Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VSQRTPD", Instruction{Summary: "Compute Square Roots of Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VSQRTPS", Instruction{Summary: "Compute Square Roots of Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VSQRTSD", Instruction{Summary: "Compute Square Root of Scalar Double-Precision Floating-Point Value", Forms: []IForm{}}},
+	{"VSQRTSS", Instruction{Summary: "Compute Square Root of Scalar Single-Precision Floating-Point Value", Forms: []IForm{}}},
+	{"VSTMXCSR", Instruction{Summary: "Store MXCSR Register State", Forms: []IForm{}}},
+	{"VSUBPD", Instruction{Summary: "Subtract Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VSUBPS", Instruction{Summary: "Subtract Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VSUBSD", Instruction{Summary: "Subtract Scalar Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VSUBSS", Instruction{Summary: "Subtract Scalar Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VTESTPD", Instruction{Summary: "Packed Double-Precision Floating-Point Bit Test", Forms: []IForm{}}},
+	{"VTESTPS", Instruction{Summary: "Packed Single-Precision Floating-Point Bit Test", Forms: []IForm{}}},
+	{"VUCOMISD", Instruction{Summary: "Unordered Compare Scalar Double-Precision Floating-Point Values and Set EFLAGS", Forms: []IForm{}}},
+	{"VUCOMISS", Instruction{Summary: "Unordered Compare Scalar Single-Precision Floating-Point Values and Set EFLAGS", Forms: []IForm{}}},
+	{"VUNPCKHPD", Instruction{Summary: "Unpack and Interleave High Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VUNPCKHPS", Instruction{Summary: "Unpack and Interleave High Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VUNPCKLPD", Instruction{Summary: "Unpack and Interleave Low Packed Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VUNPCKLPS", Instruction{Summary: "Unpack and Interleave Low Packed Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VXORPD", Instruction{Summary: "Bitwise Logical XOR for Double-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VXORPS", Instruction{Summary: "Bitwise Logical XOR for Single-Precision Floating-Point Values", Forms: []IForm{}}},
+	{"VZEROALL", Instruction{Summary: "Zero All YMM Registers", Forms: []IForm{}}},
+	{"VZEROUPPER", Instruction{Summary: "Zero Upper Bits of YMM Registers", Forms: []IForm{}}},
+	{"XADD", Instruction{Summary: "Exchange and Add", Forms: []IForm{
+		{name: "XADDB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "r8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xc0, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "XADDW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16", Output: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "XADDL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "XADDQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+		}},
+		{name: "XADDB", opcount: 2, ops: []Op{{TN: "m8", Output: true}, {TN: "r8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xc0, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "XADDW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "r16", Output: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "XADDL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "r32", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "XADDQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "r64", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0xc1, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+	}}},
+	{"XCHG", Instruction{Summary: "Exchange Register/Memory with Register", Forms: []IForm{
+		{name: "XCHGB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "r8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x86, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x86, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "XCHGB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "m8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x86, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "XCHGW", opcount: 1, ops: []Op{{TN: "ax", Output: true, Implicit: true}, {TN: "r16", Output: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x90, hasAddend: true, addend: 1}},
+		}},
+		{name: "XCHGW", opcount: 1, ops: []Op{{TN: "r16", Output: true}, {TN: "ax", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x90, hasAddend: true, addend: 0}},
+		}},
+		{name: "XCHGW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16", Output: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x87, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x87, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "XCHGW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16", Output: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x87, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "XCHGL", opcount: 1, ops: []Op{{TN: "eax", Output: true, Implicit: true}, {TN: "r32", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x90, hasAddend: true, addend: 1}},
+		}},
+		{name: "XCHGL", opcount: 1, ops: []Op{{TN: "r32", Output: true}, {TN: "eax", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x90, hasAddend: true, addend: 0}},
+		}},
+		{name: "XCHGL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x87, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x87, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "XCHGL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x87, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "XCHGQ", opcount: 1, ops: []Op{{TN: "rax", Output: true, Implicit: true}, {TN: "r64", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x90, hasAddend: true, addend: 1}},
+		}},
+		{name: "XCHGQ", opcount: 1, ops: []Op{{TN: "r64", Output: true}, {TN: "rax", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x90, hasAddend: true, addend: 0}},
+		}},
+		{name: "XCHGQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x87, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x87, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "XCHGQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x87, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "XCHGB", opcount: 2, ops: []Op{{TN: "m8", Output: true}, {TN: "r8", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x86, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "XCHGW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "r16", Output: true}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x87, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "XCHGL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "r32", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x87, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "XCHGQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "r64", Output: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x87, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+	}}},
+	{"XGETBV", Instruction{Summary: "Get Value of Extended Control Register", Forms: []IForm{
+		{name: "XGETBV", opcount: 0, ops: []Op{{TN: "eax", Output: true, Implicit: true}, {TN: "ecx", Implicit: true}, {TN: "edx", Output: true, Implicit: true}}, enc: [][]Encoder{
+			{&opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x01, hasAddend: false, addend: 0}, &opcode{op: 0xd0, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"XLATB", Instruction{Summary: "Table Look-up Translation", Forms: []IForm{
+		{name: "XLATB", opcount: 0, ops: []Op{{TN: "al", Output: true, Implicit: true}, {TN: "ebx", Implicit: true}}, enc: [][]Encoder{
+			{&opcode{op: 0xd7, hasAddend: false, addend: 0}},
+		}},
+		{name: "XLATB", opcount: 0, ops: []Op{{TN: "al", Output: true, Implicit: true}, {TN: "rbx", Implicit: true}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0xd7, hasAddend: false, addend: 0}},
+		}},
+	}}},
+	{"XOR", Instruction{Summary: "Logical Exclusive OR", Forms: []IForm{
+		{name: "XORB", opcount: 1, ops: []Op{{TN: "al", Output: true, Implicit: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&opcode{op: 0x34, hasAddend: false, addend: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "XORB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x80, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x86, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "XORB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "r8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x30, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x32, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "XORB", opcount: 2, ops: []Op{{TN: "r8", Output: true}, {TN: "m8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x32, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "XORW", opcount: 1, ops: []Op{{TN: "ax", Output: true, Implicit: true}, {TN: "imm16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &opcode{op: 0x35, hasAddend: false, addend: 0}, &immediate{size: 2, value: 1}},
+		}},
+		{name: "XORW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x86, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "XORW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "imm16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x86, rm: 0}, &immediate{size: 2, value: 1}},
+		}},
+		{name: "XORW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x31, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x33, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "XORW", opcount: 2, ops: []Op{{TN: "r16", Output: true}, {TN: "m16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x33, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "XORL", opcount: 1, ops: []Op{{TN: "eax", Output: true, Implicit: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&opcode{op: 0x35, hasAddend: false, addend: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "XORL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x86, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "XORL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x86, rm: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "XORL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x31, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x33, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "XORL", opcount: 2, ops: []Op{{TN: "r32", Output: true}, {TN: "m32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x33, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "XORQ", opcount: 1, ops: []Op{{TN: "rax", Output: true, Implicit: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x35, hasAddend: false, addend: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "XORQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x86, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "XORQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x86, rm: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "XORQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x31, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x01, rm: 0}},
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 1}, &opcode{op: 0x33, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "XORQ", opcount: 2, ops: []Op{{TN: "r64", Output: true}, {TN: "m64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 1, b: 1}, &opcode{op: 0x33, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+		{name: "XORB", opcount: 2, ops: []Op{{TN: "m8", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x80, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x86, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "XORB", opcount: 2, ops: []Op{{TN: "m8", Output: true}, {TN: "r8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x30, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "XORW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x86, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "XORW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "imm16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x86, rm: 0}, &immediate{size: 2, value: 1}},
+		}},
+		{name: "XORW", opcount: 2, ops: []Op{{TN: "m16", Output: true}, {TN: "r16"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x31, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "XORL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x86, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "XORL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x86, rm: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "XORL", opcount: 2, ops: []Op{{TN: "m32", Output: true}, {TN: "r32"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 1, x: 0, b: 0}, &opcode{op: 0x31, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+		{name: "XORQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "imm8"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x83, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x86, rm: 0}, &immediate{size: 1, value: 1}},
+		}},
+		{name: "XORQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "imm32"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 0, x: 0, b: 0}, &opcode{op: 0x81, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x86, rm: 0}, &immediate{size: 4, value: 1}},
+		}},
+		{name: "XORQ", opcount: 2, ops: []Op{{TN: "m64", Output: true}, {TN: "r64"}}, enc: [][]Encoder{
+			{&rex{mandatory: true, w: 1, r: 1, x: 0, b: 0}, &opcode{op: 0x31, hasAddend: false, addend: 0}, &modrm{mod: 0x00, reg: 0x01, rm: 0}},
+		}},
+	}}},
+	{"XORPD", Instruction{Summary: "Bitwise Logical XOR for Double-Precision Floating-Point Values", Forms: []IForm{
+		{name: "XORPD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x57, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "XORPD", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&prefix{b: 0x66}, &rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x57, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+	{"XORPS", Instruction{Summary: "Bitwise Logical XOR for Single-Precision Floating-Point Values", Forms: []IForm{
+		{name: "XORPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "xmm"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 0, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x57, hasAddend: false, addend: 0}, &modrm{mod: 0x83, reg: 0x00, rm: 1}},
+		}},
+		{name: "XORPS", opcount: 2, ops: []Op{{TN: "xmm", Output: true}, {TN: "m128"}}, enc: [][]Encoder{
+			{&rex{mandatory: false, w: 0, r: 0, x: 1, b: 1}, &opcode{op: 0x0f, hasAddend: false, addend: 0}, &opcode{op: 0x57, hasAddend: false, addend: 0}, &modrm{mod: 0x01, reg: 0x00, rm: 1}},
+		}},
+	}}},
+}